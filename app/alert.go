@@ -0,0 +1,399 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Notifier delivers an alert to an external system
+type Notifier interface {
+	Notify(alert models.Alert) error
+}
+
+func postJSON(client *http.Client, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	res, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("returned status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// WebhookNotifier posts the alert as JSON
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func (n *WebhookNotifier) Notify(alert models.Alert) error {
+	return postJSON(n.client, n.url, alert)
+}
+
+// SlackNotifier posts the alert to a Slack incoming webhook
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func (n *SlackNotifier) Notify(alert models.Alert) error {
+	lines := []string{fmt.Sprintf("*[%s] %s*", strings.ToUpper(alert.Severity), alert.Summary)}
+	lines = append(lines, fmt.Sprintf("validator: %s (%s)", alert.ValidatorId, alert.Hostname))
+	keys := make([]string, 0, len(alert.Details))
+	for key := range alert.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("%s: %s", key, alert.Details[key]))
+	}
+	return postJSON(n.client, n.url, map[string]string{"text": strings.Join(lines, "\n")})
+}
+
+// PagerDutyNotifier triggers a PagerDuty incident through the Events API v2.
+// The alert key is used as dedup key, so repeated alerts update the same incident.
+type PagerDutyNotifier struct {
+	url        string
+	routingKey string
+	client     *http.Client
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp"`
+	Component     string            `json:"component,omitempty"`
+	Class         string            `json:"class"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+func (n *PagerDutyNotifier) Notify(alert models.Alert) error {
+	return postJSON(n.client, n.url, pagerDutyEvent{
+		RoutingKey:  n.routingKey,
+		EventAction: "trigger",
+		DedupKey:    alert.Key,
+		Payload: pagerDutyPayload{
+			Summary:       alert.Summary,
+			Source:        alert.ValidatorId + "@" + alert.Hostname,
+			Severity:      alert.Severity,
+			Timestamp:     alert.CreatedAt.Format(time.RFC3339),
+			Component:     alert.Service,
+			Class:         alert.Condition,
+			CustomDetails: alert.Details,
+		},
+	})
+}
+
+// Alerter sends alerts to every notifier. An alert is dropped if one with the same key was
+// sent within the dedup window, or if the rate limit of alerts per window has been reached.
+type Alerter struct {
+	notifiers       []Notifier
+	dedupWindow     time.Duration
+	rateLimit       int
+	rateLimitWindow time.Duration
+
+	mu       sync.Mutex
+	lastSent map[string]time.Time
+	sent     []time.Time
+	dropped  int
+}
+
+// NewAlerter creates an alerter with a notifier for each configured destination
+func NewAlerter(config models.AlertsConfig) *Alerter {
+	client := &http.Client{Timeout: config.TimeoutMillis.Duration()}
+
+	var notifiers []Notifier
+	if config.WebhookURL != "" {
+		notifiers = append(notifiers, &WebhookNotifier{url: config.WebhookURL, client: client})
+	}
+	if config.SlackWebhookURL != "" {
+		notifiers = append(notifiers, &SlackNotifier{url: config.SlackWebhookURL, client: client})
+	}
+	if config.PagerDutyRoutingKey != "" {
+		notifiers = append(notifiers, &PagerDutyNotifier{url: config.PagerDutyURL, routingKey: config.PagerDutyRoutingKey, client: client})
+	}
+
+	return &Alerter{
+		notifiers:       notifiers,
+		dedupWindow:     config.DedupWindowMillis.Duration(),
+		rateLimit:       int(config.RateLimit),
+		rateLimitWindow: config.RateLimitWindowMillis.Duration(),
+		lastSent:        make(map[string]time.Time),
+	}
+}
+
+// allow records an alert as sent unless it is a duplicate or over the rate limit
+func (a *Alerter) allow(alert models.Alert) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := alert.CreatedAt
+	if last, ok := a.lastSent[alert.Key]; ok && now.Sub(last) < a.dedupWindow {
+		log.Debug("[ALERT] Dropped duplicate alert: ", alert.Key)
+		return false
+	}
+
+	if a.rateLimit > 0 {
+		recent := a.sent[:0]
+		for _, sent := range a.sent {
+			if now.Sub(sent) < a.rateLimitWindow {
+				recent = append(recent, sent)
+			}
+		}
+		a.sent = recent
+		if len(a.sent) >= a.rateLimit {
+			a.dropped++
+			log.Warnf("[ALERT] Rate limit reached, dropped alert %s (%d dropped)", alert.Key, a.dropped)
+			return false
+		}
+		a.sent = append(a.sent, now)
+	}
+
+	a.lastSent[alert.Key] = now
+	return true
+}
+
+// Raise sends an alert to every notifier and reports whether it was sent
+func (a *Alerter) Raise(alert models.Alert) bool {
+	if alert.CreatedAt.IsZero() {
+		alert.CreatedAt = time.Now()
+	}
+	if alert.Hostname == "" {
+		alert.Hostname, _ = os.Hostname()
+	}
+	if alert.ValidatorId == "" {
+		logMu.RLock()
+		alert.ValidatorId = logValidatorId
+		logMu.RUnlock()
+	}
+
+	if !a.allow(alert) {
+		return false
+	}
+
+	log.Warnf("[ALERT] %s: %s", alert.Key, alert.Summary)
+	for _, notifier := range a.notifiers {
+		if err := notifier.Notify(alert); err != nil {
+			log.Errorf("[ALERT] Error sending alert %s with %T: %s", alert.Key, notifier, err.Error())
+		}
+	}
+	return true
+}
+
+var alerter *Alerter
+
+// InitAlerts starts sending alerts if they are enabled
+func InitAlerts() {
+	if !Config.Alerts.Enabled {
+		log.Debug("[ALERT] Alerts are disabled")
+		return
+	}
+
+	alerter = NewAlerter(Config.Alerts)
+	log.Infof("[ALERT] Sending alerts to %d notifiers", len(alerter.notifiers))
+}
+
+// RaiseAlert sends an alert in the background, so that runners never wait on a notifier
+func RaiseAlert(alert models.Alert) {
+	if alerter == nil {
+		return
+	}
+	if alert.CreatedAt.IsZero() {
+		alert.CreatedAt = time.Now()
+	}
+	go alerter.Raise(alert)
+}
+
+// AlertValidationFailed alerts that a signer refused to sign a document that failed validation
+func AlertValidationFailed(service string, collection string, id *primitive.ObjectID, txHash string) {
+	if !Config.Alerts.ValidationFailed {
+		return
+	}
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionValidationFailed + ":" + collection + ":" + txHash,
+		Condition: models.AlertConditionValidationFailed,
+		Severity:  models.AlertSeverityWarning,
+		Service:   service,
+		Summary:   fmt.Sprintf("%s failed validation of %s %s", service, collection, txHash),
+		Details:   documentDetails(collection, id, txHash),
+	})
+}
+
+// AlertAwaitingApproval alerts that a transfer above the approval threshold waits for operators
+func AlertAwaitingApproval(service string, collection string, id *primitive.ObjectID, txHash string, amount string) {
+	details := documentDetails(collection, id, txHash)
+	details["amount"] = amount
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionAwaitingApproval + ":" + collection + ":" + txHash,
+		Condition: models.AlertConditionAwaitingApproval,
+		Severity:  models.AlertSeverityWarning,
+		Service:   service,
+		Summary:   fmt.Sprintf("%s %s of %s is awaiting approval", collection, txHash, amount),
+		Details:   details,
+	})
+}
+
+// AlertQuarantined alerts that a transfer involving a denied address was quarantined
+func AlertQuarantined(service string, collection string, id *primitive.ObjectID, txHash string, reason string) {
+	details := documentDetails(collection, id, txHash)
+	details["reason"] = reason
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionQuarantined + ":" + collection + ":" + txHash,
+		Condition: models.AlertConditionQuarantined,
+		Severity:  models.AlertSeverityCritical,
+		Service:   service,
+		Summary:   fmt.Sprintf("%s quarantined %s %s: %s", service, collection, txHash, reason),
+		Details:   details,
+	})
+}
+
+// AlertReturnTxFailed alerts that a return transaction failed on the Pocket network
+func AlertReturnTxFailed(service string, collection string, id *primitive.ObjectID, txHash string, returnTxHash string) {
+	if !Config.Alerts.ReturnTxFailed {
+		return
+	}
+	details := documentDetails(collection, id, txHash)
+	details["return_tx_hash"] = returnTxHash
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionReturnTxFailed + ":" + returnTxHash,
+		Condition: models.AlertConditionReturnTxFailed,
+		Severity:  models.AlertSeverityCritical,
+		Service:   service,
+		Summary:   fmt.Sprintf("Return tx %s of %s %s failed", returnTxHash, collection, txHash),
+		Details:   details,
+	})
+}
+
+// AlertUnexpectedMint alerts that a Minted event does not match a mint signed by the validators.
+// It is raised even when the unexpected_mint breaker trigger is off and signing goes on.
+func AlertUnexpectedMint(service string, id *primitive.ObjectID, txHash string, mintTxHash string, reason string) {
+	details := documentDetails(models.CollectionMints, id, txHash)
+	details["mint_tx_hash"] = mintTxHash
+	details["reason"] = reason
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionUnexpectedMint + ":" + mintTxHash,
+		Condition: models.AlertConditionUnexpectedMint,
+		Severity:  models.AlertSeverityCritical,
+		Service:   service,
+		Summary:   "Unexpected mint: " + reason,
+		Details:   details,
+	})
+}
+
+// AlertIfStuck alerts when a document has not moved from its status for longer than allowed
+func AlertIfStuck(service string, collection string, id *primitive.ObjectID, txHash string, status string, updatedAt time.Time) {
+	stuckAfter := Config.Alerts.StuckAfterMillis.Duration()
+	if stuckAfter == 0 || updatedAt.IsZero() || time.Since(updatedAt) < stuckAfter {
+		return
+	}
+	details := documentDetails(collection, id, txHash)
+	details["status"] = status
+	details["updated_at"] = updatedAt.Format(time.RFC3339)
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionStuckDocument + ":" + collection + ":" + txHash + ":" + status,
+		Condition: models.AlertConditionStuckDocument,
+		Severity:  models.AlertSeverityWarning,
+		Service:   service,
+		Summary:   fmt.Sprintf("%s %s has been %s since %s", collection, txHash, status, updatedAt.Format(time.RFC3339)),
+		Details:   details,
+	})
+}
+
+// AlertServiceUnhealthy alerts that a service failed several runs in a row
+func AlertServiceUnhealthy(service string, failedRuns int64) {
+	if Config.Alerts.UnhealthyRuns == 0 || failedRuns < Config.Alerts.UnhealthyRuns {
+		return
+	}
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionServiceUnhealthy + ":" + service,
+		Condition: models.AlertConditionServiceUnhealthy,
+		Severity:  models.AlertSeverityCritical,
+		Service:   service,
+		Summary:   fmt.Sprintf("%s failed %d runs in a row", service, failedRuns),
+		Details:   map[string]string{"failed_runs": strconv.FormatInt(failedRuns, 10)},
+	})
+}
+
+// AlertSolvency alerts that the vault balance drifted from the wPOKT it backs by more than
+// the tolerance. A vault that holds less than it should is critical.
+func AlertSolvency(service string, report models.SolvencyReport) {
+	severity := models.AlertSeverityWarning
+	if strings.HasPrefix(report.Discrepancy, "-") {
+		severity = models.AlertSeverityCritical
+	}
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionSolvency + ":" + severity,
+		Condition: models.AlertConditionSolvency,
+		Severity:  severity,
+		Service:   service,
+		Summary:   fmt.Sprintf("Vault balance %s is off by %s from the expected %s", report.VaultBalance, report.Discrepancy, report.ExpectedVaultBalance),
+		Details: map[string]string{
+			"vault_balance":          report.VaultBalance,
+			"total_supply":           report.TotalSupply,
+			"pending_mints":          report.PendingMints,
+			"pending_invalid_mints":  report.PendingInvalidMints,
+			"pending_burns":          report.PendingBurns,
+			"expected_vault_balance": report.ExpectedVaultBalance,
+			"discrepancy":            report.Discrepancy,
+			"tolerance":              report.Tolerance,
+		},
+	})
+}
+
+func documentDetails(collection string, id *primitive.ObjectID, txHash string) map[string]string {
+	details := map[string]string{
+		"doc_type": collection,
+		"tx_hash":  txHash,
+	}
+	if id != nil {
+		details["doc_id"] = id.Hex()
+	}
+	return details
+}
+
+// AlertValidatorSetDrift alerts that the validators of the mint controller no longer match the
+// config. Losing our own signer key is critical.
+func AlertValidatorSetDrift(service string, report models.ValidatorSetReport) {
+	severity := models.AlertSeverityWarning
+	if !report.SignerIsValidator {
+		severity = models.AlertSeverityCritical
+	}
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionValidatorSet + ":" + severity,
+		Condition: models.AlertConditionValidatorSet,
+		Severity:  severity,
+		Service:   service,
+		Summary:   "Validator set does not match the config: " + strings.Join(report.Drift, "; "),
+		Details: map[string]string{
+			"signer_address":  report.SignerAddress,
+			"validator_count": report.ValidatorCount,
+			"validators":      strings.Join(report.Validators, ","),
+		},
+	})
+}
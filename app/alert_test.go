@@ -0,0 +1,201 @@
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type alertRequests struct {
+	mu     sync.Mutex
+	bodies map[string][]map[string]interface{}
+}
+
+func (r *alertRequests) get(path string) []map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.bodies[path]
+}
+
+func alertServer(t *testing.T, status int) (*httptest.Server, *alertRequests) {
+	requests := &alertRequests{bodies: make(map[string][]map[string]interface{})}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		assert.Nil(t, json.Unmarshal(body, &payload))
+		requests.mu.Lock()
+		requests.bodies[r.URL.Path] = append(requests.bodies[r.URL.Path], payload)
+		requests.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func testAlert(key string, at time.Time) models.Alert {
+	return models.Alert{
+		Key:         key,
+		Condition:   models.AlertConditionValidationFailed,
+		Severity:    models.AlertSeverityCritical,
+		Service:     "MINT SIGNER",
+		Summary:     "mints abc failed validation",
+		Details:     map[string]string{"tx_hash": "abc", "doc_type": "mints"},
+		ValidatorId: "validator",
+		Hostname:    "host",
+		CreatedAt:   at,
+	}
+}
+
+func TestAlerter(t *testing.T) {
+	t.Run("Sends webhook, slack and pagerduty payloads", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		alerter := NewAlerter(models.AlertsConfig{
+			WebhookURL:          server.URL + "/webhook",
+			SlackWebhookURL:     server.URL + "/slack",
+			PagerDutyRoutingKey: "routing-key",
+			PagerDutyURL:        server.URL + "/pagerduty",
+			TimeoutMillis:       1000,
+		})
+		at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		assert.True(t, alerter.Raise(testAlert("validation_failed:mints:abc", at)))
+
+		webhook := requests.get("/webhook")
+		assert.Len(t, webhook, 1)
+		assert.Equal(t, "validation_failed:mints:abc", webhook[0]["key"])
+		assert.Equal(t, "MINT SIGNER", webhook[0]["service"])
+
+		slack := requests.get("/slack")
+		assert.Len(t, slack, 1)
+		assert.Equal(t, "*[CRITICAL] mints abc failed validation*\nvalidator: validator (host)\ndoc_type: mints\ntx_hash: abc", slack[0]["text"])
+
+		pagerduty := requests.get("/pagerduty")
+		assert.Len(t, pagerduty, 1)
+		assert.Equal(t, "routing-key", pagerduty[0]["routing_key"])
+		assert.Equal(t, "trigger", pagerduty[0]["event_action"])
+		assert.Equal(t, "validation_failed:mints:abc", pagerduty[0]["dedup_key"])
+		payload := pagerduty[0]["payload"].(map[string]interface{})
+		assert.Equal(t, "validator@host", payload["source"])
+		assert.Equal(t, "critical", payload["severity"])
+		assert.Equal(t, "2024-01-02T03:04:05Z", payload["timestamp"])
+		assert.Equal(t, "validation_failed", payload["class"])
+	})
+
+	t.Run("Drops duplicates within the dedup window", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		alerter := NewAlerter(models.AlertsConfig{
+			WebhookURL:        server.URL + "/webhook",
+			TimeoutMillis:     1000,
+			DedupWindowMillis: 60000,
+		})
+		at := time.Now()
+
+		assert.True(t, alerter.Raise(testAlert("a", at)))
+		assert.False(t, alerter.Raise(testAlert("a", at.Add(time.Second))))
+		assert.True(t, alerter.Raise(testAlert("b", at.Add(time.Second))))
+		assert.True(t, alerter.Raise(testAlert("a", at.Add(time.Minute))))
+
+		assert.Len(t, requests.get("/webhook"), 3)
+	})
+
+	t.Run("Drops alerts over the rate limit", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		alerter := NewAlerter(models.AlertsConfig{
+			WebhookURL:            server.URL + "/webhook",
+			TimeoutMillis:         1000,
+			RateLimit:             2,
+			RateLimitWindowMillis: 60000,
+		})
+		at := time.Now()
+
+		assert.True(t, alerter.Raise(testAlert("a", at)))
+		assert.True(t, alerter.Raise(testAlert("b", at.Add(time.Second))))
+		assert.False(t, alerter.Raise(testAlert("c", at.Add(2*time.Second))))
+		assert.True(t, alerter.Raise(testAlert("d", at.Add(time.Minute+time.Second))))
+
+		assert.Len(t, requests.get("/webhook"), 3)
+		assert.Equal(t, 1, alerter.dropped)
+	})
+
+	t.Run("Keeps sending when a notifier fails", func(t *testing.T) {
+		failing, _ := alertServer(t, http.StatusInternalServerError)
+		server, requests := alertServer(t, http.StatusOK)
+		alerter := NewAlerter(models.AlertsConfig{
+			WebhookURL:      failing.URL + "/webhook",
+			SlackWebhookURL: server.URL + "/slack",
+			TimeoutMillis:   1000,
+		})
+
+		assert.Equal(t, "returned status 500", alerter.notifiers[0].Notify(testAlert("a", time.Now())).Error())
+		assert.True(t, alerter.Raise(testAlert("a", time.Now())))
+		assert.Len(t, requests.get("/slack"), 1)
+	})
+}
+
+func TestAlertConditions(t *testing.T) {
+	defer func() { Config.Alerts = models.AlertsConfig{} }()
+
+	t.Run("Service unhealthy after the configured runs", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		Config.Alerts = models.AlertsConfig{
+			WebhookURL:    server.URL + "/webhook",
+			TimeoutMillis: 1000,
+			UnhealthyRuns: 3,
+		}
+		alerter = NewAlerter(Config.Alerts)
+		defer func() { alerter = nil }()
+
+		AlertServiceUnhealthy("MINT SIGNER", 2)
+		AlertServiceUnhealthy("MINT SIGNER", 3)
+
+		assert.Eventually(t, func() bool { return len(requests.get("/webhook")) == 1 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "service_unhealthy:MINT SIGNER", requests.get("/webhook")[0]["key"])
+	})
+
+	t.Run("Stuck documents past the sla", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		Config.Alerts = models.AlertsConfig{
+			WebhookURL:       server.URL + "/webhook",
+			TimeoutMillis:    1000,
+			StuckAfterMillis: 60000,
+		}
+		alerter = NewAlerter(Config.Alerts)
+		defer func() { alerter = nil }()
+
+		AlertIfStuck("BURN EXECUTOR", models.CollectionBurns, nil, "fresh", models.StatusSigned, time.Now())
+		AlertIfStuck("BURN EXECUTOR", models.CollectionBurns, nil, "stuck", models.StatusSubmitted, time.Now().Add(-time.Hour))
+
+		assert.Eventually(t, func() bool { return len(requests.get("/webhook")) == 1 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "stuck_document:burns:stuck:submitted", requests.get("/webhook")[0]["key"])
+	})
+
+	t.Run("Unexpected mint", func(t *testing.T) {
+		server, requests := alertServer(t, http.StatusOK)
+		Config.Alerts = models.AlertsConfig{
+			WebhookURL:    server.URL + "/webhook",
+			TimeoutMillis: 1000,
+		}
+		alerter = NewAlerter(Config.Alerts)
+		defer func() { alerter = nil }()
+
+		AlertUnexpectedMint("MINT EXECUTOR", nil, "", "0xabc", "minted event 0xabc does not match any mint")
+
+		assert.Eventually(t, func() bool { return len(requests.get("/webhook")) == 1 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "unexpected_mint:0xabc", requests.get("/webhook")[0]["key"])
+		assert.Equal(t, "critical", requests.get("/webhook")[0]["severity"])
+	})
+
+	t.Run("Nothing is sent when alerts are disabled", func(t *testing.T) {
+		Config.Alerts = models.AlertsConfig{ValidationFailed: true, ReturnTxFailed: true}
+
+		AlertValidationFailed("MINT SIGNER", models.CollectionMints, nil, "abc")
+		AlertReturnTxFailed("BURN EXECUTOR", models.CollectionBurns, nil, "abc", "def")
+	})
+}
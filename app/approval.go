@@ -0,0 +1,101 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrInvalidApproval = errors.New("approval signature is invalid")
+	ErrUnknownOperator = errors.New("approval is not signed by a configured operator")
+)
+
+// ApprovalThreshold is the amount above which transfers of a collection need approvals,
+// zero when approvals are disabled
+func ApprovalThreshold(collection string) int64 {
+	switch collection {
+	case models.CollectionMints:
+		return LiveConfig().Approvals.MintThreshold
+	case models.CollectionBurns:
+		return LiveConfig().Approvals.BurnThreshold
+	}
+	return 0
+}
+
+// RequiresApproval reports whether a transfer of a collection is above the approval threshold
+func RequiresApproval(collection string, amount *big.Int) bool {
+	threshold := ApprovalThreshold(collection)
+	return threshold > 0 && amount.Cmp(big.NewInt(threshold)) > 0
+}
+
+// ApprovalMessage is what operators sign to approve a transfer. It names the transfer and
+// what it pays out, so an approval cannot be reused for another transfer.
+func ApprovalMessage(collection string, id string, txHash string, recipient string, amount string) string {
+	return fmt.Sprintf("wpokt-validator approve %s %s tx %s recipient %s amount %s",
+		collection, id, strings.ToLower(txHash), strings.ToLower(recipient), amount)
+}
+
+// SignApproval signs an approval message as an EIP-191 personal message, so operators can
+// also sign it with a wallet
+func SignApproval(message string, key *ecdsa.PrivateKey) (string, error) {
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		return "", err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(signature), nil
+}
+
+// ApprovalOperator recovers the configured operator that signed an approval message
+func ApprovalOperator(message string, signature string) (string, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return "", ErrInvalidApproval
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if err != nil {
+		return "", ErrInvalidApproval
+	}
+	operator := crypto.PubkeyToAddress(*pubKey)
+
+	for _, address := range LiveConfig().Approvals.OperatorAddresses {
+		if common.HexToAddress(address) == operator {
+			return strings.ToLower(operator.Hex()), nil
+		}
+	}
+	return "", ErrUnknownOperator
+}
+
+// CountApprovals counts the configured operators with a valid approval of the message.
+// Approvals are checked again on every count, so removing an operator from the config
+// revokes their approvals.
+func CountApprovals(message string, approvals []models.Approval) int64 {
+	approved := map[string]bool{}
+	for _, approval := range approvals {
+		operator, err := ApprovalOperator(message, approval.Signature)
+		if err != nil || operator != strings.ToLower(approval.Operator) {
+			continue
+		}
+		approved[operator] = true
+	}
+	return int64(len(approved))
+}
+
+// Approved reports whether a quorum of operators approved the message
+func Approved(message string, approvals []models.Approval) bool {
+	quorum := LiveConfig().Approvals.Quorum
+	return quorum > 0 && CountApprovals(message, approvals) >= quorum
+}
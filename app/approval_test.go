@@ -0,0 +1,104 @@
+package app
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovals(t *testing.T) {
+	alice, _ := crypto.GenerateKey()
+	bob, _ := crypto.GenerateKey()
+	mallory, _ := crypto.GenerateKey()
+	aliceAddress := strings.ToLower(crypto.PubkeyToAddress(alice.PublicKey).Hex())
+	bobAddress := strings.ToLower(crypto.PubkeyToAddress(bob.PublicKey).Hex())
+
+	setup := func(t *testing.T) {
+		Config.Approvals = models.ApprovalsConfig{
+			MintThreshold:     1000,
+			Quorum:            2,
+			OperatorAddresses: []string{crypto.PubkeyToAddress(alice.PublicKey).Hex(), bobAddress},
+		}
+		t.Cleanup(func() { Config = models.Config{} })
+	}
+
+	message := ApprovalMessage(models.CollectionMints, "id", "0xHASH", "0xRECIPIENT", "5000")
+
+	aliceSig, err := SignApproval(message, alice)
+	assert.Nil(t, err)
+	bobSig, _ := SignApproval(message, bob)
+	mallorySig, _ := SignApproval(message, mallory)
+
+	t.Run("Requires approval", func(t *testing.T) {
+		setup(t)
+
+		assert.True(t, RequiresApproval(models.CollectionMints, big.NewInt(1001)))
+		assert.False(t, RequiresApproval(models.CollectionMints, big.NewInt(1000)))
+		assert.False(t, RequiresApproval(models.CollectionBurns, big.NewInt(1000000)))
+	})
+
+	t.Run("Recovers operator", func(t *testing.T) {
+		setup(t)
+
+		operator, err := ApprovalOperator(message, aliceSig)
+
+		assert.Nil(t, err)
+		assert.Equal(t, aliceAddress, operator)
+	})
+
+	t.Run("Unknown operator", func(t *testing.T) {
+		setup(t)
+
+		_, err := ApprovalOperator(message, mallorySig)
+
+		assert.Equal(t, ErrUnknownOperator, err)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		setup(t)
+
+		_, err := ApprovalOperator(message, "0x1234")
+
+		assert.Equal(t, ErrInvalidApproval, err)
+	})
+
+	t.Run("Quorum", func(t *testing.T) {
+		setup(t)
+
+		approvals := []models.Approval{{Operator: aliceAddress, Signature: aliceSig}}
+		assert.False(t, Approved(message, approvals))
+
+		approvals = append(approvals, models.Approval{Operator: bobAddress, Signature: bobSig})
+		assert.True(t, Approved(message, approvals))
+	})
+
+	t.Run("Duplicate and mismatched approvals do not count", func(t *testing.T) {
+		setup(t)
+
+		approvals := []models.Approval{
+			{Operator: aliceAddress, Signature: aliceSig},
+			{Operator: aliceAddress, Signature: aliceSig},
+			{Operator: bobAddress, Signature: aliceSig},
+			{Operator: "0xmallory", Signature: mallorySig},
+		}
+
+		assert.Equal(t, int64(1), CountApprovals(message, approvals))
+		assert.False(t, Approved(message, approvals))
+	})
+
+	t.Run("Approval of another transfer", func(t *testing.T) {
+		setup(t)
+
+		other := ApprovalMessage(models.CollectionMints, "id", "0xhash", "0xrecipient", "5001")
+		approvals := []models.Approval{
+			{Operator: aliceAddress, Signature: aliceSig},
+			{Operator: bobAddress, Signature: bobSig},
+		}
+
+		assert.Equal(t, int64(0), CountApprovals(other, approvals))
+	})
+}
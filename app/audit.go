@@ -0,0 +1,31 @@
+package app
+
+import (
+	"os"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// RecordAudit stores a record of a manual change made by an operator or of a secret rotation
+func RecordAudit(audit models.AuditLog) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Warn("[AUDIT] Error getting hostname: ", err)
+	}
+	audit.Hostname = hostname
+	audit.CreatedAt = time.Now()
+
+	err = DB.InsertOne(models.CollectionAuditLogs, audit)
+	if err != nil {
+		log.Error("[AUDIT] Error recording audit log: ", err)
+		return err
+	}
+	if audit.Field != "" {
+		log.Infof("[AUDIT] %s %s %s: %s", audit.Operator, audit.Action, audit.Field, audit.Reason)
+		return nil
+	}
+	log.Infof("[AUDIT] %s %s %s/%s: %s -> %s", audit.Operator, audit.Action, audit.Collection, audit.DocumentId, audit.StatusFrom, audit.StatusTo)
+	return nil
+}
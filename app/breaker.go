@@ -0,0 +1,163 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrBreakerNotTripped is returned when resetting a breaker that is not tripped
+var ErrBreakerNotTripped = errors.New("signing breaker is not tripped")
+
+// breakerTriggerEnabled reports whether the config enables an automatic trigger
+func breakerTriggerEnabled(trigger string) bool {
+	switch trigger {
+	case models.BreakerTriggerSolvency:
+		return LiveConfig().CircuitBreaker.SolvencyMismatch
+	case models.BreakerTriggerUnexpectedMint:
+		return LiveConfig().CircuitBreaker.UnexpectedMint
+	case models.BreakerTriggerVolumeLimit:
+		return LiveConfig().CircuitBreaker.VolumeLimit
+	case models.BreakerTriggerPaused:
+		return LiveConfig().CircuitBreaker.Paused
+	}
+	return true
+}
+
+// SigningBreaker reads the signing breaker, which is reset if it was never tripped
+func SigningBreaker(db Database) (models.CircuitBreaker, error) {
+	breaker := models.CircuitBreaker{Id: models.SigningBreakerId}
+	err := db.FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, &breaker)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return models.CircuitBreaker{Id: models.SigningBreakerId}, nil
+	}
+	return breaker, err
+}
+
+// SigningHalted reports whether signers must stop producing signatures. It fails closed, so
+// a signer that cannot read the breaker does not sign.
+func SigningHalted(db Database, service string) bool {
+	breaker, err := SigningBreaker(db)
+	if err != nil {
+		log.Errorf("[%s] Error reading signing breaker, not signing: %s", service, err.Error())
+		return true
+	}
+	if breaker.Tripped {
+		log.Warnf("[%s] Signing breaker tripped by %s at %s (%s: %s), not signing", service, breaker.TrippedBy, breaker.TrippedAt.Format(time.RFC3339), breaker.Trigger, breaker.Reason)
+		return true
+	}
+	return false
+}
+
+// TripBreaker stops every validator from signing until an operator resets the breaker.
+// Automatic triggers can be turned off in the config. Tripping a tripped breaker keeps
+// the original trigger and reason.
+func TripBreaker(trigger string, reason string, operator string) error {
+	if !breakerTriggerEnabled(trigger) {
+		log.Warnf("[BREAKER] Not tripping signing breaker on disabled trigger %s: %s", trigger, reason)
+		return nil
+	}
+
+	breaker, err := SigningBreaker(DB)
+	if err != nil {
+		log.Error("[BREAKER] Error reading signing breaker: ", err)
+		return err
+	}
+	if breaker.Tripped {
+		log.Debugf("[BREAKER] Signing breaker already tripped, ignoring %s: %s", trigger, reason)
+		return nil
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"tripped":    true,
+			"trigger":    trigger,
+			"reason":     reason,
+			"tripped_by": operator,
+			"tripped_at": now,
+			"updated_at": now,
+		},
+	}
+	if err := DB.UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, update); err != nil {
+		log.Error("[BREAKER] Error tripping signing breaker: ", err)
+		return err
+	}
+	log.Errorf("[BREAKER] Signing breaker tripped by %s on %s: %s", operator, trigger, reason)
+
+	RaiseAlert(models.Alert{
+		Key:       models.AlertConditionBreakerTripped + ":" + trigger,
+		Condition: models.AlertConditionBreakerTripped,
+		Severity:  models.AlertSeverityCritical,
+		Service:   "BREAKER",
+		Summary:   fmt.Sprintf("Signing halted on %s: %s", trigger, reason),
+		Details:   map[string]string{"trigger": trigger, "reason": reason, "tripped_by": operator},
+	})
+
+	return RecordAudit(models.AuditLog{
+		Action:     models.AuditActionTripBreaker,
+		Operator:   operator,
+		Collection: models.CollectionCircuitBreakers,
+		DocumentId: models.SigningBreakerId,
+		StatusFrom: models.BreakerStatusReset,
+		StatusTo:   models.BreakerStatusTripped,
+		Reason:     trigger + ": " + reason,
+	})
+}
+
+// ResetBreaker lets the validators sign again
+func ResetBreaker(operator string, reason string) error {
+	breaker, err := SigningBreaker(DB)
+	if err != nil {
+		return err
+	}
+	if !breaker.Tripped {
+		return ErrBreakerNotTripped
+	}
+
+	now := time.Now()
+	filter := bson.M{"_id": models.SigningBreakerId, "tripped": true}
+	update := bson.M{
+		"$set": bson.M{
+			"tripped":      false,
+			"reset_by":     operator,
+			"reset_reason": reason,
+			"reset_at":     now,
+			"updated_at":   now,
+		},
+	}
+	err = DB.UpdateOne(models.CollectionCircuitBreakers, filter, update)
+	if errors.Is(err, ErrNotMatched) {
+		return ErrBreakerNotTripped
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("[BREAKER] Signing breaker reset by %s: %s", operator, reason)
+
+	return RecordAudit(models.AuditLog{
+		Action:     models.AuditActionResetBreaker,
+		Operator:   operator,
+		Collection: models.CollectionCircuitBreakers,
+		DocumentId: models.SigningBreakerId,
+		StatusFrom: models.BreakerStatusTripped,
+		StatusTo:   models.BreakerStatusReset,
+		Reason:     reason,
+	})
+}
+
+// ValidatorOperator names this validator in the audit log of the changes it makes on its own
+func ValidatorOperator() string {
+	logMu.RLock()
+	defer logMu.RUnlock()
+
+	if logValidatorId != "" {
+		return logValidatorId
+	}
+	return SecretOperator
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestSigningBreaker(t *testing.T) {
+	setup := func(t *testing.T) *MockDatabase {
+		Config = models.Config{}
+		Config.CircuitBreaker = models.CircuitBreakerConfig{
+			SolvencyMismatch: true,
+			UnexpectedMint:   true,
+			VolumeLimit:      true,
+			Paused:           true,
+		}
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		t.Cleanup(func() {
+			Config = models.Config{}
+			DB = nil
+		})
+		return mockDB
+	}
+
+	filter := bson.M{"_id": models.SigningBreakerId}
+
+	expectBreaker := func(mockDB *MockDatabase, breaker *models.CircuitBreaker, err error) {
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, filter, mock.Anything).Return(err).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				if breaker != nil {
+					*result.(*models.CircuitBreaker) = *breaker
+				}
+			}).Once()
+	}
+
+	auditFor := func(action string, statusTo string) interface{} {
+		return mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == action && audit.StatusTo == statusTo && audit.Operator == "alice" &&
+				audit.DocumentId == models.SigningBreakerId
+		})
+	}
+
+	t.Run("Never tripped", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, nil, mongo.ErrNoDocuments)
+
+		assert.False(t, SigningHalted(mockDB, "TEST"))
+	})
+
+	t.Run("Tripped", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, &models.CircuitBreaker{Id: models.SigningBreakerId, Tripped: true, TrippedAt: time.Now()}, nil)
+
+		assert.True(t, SigningHalted(mockDB, "TEST"))
+	})
+
+	t.Run("Fails closed", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, nil, errors.New("error"))
+
+		assert.True(t, SigningHalted(mockDB, "TEST"))
+	})
+
+	t.Run("Trips", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, nil, mongo.ErrNoDocuments)
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, filter, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, true, set["tripped"])
+				assert.Equal(t, models.BreakerTriggerPaused, set["trigger"])
+				assert.Equal(t, "contract paused", set["reason"])
+				assert.Equal(t, "alice", set["tripped_by"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionTripBreaker, models.BreakerStatusTripped)).Return(nil).Once()
+
+		assert.Nil(t, TripBreaker(models.BreakerTriggerPaused, "contract paused", "alice"))
+	})
+
+	t.Run("Already tripped", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, &models.CircuitBreaker{Id: models.SigningBreakerId, Tripped: true}, nil)
+
+		assert.Nil(t, TripBreaker(models.BreakerTriggerSolvency, "off by 100", "alice"))
+	})
+
+	t.Run("Disabled trigger", func(t *testing.T) {
+		setup(t)
+		Config.CircuitBreaker.UnexpectedMint = false
+
+		assert.Nil(t, TripBreaker(models.BreakerTriggerUnexpectedMint, "unknown mint", "alice"))
+	})
+
+	t.Run("Error tripping", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, nil, mongo.ErrNoDocuments)
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, filter, mock.Anything).Return(errors.New("error")).Once()
+
+		assert.NotNil(t, TripBreaker(models.BreakerTriggerManual, "investigating", "alice"))
+	})
+
+	t.Run("Resets", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, &models.CircuitBreaker{Id: models.SigningBreakerId, Tripped: true}, nil)
+		mockDB.EXPECT().UpdateOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId, "tripped": true}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, false, set["tripped"])
+				assert.Equal(t, "alice", set["reset_by"])
+				assert.Equal(t, "vault reconciled", set["reset_reason"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionResetBreaker, models.BreakerStatusReset)).Return(nil).Once()
+
+		assert.Nil(t, ResetBreaker("alice", "vault reconciled"))
+	})
+
+	t.Run("Reset when not tripped", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, nil, mongo.ErrNoDocuments)
+
+		assert.Equal(t, ErrBreakerNotTripped, ResetBreaker("alice", "vault reconciled"))
+	})
+
+	t.Run("Reset by another operator meanwhile", func(t *testing.T) {
+		mockDB := setup(t)
+		expectBreaker(mockDB, &models.CircuitBreaker{Id: models.SigningBreakerId, Tripped: true}, nil)
+		mockDB.EXPECT().UpdateOne(models.CollectionCircuitBreakers, mock.Anything, mock.Anything).Return(ErrNotMatched).Once()
+
+		assert.Equal(t, ErrBreakerNotTripped, ResetBreaker("alice", "vault reconciled"))
+	})
+}
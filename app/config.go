@@ -1,7 +1,11 @@
 package app
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
@@ -11,117 +15,95 @@ import (
 
 var (
 	Config models.Config
+
+	// configMu guards the fields of Config that change while the validator runs
+	configMu sync.RWMutex
 )
 
+// LiveConfig returns a copy of the config that is safe to read while a reload or a secret
+// rotation changes it. Fields that only change on restart can be read from Config directly.
+func LiveConfig() models.Config {
+	configMu.RLock()
+	defer configMu.RUnlock()
+
+	return Config
+}
+
 func InitConfig(configFile string, envFile string) {
 	log.Debug("[CONFIG] Initializing config")
-	readConfigFromConfigFile(configFile)
-	readConfigFromENV(envFile)
-	readKeysFromGSM()
+	LoadConfig(configFile, envFile)
 	validateConfig()
 	log.Info("[CONFIG] Config initialized")
 }
 
+// LoadConfig reads the config file, env and secrets without validating them
+func LoadConfig(configFile string, envFile string) {
+	loadEnvFile(envFile)
+	readConfigFromConfigFile(configFile)
+	readConfigFromENV(envFile)
+	refs, err := readSecrets(&Config)
+	if err != nil {
+		log.Fatal("[SECRETS] ", err.Error())
+	}
+	setSecretRefs(refs)
+}
+
 func readConfigFromConfigFile(configFile string) bool {
 	if configFile == "" {
 		log.Debug("[CONFIG] No config file provided")
 		return false
 	}
 	log.Debugf("[CONFIG] Reading config file %s", configFile)
-	var yamlFile, err = os.ReadFile(configFile)
-	if err != nil {
-		log.Fatalf("[CONFIG] Error reading config file %q: %s\n", configFile, err.Error())
-	}
-	err = yaml.Unmarshal(yamlFile, &Config)
-	if err != nil {
-		log.Fatalf("[CONFIG] Error unmarshalling config file %q: %s\n", configFile, err.Error())
+	if err := loadConfigFile(&Config, configFile); err != nil {
+		log.Fatal("[CONFIG] ", err.Error())
 	}
 	log.Debugf("[CONFIG] Config loaded from %s", configFile)
 	return true
 }
 
-func validateConfig() {
-	log.Debug("[CONFIG] Validating config")
-	// mongodb
-	if Config.MongoDB.URI == "" {
-		log.Fatal("[CONFIG] MongoDB.URI is required")
+func loadConfigFile(config *models.Config, configFile string) error {
+	var yamlFile, err = os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("error reading config file %q: %s", configFile, err.Error())
 	}
-	if Config.MongoDB.Database == "" {
-		log.Fatal("[CONFIG] MongoDB.Database is required")
+	yamlFile, err = interpolateEnv(yamlFile)
+	if err != nil {
+		return fmt.Errorf("error interpolating config file %q: %s", configFile, err.Error())
 	}
-	if Config.MongoDB.TimeoutMillis == 0 {
-		log.Fatal("[CONFIG] MongoDB.TimeoutMillis is required")
+	err = yaml.Unmarshal(yamlFile, config)
+	if err != nil {
+		return fmt.Errorf("error unmarshalling config file %q: %s", configFile, err.Error())
 	}
+	return nil
+}
 
-	// ethereum
-	if Config.Ethereum.RPCURL == "" {
-		log.Fatal("[CONFIG] Ethereum.RPCURL is required")
-	}
-	if Config.Ethereum.ChainId == "" {
-		log.Fatal("[CONFIG] Ethereum.ChainId is required")
-	}
-	if Config.Ethereum.RPCTimeoutMillis == 0 {
-		log.Fatal("[CONFIG] Ethereum.RPCTimeoutMillis is required")
-	}
-	if Config.Ethereum.PrivateKey == "" {
-		log.Fatal("[CONFIG] Ethereum.PrivateKey is required")
-	}
-	if Config.Ethereum.WrappedPocketAddress == "" {
-		log.Fatal("[CONFIG] Ethereum.WrappedPocketAddress is required")
-	}
-	if Config.Ethereum.MintControllerAddress == "" {
-		log.Fatal("[CONFIG] Ethereum.MintControllerAddress is required")
-	}
-	if Config.Ethereum.ValidatorAddresses == nil || len(Config.Ethereum.ValidatorAddresses) == 0 {
-		log.Fatal("[CONFIG] Ethereum.ValidatorAddresses is required")
-	}
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
 
-	// pocket
-	if Config.Pocket.RPCURL == "" {
-		log.Fatal("[CONFIG] Pocket.RPCURL is required")
-	}
-	if Config.Pocket.ChainId == "" {
-		log.Fatal("[CONFIG] Pocket.ChainId is required")
-	}
-	if Config.Pocket.RPCTimeoutMillis == 0 {
-		log.Fatal("[CONFIG] Pocket.RPCTimeoutMillis is required")
-	}
-	if Config.Pocket.PrivateKey == "" {
-		log.Fatal("[CONFIG] Pocket.PrivateKey is required")
-	}
-	if Config.Pocket.TxFee == 0 {
-		log.Fatal("[CONFIG] Pocket.TxFee is required")
-	}
-	if Config.Pocket.VaultAddress == "" {
-		log.Fatal("[CONFIG] Pocket.VaultAddress is required")
-	}
-	if Config.Pocket.MultisigPublicKeys == nil || len(Config.Pocket.MultisigPublicKeys) == 0 {
-		log.Fatal("[CONFIG] Pocket.MultisigPublicKeys is required")
-	}
+// interpolateEnv replaces each ${VAR} with the value of the env variable VAR
+func interpolateEnv(data []byte) ([]byte, error) {
+	var missing []string
+	result := envReference.ReplaceAllFunc(data, func(match []byte) []byte {
+		name := string(envReference.FindSubmatch(match)[1])
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return []byte(value)
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("env variables not set: %s", strings.Join(missing, ", "))
+	}
+	return result, nil
+}
 
-	// services
-	if Config.MintMonitor.Enabled && Config.MintMonitor.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] MintMonitor.Interval is required")
-	}
-	if Config.MintSigner.Enabled && Config.MintSigner.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] MintSigner.Interval is required")
-	}
-	if Config.MintExecutor.Enabled && Config.MintExecutor.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] MintExecutor.Interval is required")
-	}
-	if Config.BurnMonitor.Enabled && Config.BurnMonitor.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] BurnMonitor.Interval is required")
-	}
-	if Config.BurnSigner.Enabled && Config.BurnSigner.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] BurnSigner.Interval is required")
+func validateConfig() {
+	log.Debug("[CONFIG] Validating config")
+	errs := ValidateConfig(Config)
+	for _, err := range errs {
+		log.Error("[CONFIG] ", err.Error())
 	}
-	if Config.BurnExecutor.Enabled && Config.BurnExecutor.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] BurnExecutor.Interval is required")
+	if len(errs) > 0 {
+		log.Fatalf("[CONFIG] Config is invalid, found %d problems", len(errs))
 	}
-
-	if Config.HealthCheck.IntervalMillis == 0 {
-		log.Fatal("[CONFIG] HealthCheck.Interval is required")
-	}
-
 	log.Debug("[CONFIG] Config validated")
 }
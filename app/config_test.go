@@ -23,7 +23,7 @@ func TestReadConfigFromConfigFile(t *testing.T) {
 
 		assert.Equal(t, read, true)
 		assert.Equal(t, Config.MongoDB.Database, "mongodb-database")
-		assert.Equal(t, Config.MongoDB.TimeoutMillis, int64(2000))
+		assert.Equal(t, Config.MongoDB.TimeoutMillis, models.Millis(2000))
 	})
 
 	t.Run("No Config File Provided", func(t *testing.T) {
@@ -342,7 +342,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 
 		defer func() { log.StandardLogger().ExitFunc = nil }()
 		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
@@ -366,7 +365,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 
 		defer func() { log.StandardLogger().ExitFunc = nil }()
@@ -391,7 +389,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -423,7 +420,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -456,7 +452,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -490,7 +485,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -525,7 +519,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -561,7 +554,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -598,7 +590,6 @@ func TestValidateConfig(t *testing.T) {
 		Config.Pocket.ChainId = "localnet"
 		Config.Pocket.RPCTimeoutMillis = 2000
 		Config.Pocket.PrivateKey = "abcd"
-		Config.Pocket.TxFee = 10000
 		Config.Pocket.VaultAddress = "0x1234"
 		Config.Pocket.MultisigPublicKeys = []string{"1234"}
 		Config.MintMonitor.Enabled = true
@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"crypto/rand"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/dan13ram/wpokt-validator/models"
@@ -12,8 +14,6 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
-
-	lock "github.com/square/mongo-lock"
 )
 
 type Database interface {
@@ -26,32 +26,42 @@ type Database interface {
 	UpdateOne(collection string, filter interface{}, update interface{}) error
 	UpsertOne(collection string, filter interface{}, update interface{}) error
 
-	XLock(resourceId string) (string, error)
+	XLock(resourceId string) (string, int64, error)
 	SLock(resourceId string) (string, error)
 	Unlock(lockId string) error
+	RenewLock(lockId string) error
 }
 
 // MongoDatabase is a wrapper around the mongo database
 type MongoDatabase struct {
+	mu       sync.RWMutex
 	db       *mongo.Database
 	uri      string
 	database string
-	locker   *lock.Client
+	locks    *LockManager
 }
 
 var (
 	DB Database
+
+	// ErrNotMatched is returned when an update matches no document, such as a write rejected by its lock_token fence
+	ErrNotMatched = errors.New("no document matched the update")
 )
 
+func connectClient(uri string) (*mongo.Client, error) {
+	wcMajority := writeconcern.New(writeconcern.WMajority(), writeconcern.WTimeout(Config.MongoDB.TimeoutMillis.Duration()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
+	defer cancel()
+
+	return mongo.Connect(ctx, options.Client().ApplyURI(uri).SetWriteConcern(wcMajority))
+}
+
 // Connect connects to the database
 func (d *MongoDatabase) Connect() error {
 	log.Debug("[DB] Connecting to database")
-	wcMajority := writeconcern.New(writeconcern.WMajority(), writeconcern.WTimeout(time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond))
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(d.uri).SetWriteConcern(wcMajority))
+	client, err := connectClient(d.uri)
 	if err != nil {
 		return err
 	}
@@ -61,21 +71,63 @@ func (d *MongoDatabase) Connect() error {
 	return nil
 }
 
-// SetupLocker sets up the locker
-func (d *MongoDatabase) SetupLocker() error {
-	log.Debug("[DB] Setting up locker")
-	var locker *lock.Client
+// Reconnect connects with a new uri and moves every operation and held lock over to the
+// new connection. The old connection is closed once in-flight operations had time to finish.
+func (d *MongoDatabase) Reconnect(uri string) error {
+	log.Debug("[DB] Reconnecting to database")
+
+	client, err := connectClient(uri)
+	if err != nil {
+		return err
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
+	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background())
+		return err
+	}
 
-	locker = lock.NewClient(d.db.Collection("locks"))
-	err := locker.CreateIndexes(ctx)
+	d.mu.Lock()
+	old := d.db
+	d.db = client.Database(d.database)
+	d.uri = uri
+	if d.locks != nil {
+		d.locks.SetDatabase(d.db)
+	}
+	d.mu.Unlock()
+
+	grace := Config.MongoDB.TimeoutMillis.Duration()
+	time.AfterFunc(grace, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
+		defer cancel()
+		if err := old.Client().Disconnect(ctx); err != nil {
+			log.Error("[DB] Error disconnecting previous connection: ", err)
+		}
+	})
+
+	log.Info("[DB] Reconnected to mongo database: ", d.database)
+	return nil
+}
+
+func (d *MongoDatabase) mongo() *mongo.Database {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.db
+}
+
+// SetupLocker sets up the lock manager and starts renewing leases
+func (d *MongoDatabase) SetupLocker() error {
+	log.Debug("[DB] Setting up locker")
+
+	locks, err := NewLockManager(d.db)
 	if err != nil {
 		return err
 	}
+	locks.Start()
 
-	d.locker = locker
+	d.locks = locks
 
 	log.Info("[DB] Locker setup")
 	return nil
@@ -95,43 +147,24 @@ func randomString(n int) (string, error) {
 	return string(bytes), nil
 }
 
-// XLock locks a resource for exclusive access
-func (d *MongoDatabase) XLock(resourceId string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
-
-	lockId, err := randomString(32)
-	if err != nil {
-		return "", err
-	}
-	err = d.locker.XLock(ctx, resourceId, lockId, lock.LockDetails{
-		TTL: 60, // locks expire in 60 seconds
-	})
-	return lockId, err
+// XLock locks a resource for exclusive access and returns a fencing token for guarded writes
+func (d *MongoDatabase) XLock(resourceId string) (string, int64, error) {
+	return d.locks.XLock(resourceId)
 }
 
 // SLock locks a resource for shared access
 func (d *MongoDatabase) SLock(resourceId string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
-
-	lockId, err := randomString(32)
-	if err != nil {
-		return "", err
-	}
-	err = d.locker.SLock(ctx, resourceId, lockId, lock.LockDetails{
-		TTL: 60, // locks expire in 60 seconds
-	}, -1)
-	return lockId, err
+	return d.locks.SLock(resourceId)
 }
 
 // Unlock unlocks a resource
 func (d *MongoDatabase) Unlock(lockId string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
+	return d.locks.Unlock(lockId)
+}
 
-	_, err := d.locker.Unlock(ctx, lockId)
-	return err
+// RenewLock extends a held lock, failing with ErrLeaseLost when it is no longer held
+func (d *MongoDatabase) RenewLock(lockId string) error {
+	return d.locks.Renew(lockId)
 }
 
 // Setup Indexes
@@ -140,9 +173,9 @@ func (d *MongoDatabase) SetupIndexes() error {
 
 	// setup unique index for mints
 	log.Debug("[DB] Setting up indexes for mints")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err := d.db.Collection(models.CollectionMints).Indexes().CreateOne(ctx, mongo.IndexModel{
+	_, err := d.mongo().Collection(models.CollectionMints).Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys:    bson.D{{Key: "transaction_hash", Value: 1}},
 		Options: options.Index().SetUnique(true),
 	})
@@ -152,9 +185,9 @@ func (d *MongoDatabase) SetupIndexes() error {
 
 	// setup unique index for invalid mints
 	log.Debug("[DB] Setting up indexes for invalid mints")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel = context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err = d.db.Collection(models.CollectionInvalidMints).Indexes().CreateOne(ctx, mongo.IndexModel{
+	_, err = d.mongo().Collection(models.CollectionInvalidMints).Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys:    bson.D{{Key: "transaction_hash", Value: 1}},
 		Options: options.Index().SetUnique(true),
 	})
@@ -164,9 +197,9 @@ func (d *MongoDatabase) SetupIndexes() error {
 
 	// setup unique index for burns
 	log.Debug("[DB] Setting up indexes for burns")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel = context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err = d.db.Collection(models.CollectionBurns).Indexes().CreateOne(ctx, mongo.IndexModel{
+	_, err = d.mongo().Collection(models.CollectionBurns).Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys:    bson.D{{Key: "transaction_hash", Value: 1}, {Key: "log_index", Value: 1}},
 		Options: options.Index().SetUnique(true),
 	})
@@ -174,11 +207,35 @@ func (d *MongoDatabase) SetupIndexes() error {
 		return err
 	}
 
+	// setup unique index for fees collected
+	log.Debug("[DB] Setting up indexes for fees collected")
+	ctx, cancel = context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
+	defer cancel()
+	_, err = d.mongo().Collection(models.CollectionFeesCollected).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "transaction_hash", Value: 1}, {Key: "log_index", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	// setup unique index for stuck transfers
+	log.Debug("[DB] Setting up indexes for stuck transfers")
+	ctx, cancel = context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
+	defer cancel()
+	_, err = d.mongo().Collection(models.CollectionStuckTransfers).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "doc_type", Value: 1}, {Key: "document_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
 	// setup unique index for healthchecks
 	log.Debug("[DB] Setting up indexes for healthchecks")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel = context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err = d.db.Collection(models.CollectionHealthChecks).Indexes().CreateOne(ctx, mongo.IndexModel{
+	_, err = d.mongo().Collection(models.CollectionHealthChecks).Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys:    bson.D{{Key: "validator_id", Value: 1}, {Key: "hostname", Value: 1}},
 		Options: options.Index().SetUnique(true),
 	})
@@ -194,34 +251,37 @@ func (d *MongoDatabase) SetupIndexes() error {
 // Disconnect disconnects from the database
 func (d *MongoDatabase) Disconnect() error {
 	log.Debug("[DB] Disconnecting from database")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	if d.locks != nil {
+		d.locks.Stop()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	err := d.db.Client().Disconnect(ctx)
+	err := d.mongo().Client().Disconnect(ctx)
 	log.Info("[DB] Disconnected from database")
 	return err
 }
 
 // method for insert single value in a collection
 func (d *MongoDatabase) InsertOne(collection string, data interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err := d.db.Collection(collection).InsertOne(ctx, data)
+	_, err := d.mongo().Collection(collection).InsertOne(ctx, data)
 	return err
 }
 
 // method for find single value in a collection
 func (d *MongoDatabase) FindOne(collection string, filter interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	err := d.db.Collection(collection).FindOne(ctx, filter).Decode(result)
+	err := d.mongo().Collection(collection).FindOne(ctx, filter).Decode(result)
 	return err
 }
 
 // method for find multiple values in a collection
 func (d *MongoDatabase) FindMany(collection string, filter interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	cursor, err := d.db.Collection(collection).Find(ctx, filter)
+	cursor, err := d.mongo().Collection(collection).Find(ctx, filter)
 	if err != nil {
 		return err
 	}
@@ -231,19 +291,25 @@ func (d *MongoDatabase) FindMany(collection string, filter interface{}, result i
 
 // method for update single value in a collection
 func (d *MongoDatabase) UpdateOne(collection string, filter interface{}, update interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
-	_, err := d.db.Collection(collection).UpdateOne(ctx, filter, update)
-	return err
+	result, err := d.mongo().Collection(collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotMatched
+	}
+	return nil
 }
 
 // method for upsert single value in a collection
 func (d *MongoDatabase) UpsertOne(collection string, filter interface{}, update interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), Config.MongoDB.TimeoutMillis.Duration())
 	defer cancel()
 
 	opts := options.Update().SetUpsert(true)
-	_, err := d.db.Collection(collection).UpdateOne(ctx, filter, update, opts)
+	_, err := d.mongo().Collection(collection).UpdateOne(ctx, filter, update, opts)
 	return err
 }
 
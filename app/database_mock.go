@@ -230,6 +230,48 @@ func (_c *MockDatabase_InsertOne_Call) RunAndReturn(run func(string, interface{}
 	return _c
 }
 
+// RenewLock provides a mock function with given fields: lockId
+func (_m *MockDatabase) RenewLock(lockId string) error {
+	ret := _m.Called(lockId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(lockId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_RenewLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewLock'
+type MockDatabase_RenewLock_Call struct {
+	*mock.Call
+}
+
+// RenewLock is a helper method to define mock.On call
+//   - lockId string
+func (_e *MockDatabase_Expecter) RenewLock(lockId interface{}) *MockDatabase_RenewLock_Call {
+	return &MockDatabase_RenewLock_Call{Call: _e.mock.On("RenewLock", lockId)}
+}
+
+func (_c *MockDatabase_RenewLock_Call) Run(run func(lockId string)) *MockDatabase_RenewLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RenewLock_Call) Return(_a0 error) *MockDatabase_RenewLock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_RenewLock_Call) RunAndReturn(run func(string) error) *MockDatabase_RenewLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SLock provides a mock function with given fields: resourceId
 func (_m *MockDatabase) SLock(resourceId string) (string, error) {
 	ret := _m.Called(resourceId)
@@ -413,12 +455,13 @@ func (_c *MockDatabase_UpsertOne_Call) RunAndReturn(run func(string, interface{}
 }
 
 // XLock provides a mock function with given fields: resourceId
-func (_m *MockDatabase) XLock(resourceId string) (string, error) {
+func (_m *MockDatabase) XLock(resourceId string) (string, int64, error) {
 	ret := _m.Called(resourceId)
 
 	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (string, int64, error)); ok {
 		return rf(resourceId)
 	}
 	if rf, ok := ret.Get(0).(func(string) string); ok {
@@ -427,13 +470,19 @@ func (_m *MockDatabase) XLock(resourceId string) (string, error) {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(string) int64); ok {
 		r1 = rf(resourceId)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(resourceId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockDatabase_XLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XLock'
@@ -454,12 +503,12 @@ func (_c *MockDatabase_XLock_Call) Run(run func(resourceId string)) *MockDatabas
 	return _c
 }
 
-func (_c *MockDatabase_XLock_Call) Return(_a0 string, _a1 error) *MockDatabase_XLock_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatabase_XLock_Call) Return(_a0 string, _a1 int64, _a2 error) *MockDatabase_XLock_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockDatabase_XLock_Call) RunAndReturn(run func(string) (string, error)) *MockDatabase_XLock_Call {
+func (_c *MockDatabase_XLock_Call) RunAndReturn(run func(string) (string, int64, error)) *MockDatabase_XLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracedDatabase traces every operation of the database as a child of a span
+type tracedDatabase struct {
+	db  Database
+	ctx context.Context
+}
+
+// TracedDB returns the database with every operation traced as a child of the span in ctx
+func TracedDB(ctx context.Context) Database {
+	return &tracedDatabase{db: DB, ctx: ctx}
+}
+
+func (d *tracedDatabase) span(operation string, attrs ...attribute.KeyValue) func(error) {
+	_, span := StartSpan(d.ctx, "db."+operation, append([]attribute.KeyValue{attribute.String("db.system", "mongodb")}, attrs...)...)
+	return func(err error) {
+		EndSpan(span, err)
+	}
+}
+
+func (d *tracedDatabase) Connect() error {
+	end := d.span("Connect")
+	err := d.db.Connect()
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) Disconnect() error {
+	end := d.span("Disconnect")
+	err := d.db.Disconnect()
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) InsertOne(collection string, data interface{}) error {
+	end := d.span("InsertOne", attribute.String("db.collection", collection))
+	err := d.db.InsertOne(collection, data)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) FindOne(collection string, filter interface{}, result interface{}) error {
+	end := d.span("FindOne", attribute.String("db.collection", collection))
+	err := d.db.FindOne(collection, filter, result)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) FindMany(collection string, filter interface{}, result interface{}) error {
+	end := d.span("FindMany", attribute.String("db.collection", collection))
+	err := d.db.FindMany(collection, filter, result)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) UpdateOne(collection string, filter interface{}, update interface{}) error {
+	end := d.span("UpdateOne", attribute.String("db.collection", collection))
+	err := d.db.UpdateOne(collection, filter, update)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) UpsertOne(collection string, filter interface{}, update interface{}) error {
+	end := d.span("UpsertOne", attribute.String("db.collection", collection))
+	err := d.db.UpsertOne(collection, filter, update)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) XLock(resourceId string) (string, int64, error) {
+	end := d.span("XLock", attribute.String("lock.resource", resourceId))
+	lockId, token, err := d.db.XLock(resourceId)
+	end(err)
+	return lockId, token, err
+}
+
+func (d *tracedDatabase) SLock(resourceId string) (string, error) {
+	end := d.span("SLock", attribute.String("lock.resource", resourceId))
+	lockId, err := d.db.SLock(resourceId)
+	end(err)
+	return lockId, err
+}
+
+func (d *tracedDatabase) Unlock(lockId string) error {
+	end := d.span("Unlock")
+	err := d.db.Unlock(lockId)
+	end(err)
+	return err
+}
+
+func (d *tracedDatabase) RenewLock(lockId string) error {
+	end := d.span("RenewLock")
+	err := d.db.RenewLock(lockId)
+	end(err)
+	return err
+}
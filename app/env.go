@@ -1,286 +1,109 @@
 package app
 
 import (
+	"encoding"
+	"fmt"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 
+	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/joho/godotenv"
 	log "github.com/sirupsen/logrus"
 )
 
-func readConfigFromENV(envFile string) {
-	if envFile != "" {
-		err := godotenv.Load(envFile)
-		if err != nil {
-			log.Warn("[ENV] Error loading .env file: ", err.Error())
-		} else {
-			log.Debug("[ENV] .env file loaded from: ", envFile)
-		}
-	} else {
+var (
+	// envFileKeys are the variables that were set by the env file rather than the process environment
+	envFileKeys = map[string]bool{}
+)
+
+// loadEnvFile sets variables from the env file without overriding the process environment.
+// Variables that came from the env file are replaced when the file is loaded again.
+func loadEnvFile(envFile string) {
+	if envFile == "" {
 		log.Debug("[ENV] No .env file provided")
+		return
 	}
 
-	log.Debug("[ENV] Reading config from ENV variables")
-
-	if os.Getenv("MONGODB_URI") != "" {
-		Config.MongoDB.URI = os.Getenv("MONGODB_URI")
-	}
-	if os.Getenv("MONGODB_DATABASE") != "" {
-		Config.MongoDB.Database = os.Getenv("MONGODB_DATABASE")
-	}
-	if os.Getenv("MONGODB_TIMEOUT_MS") != "" {
-		timeoutMillis, err := strconv.ParseInt(os.Getenv("MONGODB_TIMEOUT_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing MONGODB_TIMEOUT_MS: ", err.Error())
-		} else {
-			Config.MongoDB.TimeoutMillis = timeoutMillis
-		}
+	values, err := godotenv.Read(envFile)
+	if err != nil {
+		log.Warn("[ENV] Error loading .env file: ", err.Error())
+		return
 	}
 
-	// ethereum
-	if os.Getenv("ETH_RPC_URL") != "" {
-		Config.Ethereum.RPCURL = os.Getenv("ETH_RPC_URL")
-	}
-	if os.Getenv("ETH_CHAIN_ID") != "" {
-		Config.Ethereum.ChainId = os.Getenv("ETH_CHAIN_ID")
-	}
-	if os.Getenv("ETH_PRIVATE_KEY") != "" {
-		Config.Ethereum.PrivateKey = os.Getenv("ETH_PRIVATE_KEY")
-	}
-	if os.Getenv("ETH_START_BLOCK_NUMBER") != "" {
-		blockNumber, err := strconv.ParseInt(os.Getenv("ETH_START_BLOCK_NUMBER"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing ETH_START_BLOCK_NUMBER: ", err.Error())
-		} else {
-			Config.Ethereum.StartBlockNumber = blockNumber
-		}
-	}
-	if os.Getenv("ETH_CONFIRMATIONS") != "" {
-		confirmations, err := strconv.ParseInt(os.Getenv("ETH_CONFIRMATIONS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing ETH_CONFIRMATIONS: ", err.Error())
-		} else {
-			Config.Ethereum.Confirmations = confirmations
+	for key, value := range values {
+		if _, ok := os.LookupEnv(key); ok && !envFileKeys[key] {
+			continue
 		}
+		envFileKeys[key] = true
+		os.Setenv(key, value)
 	}
-	if os.Getenv("ETH_RPC_TIMEOUT_MS") != "" {
-		timeoutMillis, err := strconv.ParseInt(os.Getenv("ETH_RPC_TIMEOUT_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing ETH_RPC_TIMEOUT_MS: ", err.Error())
-		} else {
-			Config.Ethereum.RPCTimeoutMillis = timeoutMillis
-		}
-	}
-	if os.Getenv("ETH_WRAPPED_POCKET_ADDRESS") != "" {
-		Config.Ethereum.WrappedPocketAddress = os.Getenv("ETH_WRAPPED_POCKET_ADDRESS")
-	}
-	if os.Getenv("ETH_MINT_CONTROLLER_ADDRESS") != "" {
-		Config.Ethereum.MintControllerAddress = os.Getenv("ETH_MINT_CONTROLLER_ADDRESS")
-	}
-	if os.Getenv("ETH_VALIDATOR_ADDRESSES") != "" {
-		Config.Ethereum.ValidatorAddresses = strings.Split(os.Getenv("ETH_VALIDATOR_ADDRESSES"), ",")
-	}
-
-	// pocket
-	if os.Getenv("POKT_RPC_URL") != "" {
-		Config.Pocket.RPCURL = os.Getenv("POKT_RPC_URL")
-	}
-	if os.Getenv("POKT_CHAIN_ID") != "" {
-		Config.Pocket.ChainId = os.Getenv("POKT_CHAIN_ID")
-	}
-	if os.Getenv("POKT_PRIVATE_KEY") != "" {
-		Config.Pocket.PrivateKey = os.Getenv("POKT_PRIVATE_KEY")
-	}
-	if os.Getenv("POKT_START_HEIGHT") != "" {
-		startHeight, err := strconv.ParseInt(os.Getenv("POKT_START_HEIGHT"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing POKT_START_HEIGHT: ", err.Error())
-		} else {
-			Config.Pocket.StartHeight = startHeight
-		}
-	}
-	if os.Getenv("POKT_CONFIRMATIONS") != "" {
-		confirmations, err := strconv.ParseInt(os.Getenv("POKT_CONFIRMATIONS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing POKT_CONFIRMATIONS: ", err.Error())
-		} else {
-			Config.Pocket.Confirmations = confirmations
-		}
-	}
-	if os.Getenv("POKT_RPC_TIMEOUT_MS") != "" {
-		timeoutMillis, err := strconv.ParseInt(os.Getenv("POKT_RPC_TIMEOUT_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing POKT_RPC_TIMEOUT_MS: ", err.Error())
-		} else {
-			Config.Pocket.RPCTimeoutMillis = timeoutMillis
-		}
-	}
-	if os.Getenv("POKT_TX_FEE") != "" {
-		txFee, err := strconv.ParseInt(os.Getenv("POKT_TX_FEE"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing POKT_TX_FEE: ", err.Error())
-		} else {
-			Config.Pocket.TxFee = txFee
-		}
-	}
-	if os.Getenv("POKT_VAULT_ADDRESS") != "" {
-		Config.Pocket.VaultAddress = os.Getenv("POKT_VAULT_ADDRESS")
-	}
-	if os.Getenv("POKT_MULTISIG_PUBLIC_KEYS") != "" {
-		multisigPublicKeys := os.Getenv("POKT_MULTISIG_PUBLIC_KEYS")
-		Config.Pocket.MultisigPublicKeys = strings.Split(multisigPublicKeys, ",")
-	}
+	log.Debug("[ENV] .env file loaded from: ", envFile)
+}
 
-	// mint monitor
-	if os.Getenv("MINT_MONITOR_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("MINT_MONITOR_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_MONITOR_ENABLED: ", err.Error())
-		} else {
-			Config.MintMonitor.Enabled = enabled
-		}
-	}
-	if os.Getenv("MINT_MONITOR_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("MINT_MONITOR_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_MONITOR_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.MintMonitor.IntervalMillis = intervalMillis
-		}
-	}
+func readConfigFromENV(envFile string) {
+	loadEnvFile(envFile)
+	readEnv(&Config)
+}
 
-	// mint signer
-	if os.Getenv("MINT_SIGNER_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("MINT_SIGNER_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_SIGNER_ENABLED: ", err.Error())
-		} else {
-			Config.MintSigner.Enabled = enabled
-		}
-	}
-	if os.Getenv("MINT_SIGNER_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("MINT_SIGNER_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_SIGNER_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.MintSigner.IntervalMillis = intervalMillis
-		}
-	}
+func readEnv(config *models.Config) {
+	log.Debug("[ENV] Reading config from ENV variables")
+	readEnvIntoStruct(reflect.ValueOf(config).Elem(), "")
+	log.Debug("[ENV] Config read from env variables")
+}
 
-	// mint executor
-	if os.Getenv("MINT_EXECUTOR_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("MINT_EXECUTOR_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_EXECUTOR_ENABLED: ", err.Error())
-		} else {
-			Config.MintExecutor.Enabled = enabled
+// readEnvIntoStruct sets each field that has an env tag from its env variable, if the variable is set
+func readEnvIntoStruct(value reflect.Value, prefix string) {
+	for i := 0; i < value.NumField(); i++ {
+		name, ok := value.Type().Field(i).Tag.Lookup("env")
+		if !ok {
+			continue
 		}
-	}
-	if os.Getenv("MINT_EXECUTOR_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("MINT_EXECUTOR_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing MINT_EXECUTOR_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.MintExecutor.IntervalMillis = intervalMillis
+		if prefix != "" {
+			name = prefix + "_" + name
 		}
-	}
 
-	// burn monitor
-	if os.Getenv("BURN_MONITOR_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("BURN_MONITOR_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_MONITOR_ENABLED: ", err.Error())
-		} else {
-			Config.BurnMonitor.Enabled = enabled
-		}
-	}
-	if os.Getenv("BURN_MONITOR_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("BURN_MONITOR_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_MONITOR_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.BurnMonitor.IntervalMillis = intervalMillis
+		field := value.Field(i)
+		if field.Kind() == reflect.Struct {
+			readEnvIntoStruct(field, name)
+			continue
 		}
-	}
 
-	// burn signer
-	if os.Getenv("BURN_SIGNER_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("BURN_SIGNER_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_SIGNER_ENABLED: ", err.Error())
-		} else {
-			Config.BurnSigner.Enabled = enabled
+		env := os.Getenv(name)
+		if env == "" {
+			continue
 		}
-	}
-	if os.Getenv("BURN_SIGNER_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("BURN_SIGNER_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_SIGNER_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.BurnSigner.IntervalMillis = intervalMillis
+		if err := setFromEnv(field, env); err != nil {
+			log.Warn("[ENV] Error parsing "+name+": ", err.Error())
 		}
 	}
+}
 
-	// burn executor
-	if os.Getenv("BURN_EXECUTOR_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("BURN_EXECUTOR_ENABLED"))
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_EXECUTOR_ENABLED: ", err.Error())
-		} else {
-			Config.BurnExecutor.Enabled = enabled
-		}
-	}
-	if os.Getenv("BURN_EXECUTOR_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("BURN_EXECUTOR_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing BURN_EXECUTOR_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.BurnExecutor.IntervalMillis = intervalMillis
-		}
+func setFromEnv(field reflect.Value, env string) error {
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(env))
 	}
 
-	// health check
-	if os.Getenv("HEALTH_CHECK_INTERVAL_MS") != "" {
-		intervalMillis, err := strconv.ParseInt(os.Getenv("HEALTH_CHECK_INTERVAL_MS"), 10, 64)
-		if err != nil {
-			log.Warn("[ENV] Error parsing HEALTH_CHECK_INTERVAL_MS: ", err.Error())
-		} else {
-			Config.HealthCheck.IntervalMillis = intervalMillis
-		}
-	}
-	if os.Getenv("HEALTH_CHECK_READ_LAST_HEALTH") != "" {
-		readLastHealth, err := strconv.ParseBool(os.Getenv("HEALTH_CHECK_READ_LAST_HEALTH"))
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(env)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(env)
 		if err != nil {
-			log.Warn("[ENV] Error parsing HEALTH_CHECK_READ_LAST_HEALTH: ", err.Error())
-		} else {
-			Config.HealthCheck.ReadLastHealth = readLastHealth
+			return err
 		}
-	}
-
-	// logging
-	if os.Getenv("LOG_LEVEL") != "" {
-		Config.Logger.Level = os.Getenv("LOG_LEVEL")
-	}
-
-	// google secret manager
-	if os.Getenv("GOOGLE_SECRET_MANAGER_ENABLED") != "" {
-		enabled, err := strconv.ParseBool(os.Getenv("GOOGLE_SECRET_MANAGER_ENABLED"))
+		field.SetBool(value)
+	case reflect.Int64:
+		value, err := strconv.ParseInt(env, 10, 64)
 		if err != nil {
-			log.Warn("[ENV] Error parsing GOOGLE_SECRET_MANAGER_ENABLED: ", err.Error())
-		} else {
-			Config.GoogleSecretManager.Enabled = enabled
+			return err
 		}
+		field.SetInt(value)
+	case reflect.Slice:
+		field.Set(reflect.ValueOf(strings.Split(env, ",")))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
 	}
-	if os.Getenv("GOOGLE_MONGO_SECRET_NAME") != "" {
-		Config.GoogleSecretManager.MongoSecretName = os.Getenv("GOOGLE_MONGO_SECRET_NAME")
-	}
-	if os.Getenv("GOOGLE_POKT_SECRET_NAME") != "" {
-		Config.GoogleSecretManager.PoktSecretName = os.Getenv("GOOGLE_POKT_SECRET_NAME")
-	}
-	if os.Getenv("GOOGLE_ETH_SECRET_NAME") != "" {
-		Config.GoogleSecretManager.EthSecretName = os.Getenv("GOOGLE_ETH_SECRET_NAME")
-	}
-
-	log.Debug("[ENV] Config read from env variables")
+	return nil
 }
@@ -2,12 +2,32 @@ package app
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"github.com/dan13ram/wpokt-validator/models"
 	log "github.com/sirupsen/logrus"
 )
 
+const SchemeGSM = "gsm"
+
+// GSMSecretProvider reads secrets from Google Secret Manager. A reference
+// gsm://projects/<project>/secrets/<name>/versions/latest reads that secret version.
+type GSMSecretProvider struct{}
+
+func (p *GSMSecretProvider) Fetch(ref *url.URL) (string, error) {
+	ctx := context.Background()
+	client, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	return accessSecretVersion(client, strings.Trim(ref.Host+ref.Path, "/"))
+}
+
 func accessSecretVersion(client *secretmanager.Client, name string) (string, error) {
 	req := &secretmanagerpb.AccessSecretVersionRequest{
 		Name: name,
@@ -21,58 +41,26 @@ func accessSecretVersion(client *secretmanager.Client, name string) (string, err
 	return string(result.Payload.Data), nil
 }
 
-func readKeysFromGSM() {
-	if Config.GoogleSecretManager.Enabled == true {
-		log.Debug("[GSM] Reading keys from Google Secret Manager")
-	} else {
+// GSMReference returns the secret reference for a Google Secret Manager secret name
+func GSMReference(name string) string {
+	return SchemeGSM + "://" + name
+}
+
+// useGSMSecretNames points the fields configured with google_secret_manager secret names
+// at those secrets
+func useGSMSecretNames(config *models.Config) {
+	if !config.GoogleSecretManager.Enabled {
 		log.Debug("[GSM] Google Secret Manager is disabled")
 		return
 	}
 
-	ctx := context.Background()
-	client, err := secretmanager.NewClient(ctx)
-	if err != nil {
-		log.Fatalf("[GSM] Failed to create secretmanager client: %v", err)
-	}
-	defer client.Close()
-
-	if Config.MongoDB.URI == "" && Config.GoogleSecretManager.MongoSecretName == "" {
-		log.Fatalf("[GSM] Mongo secret name is empty")
-	}
-
-	if Config.GoogleSecretManager.MongoSecretName != "" {
-		log.Debug("[GSM] Reading mongo uri")
-		Config.MongoDB.URI, err = accessSecretVersion(client, Config.GoogleSecretManager.MongoSecretName)
-		if err != nil {
-			log.Fatalf("[GSM] Failed to access mongo uri: %v", err)
-		}
-		log.Info("[GSM] Successfully read mongo uri")
+	if config.GoogleSecretManager.MongoSecretName != "" {
+		config.MongoDB.URI = GSMReference(config.GoogleSecretManager.MongoSecretName)
 	}
-
-	if Config.Ethereum.PrivateKey == "" && Config.GoogleSecretManager.EthSecretName == "" {
-		log.Fatalf("[GSM] Ethereum secret name is empty")
-	}
-
-	if Config.GoogleSecretManager.EthSecretName != "" {
-		log.Debug("[GSM] Reading ethereum private key")
-		Config.Ethereum.PrivateKey, err = accessSecretVersion(client, Config.GoogleSecretManager.EthSecretName)
-		if err != nil {
-			log.Fatalf("[GSM] Failed to access ethereum private key: %v", err)
-		}
-		log.Info("[GSM] Successfully read ethereum private key")
-
+	if config.GoogleSecretManager.EthSecretName != "" {
+		config.Ethereum.PrivateKey = GSMReference(config.GoogleSecretManager.EthSecretName)
 	}
-
-	if Config.Pocket.PrivateKey == "" && Config.GoogleSecretManager.PoktSecretName == "" {
-		log.Fatalf("[GSM] Pocket secret name is empty")
-	}
-
-	if Config.GoogleSecretManager.PoktSecretName != "" {
-		log.Debug("[GSM] Reading pocket private key")
-		Config.Pocket.PrivateKey, err = accessSecretVersion(client, Config.GoogleSecretManager.PoktSecretName)
-		if err != nil {
-			log.Fatalf("[GSM] Failed to access pocket private key: %v", err)
-		}
-		log.Info("[GSM] Successfully read pocket private key")
+	if config.GoogleSecretManager.PoktSecretName != "" {
+		config.Pocket.PrivateKey = GSMReference(config.GoogleSecretManager.PoktSecretName)
 	}
 }
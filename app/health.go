@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -28,7 +29,9 @@ type HealthCheckRunner struct {
 	wpoktAddress     string
 	hostname         string
 	validatorId      string
-	services         []Service
+
+	servicesMu sync.RWMutex
+	services   []Service
 }
 
 func (x *HealthCheckRunner) Status() models.RunnerStatus {
@@ -50,6 +53,9 @@ func (x *HealthCheckRunner) FindLastHealth() (models.Health, error) {
 }
 
 func (x *HealthCheckRunner) ServiceHealths() []models.ServiceHealth {
+	x.servicesMu.RLock()
+	defer x.servicesMu.RUnlock()
+
 	var serviceHealths []models.ServiceHealth
 	for _, service := range x.services {
 		serviceHealth := service.Health()
@@ -101,10 +107,38 @@ func (x *HealthCheckRunner) PostHealth() bool {
 	return true
 }
 
+func (x *HealthCheckRunner) ValidatorId() string {
+	return x.validatorId
+}
+
 func (x *HealthCheckRunner) SetServices(services []Service) {
+	x.servicesMu.Lock()
+	defer x.servicesMu.Unlock()
+
 	x.services = services
 }
 
+// ValidatorId names the validator by the position of its pokt key in the multisig public keys,
+// so every replica of a validator has the same id
+func ValidatorId() (string, error) {
+	pk, err := poktCrypto.NewPrivateKey(Config.Pocket.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("error parsing pokt private key: %w", err)
+	}
+	address := pk.PublicKey().Address().String()
+
+	for i, key := range Config.Pocket.MultisigPublicKeys {
+		p, err := poktCrypto.NewPublicKey(key)
+		if err != nil {
+			return "", fmt.Errorf("error parsing multisig public key: %w", err)
+		}
+		if p.Address().String() == address {
+			return fmt.Sprintf("wpokt-validator-%02d", i+1), nil
+		}
+	}
+	return "", errors.New("multisig public keys do not contain signer")
+}
+
 func NewHealthCheck() *HealthCheckRunner {
 	log.Debug("[HEALTH] Initializing health")
 
@@ -124,24 +158,19 @@ func NewHealthCheck() *HealthCheckRunner {
 	poktAddress := pk.PublicKey().Address().String()
 
 	var pks []poktCrypto.PublicKey
-	signerIndex := -1
 	for _, pk := range Config.Pocket.MultisigPublicKeys {
 		p, err := poktCrypto.NewPublicKey(pk)
 		if err != nil {
 			log.Fatal("[HEALTH] Error parsing multisig public key: ", err)
 		}
 		pks = append(pks, p)
-		if p.Address().String() == poktAddress {
-			signerIndex = len(pks)
-		}
 	}
 
-	if signerIndex == -1 {
-		log.Fatal("[HEALTH] Multisig public keys do not contain signer")
+	validatorId, err := ValidatorId()
+	if err != nil {
+		log.Fatal("[HEALTH] Error naming validator: ", err)
 	}
 
-	validatorId := "wpokt-validator-" + fmt.Sprintf("%02d", signerIndex)
-
 	hostname, err := os.Hostname()
 	if err != nil {
 		log.Fatal("[HEALTH] Error getting hostname: ", err)
@@ -171,6 +200,6 @@ func NewHealthCheck() *HealthCheckRunner {
 }
 
 func NewHealthService(x *HealthCheckRunner, wg *sync.WaitGroup) Service {
-	service := NewRunnerService(HealthCheckName, x, wg, time.Duration(Config.HealthCheck.IntervalMillis)*time.Millisecond)
-	return service
+	// every replica reports its own health, leader or not
+	return NewRunnerService(HealthCheckName, x, wg, Config.HealthCheck.IntervalMillis.Duration(), WithoutLeaderElection())
 }
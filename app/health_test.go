@@ -85,6 +85,12 @@ func (e *MockService) Start() {}
 func (e *MockService) Stop() {
 }
 
+func (e *MockService) Done() <-chan struct{} {
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
 const MockServiceName = "mock"
 
 func (e *MockService) Health() models.ServiceHealth {
@@ -332,3 +338,31 @@ func TestNewHealthCheck(t *testing.T) {
 
 	})
 }
+
+func TestValidatorId(t *testing.T) {
+	Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
+
+	t.Run("Position in the multisig", func(t *testing.T) {
+		Config.Pocket.MultisigPublicKeys = []string{
+			"ec69e25c0f2d79e252c1fe0eb8ae07c3a3d8ff7bd616d736f2ded2e9167488b2",
+			"eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743",
+			"abc364918abe9e3966564f60baf74d7ea1c4f3efe92889de066e617989c54283",
+		}
+
+		validatorId, err := ValidatorId()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "wpokt-validator-02", validatorId)
+	})
+
+	t.Run("Signer not in the multisig", func(t *testing.T) {
+		Config.Pocket.MultisigPublicKeys = []string{
+			"ec69e25c0f2d79e252c1fe0eb8ae07c3a3d8ff7bd616d736f2ded2e9167488b2",
+			"abc364918abe9e3966564f60baf74d7ea1c4f3efe92889de066e617989c54283",
+		}
+
+		_, err := ValidatorId()
+
+		assert.EqualError(t, err, "multisig public keys do not contain signer")
+	})
+}
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// LeaderElection lets replicas of the same validator run active/standby
+// by holding a lease document per validator or per service
+type LeaderElection struct {
+	candidateId string
+	validatorId string
+	hostname    string
+	scope       string
+	ttl         time.Duration
+
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+var (
+	Leader *LeaderElection
+)
+
+func (l *LeaderElection) leaseId(service string) string {
+	if l.scope == models.LeaderScopeService {
+		return fmt.Sprintf("%s/%s", l.validatorId, service)
+	}
+	return l.validatorId
+}
+
+// RenewInterval is how often a leader renews its lease while it runs
+func (l *LeaderElection) RenewInterval() time.Duration {
+	return l.ttl / 3
+}
+
+// Campaign acquires or renews the lease for a service and reports whether this replica leads it
+func (l *LeaderElection) Campaign(service string) bool {
+	leaseId := l.leaseId(service)
+	now := time.Now()
+
+	filter := bson.M{
+		"_id": leaseId,
+		"$or": []bson.M{
+			{"holder": l.candidateId},
+			{"expires_at": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"validator_id": l.validatorId,
+			"holder":       l.candidateId,
+			"hostname":     l.hostname,
+			"expires_at":   now.Add(l.ttl),
+			"updated_at":   now,
+		},
+	}
+
+	// an unexpired lease held by another replica makes the upsert collide on _id
+	err := DB.UpsertOne(models.CollectionLeaders, filter, update)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			log.Errorf("[LEADER] Error campaigning for %s: %s", leaseId, err)
+		}
+		if l.held[leaseId] {
+			log.Warnf("[LEADER] Lost leadership of %s", leaseId)
+		}
+		delete(l.held, leaseId)
+		return false
+	}
+
+	if !l.held[leaseId] {
+		log.Infof("[LEADER] Became leader of %s", leaseId)
+	}
+	l.held[leaseId] = true
+	return true
+}
+
+// Resign expires every lease held by this replica so a standby can take over immediately
+func (l *LeaderElection) Resign() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for leaseId := range l.held {
+		filter := bson.M{
+			"_id":    leaseId,
+			"holder": l.candidateId,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"expires_at": time.Now(),
+				"updated_at": time.Now(),
+			},
+		}
+		err := DB.UpdateOne(models.CollectionLeaders, filter, update)
+		if errors.Is(err, ErrNotMatched) {
+			log.Warnf("[LEADER] Lease %s was already taken over", leaseId)
+			continue
+		}
+		if err != nil {
+			log.Errorf("[LEADER] Error resigning from %s: %s", leaseId, err)
+			continue
+		}
+		log.Infof("[LEADER] Resigned from %s", leaseId)
+	}
+	l.held = make(map[string]bool)
+}
+
+// InitLeaderElection sets up leader election for the given validator when enabled
+func InitLeaderElection(validatorId string) {
+	if !Config.LeaderElection.Enabled {
+		log.Debug("[LEADER] Leader election is disabled")
+		return
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Fatal("[LEADER] Error getting hostname: ", err)
+	}
+
+	suffix, err := randomString(8)
+	if err != nil {
+		log.Fatal("[LEADER] Error generating candidate id: ", err)
+	}
+
+	Leader = &LeaderElection{
+		candidateId: fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), suffix),
+		validatorId: validatorId,
+		hostname:    hostname,
+		scope:       Config.LeaderElection.Scope,
+		ttl:         Config.LeaderElection.LeaseTTLMillis.Duration(),
+		held:        make(map[string]bool),
+	}
+
+	log.Info("[LEADER] Leader election initialized with scope: ", Leader.scope)
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	log.SetOutput(io.Discard)
+}
+
+func NewTestLeaderElection(scope string) *LeaderElection {
+	return &LeaderElection{
+		candidateId: "candidateId",
+		validatorId: "validatorId",
+		hostname:    "hostname",
+		scope:       scope,
+		ttl:         time.Minute,
+		held:        make(map[string]bool),
+	}
+}
+
+var duplicateKeyError = mongo.WriteException{
+	WriteErrors: []mongo.WriteError{{Code: 11000}},
+}
+
+func TestLeaderCampaign(t *testing.T) {
+
+	t.Run("Acquired", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestLeaderElection(models.LeaderScopeValidator)
+
+		mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, update interface{}) {
+				assert.Equal(t, "validatorId", filter.(bson.M)["_id"])
+				assert.Equal(t, "candidateId", update.(bson.M)["$set"].(bson.M)["holder"])
+			}).Once()
+
+		leading := x.Campaign("MINT SIGNER")
+
+		assert.True(t, leading)
+		assert.True(t, x.held["validatorId"])
+	})
+
+	t.Run("Per service scope", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestLeaderElection(models.LeaderScopeService)
+
+		mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, _ interface{}) {
+				assert.Equal(t, "validatorId/MINT SIGNER", filter.(bson.M)["_id"])
+			}).Once()
+
+		leading := x.Campaign("MINT SIGNER")
+
+		assert.True(t, leading)
+	})
+
+	t.Run("Held by another replica", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestLeaderElection(models.LeaderScopeValidator)
+		x.held["validatorId"] = true
+
+		mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(duplicateKeyError).Once()
+
+		leading := x.Campaign("MINT SIGNER")
+
+		assert.False(t, leading)
+		assert.False(t, x.held["validatorId"])
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestLeaderElection(models.LeaderScopeValidator)
+
+		mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		leading := x.Campaign("MINT SIGNER")
+
+		assert.False(t, leading)
+	})
+}
+
+func TestLeaderResign(t *testing.T) {
+	mockDB := NewMockDatabase(t)
+	DB = mockDB
+	x := NewTestLeaderElection(models.LeaderScopeService)
+	x.held["validatorId/MINT SIGNER"] = true
+
+	filter := bson.M{
+		"_id":    "validatorId/MINT SIGNER",
+		"holder": "candidateId",
+	}
+	mockDB.EXPECT().UpdateOne(models.CollectionLeaders, filter, mock.Anything).Return(nil).Once()
+
+	x.Resign()
+
+	assert.Empty(t, x.held)
+}
+
+func TestRunnerServiceStandby(t *testing.T) {
+	mockDB := NewMockDatabase(t)
+	DB = mockDB
+	mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(duplicateKeyError)
+
+	Leader = NewTestLeaderElection(models.LeaderScopeValidator)
+	defer func() { Leader = nil }()
+
+	mockRunner := &MockRunner{}
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", mockRunner, wg, 100*time.Millisecond)
+	wg.Add(1)
+
+	go service.Start()
+
+	time.Sleep(250 * time.Millisecond)
+
+	service.Stop()
+
+	wg.Wait()
+
+	health := service.Health()
+	assert.Equal(t, 0, mockRunner.runs)
+	assert.False(t, health.Leader)
+	assert.True(t, health.Healthy)
+}
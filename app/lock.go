@@ -0,0 +1,315 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	lock "github.com/square/mongo-lock"
+)
+
+const (
+	CollectionLocks         = "locks"
+	CollectionFencingTokens = "fencingTokens"
+
+	DefaultLockTTLMillis = 60000
+)
+
+var (
+	// ErrLeaseLost is returned when a lock expired or was taken over before it was renewed
+	ErrLeaseLost = errors.New("lease lost")
+)
+
+// lockClient is the part of the mongo lock client used by the lock manager
+type lockClient interface {
+	XLock(ctx context.Context, resourceName, lockId string, ld lock.LockDetails) error
+	SLock(ctx context.Context, resourceName, lockId string, ld lock.LockDetails, maxConcurrent int) error
+	Unlock(ctx context.Context, lockId string) ([]lock.LockStatus, error)
+	Renew(ctx context.Context, lockId string, ttl uint) ([]lock.LockStatus, error)
+}
+
+// tokenIssuer hands out increasing fencing tokens per resource
+type tokenIssuer interface {
+	NextToken(ctx context.Context, resourceId string) (int64, error)
+}
+
+// mongoTokens issues fencing tokens from a counter document per resource
+type mongoTokens struct {
+	collection *mongo.Collection
+}
+
+func (t *mongoTokens) NextToken(ctx context.Context, resourceId string) (int64, error) {
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var result struct {
+		Token int64 `bson:"token"`
+	}
+	err := t.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": resourceId},
+		bson.M{"$inc": bson.M{"token": int64(1)}},
+		opts,
+	).Decode(&result)
+	return result.Token, err
+}
+
+// lease is a lock held by this process that is kept alive until released
+type lease struct {
+	resourceId string
+	token      int64
+}
+
+// LockManager acquires locks, issues fencing tokens and renews held leases in the background
+type LockManager struct {
+	clientMu sync.RWMutex
+	locker   lockClient
+	tokens   tokenIssuer
+
+	ttl           time.Duration
+	renewInterval time.Duration
+	timeout       time.Duration
+
+	mu     sync.Mutex
+	leases map[string]*lease
+
+	stop    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// LockTokenFilter matches documents that have not been written under a newer fencing token
+func LockTokenFilter(token int64) bson.M {
+	return bson.M{"$not": bson.M{"$gt": token}}
+}
+
+// SetDatabase moves the manager to another connection, keeping the leases it holds
+func (m *LockManager) SetDatabase(db *mongo.Database) {
+	m.clientMu.Lock()
+	defer m.clientMu.Unlock()
+
+	m.locker = lock.NewClient(db.Collection(CollectionLocks))
+	m.tokens = &mongoTokens{db.Collection(CollectionFencingTokens)}
+}
+
+func (m *LockManager) client() (lockClient, tokenIssuer) {
+	m.clientMu.RLock()
+	defer m.clientMu.RUnlock()
+
+	return m.locker, m.tokens
+}
+
+func (m *LockManager) ttlSeconds() uint {
+	ttl := uint(m.ttl / time.Second)
+	if ttl == 0 {
+		ttl = 1
+	}
+	return ttl
+}
+
+// nextToken increments and returns the fencing token for a resource
+func (m *LockManager) nextToken(resourceId string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	_, tokens := m.client()
+	return tokens.NextToken(ctx, resourceId)
+}
+
+// XLock locks a resource for exclusive access and returns the lock id with a fencing token
+func (m *LockManager) XLock(resourceId string) (string, int64, error) {
+	lockId, err := randomString(32)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	locker, _ := m.client()
+	err = locker.XLock(ctx, resourceId, lockId, lock.LockDetails{
+		TTL: m.ttlSeconds(),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	token, err := m.nextToken(resourceId)
+	if err != nil {
+		if unlockErr := m.release(lockId); unlockErr != nil {
+			log.Error("[LOCK] Error releasing lock after token failure: ", unlockErr)
+		}
+		return "", 0, err
+	}
+
+	m.hold(lockId, resourceId, token)
+	return lockId, token, nil
+}
+
+// SLock locks a resource for shared access
+func (m *LockManager) SLock(resourceId string) (string, error) {
+	lockId, err := randomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	locker, _ := m.client()
+	err = locker.SLock(ctx, resourceId, lockId, lock.LockDetails{
+		TTL: m.ttlSeconds(),
+	}, -1)
+	if err != nil {
+		return "", err
+	}
+
+	m.hold(lockId, resourceId, 0)
+	return lockId, nil
+}
+
+// Unlock releases a lock and stops renewing its lease
+func (m *LockManager) Unlock(lockId string) error {
+	m.mu.Lock()
+	delete(m.leases, lockId)
+	m.mu.Unlock()
+
+	return m.release(lockId)
+}
+
+func (m *LockManager) hold(lockId string, resourceId string, token int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.leases[lockId] = &lease{
+		resourceId: resourceId,
+		token:      token,
+	}
+}
+
+func (m *LockManager) release(lockId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	locker, _ := m.client()
+	_, err := locker.Unlock(ctx, lockId)
+	return err
+}
+
+// Renew extends the lease of a held lock. A lock that expired or was taken over is dropped and
+// ErrLeaseLost is returned, so that the holder stops before acting on the resource.
+func (m *LockManager) Renew(lockId string) error {
+	m.mu.Lock()
+	l, ok := m.leases[lockId]
+	m.mu.Unlock()
+	if !ok {
+		return ErrLeaseLost
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	locker, _ := m.client()
+	_, err := locker.Renew(ctx, lockId, m.ttlSeconds())
+
+	if errors.Is(err, lock.ErrLockNotFound) {
+		m.mu.Lock()
+		delete(m.leases, lockId)
+		m.mu.Unlock()
+		log.Error("[LOCK] Lost lease on ", l.resourceId, " with token ", l.token)
+		return ErrLeaseLost
+	}
+	if err != nil {
+		return err
+	}
+	log.Debug("[LOCK] Renewed lease on ", l.resourceId)
+	return nil
+}
+
+// RenewAll extends every held lease, dropping the ones that have been lost
+func (m *LockManager) RenewAll() {
+	m.mu.Lock()
+	lockIds := make([]string, 0, len(m.leases))
+	for lockId := range m.leases {
+		lockIds = append(lockIds, lockId)
+	}
+	m.mu.Unlock()
+
+	for _, lockId := range lockIds {
+		err := m.Renew(lockId)
+		if err != nil && !errors.Is(err, ErrLeaseLost) {
+			log.Error("[LOCK] Error renewing lease: ", err)
+		}
+	}
+}
+
+// Start renews held leases until the manager is stopped
+func (m *LockManager) Start() {
+	m.stopped.Add(1)
+	go func() {
+		defer m.stopped.Done()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-time.After(m.renewInterval):
+				m.RenewAll()
+			}
+		}
+	}()
+}
+
+// Stop stops renewing leases and releases every lock still held
+func (m *LockManager) Stop() {
+	log.Debug("[LOCK] Stopping lock manager")
+	close(m.stop)
+	m.stopped.Wait()
+
+	m.mu.Lock()
+	held := m.leases
+	m.leases = make(map[string]*lease)
+	m.mu.Unlock()
+
+	for lockId, l := range held {
+		if err := m.release(lockId); err != nil {
+			log.Error("[LOCK] Error releasing lock on ", l.resourceId, ": ", err)
+			continue
+		}
+		log.Debug("[LOCK] Released lock on ", l.resourceId)
+	}
+	log.Info("[LOCK] Lock manager stopped")
+}
+
+// NewLockManager creates a lock manager backed by the locks and fencing token collections
+func NewLockManager(db *mongo.Database) (*LockManager, error) {
+	timeout := Config.MongoDB.TimeoutMillis.Duration()
+
+	ttl := Config.MongoDB.LockTTLMillis.Duration()
+	if ttl <= 0 {
+		ttl = DefaultLockTTLMillis * time.Millisecond
+	}
+	renewInterval := Config.MongoDB.LockRenewIntervalMillis.Duration()
+	if renewInterval <= 0 || renewInterval >= ttl {
+		renewInterval = ttl / 3
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	locker := lock.NewClient(db.Collection(CollectionLocks))
+	if err := locker.CreateIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	return &LockManager{
+		locker:        locker,
+		tokens:        &mongoTokens{db.Collection(CollectionFencingTokens)},
+		ttl:           ttl,
+		renewInterval: renewInterval,
+		timeout:       timeout,
+		leases:        make(map[string]*lease),
+		stop:          make(chan struct{}),
+	}, nil
+}
@@ -0,0 +1,288 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	lock "github.com/square/mongo-lock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLocker keeps locks in memory with the expiry the mongo lock client would store
+type fakeLocker struct {
+	mu       sync.Mutex
+	locks    map[string]string
+	expires  map[string]time.Time
+	renewErr error
+	unlocked []string
+}
+
+func newFakeLocker() *fakeLocker {
+	return &fakeLocker{
+		locks:   make(map[string]string),
+		expires: make(map[string]time.Time),
+	}
+}
+
+func (f *fakeLocker) XLock(ctx context.Context, resourceName, lockId string, ld lock.LockDetails) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, resource := range f.locks {
+		if resource == resourceName {
+			return lock.ErrAlreadyLocked
+		}
+	}
+	f.locks[lockId] = resourceName
+	f.expires[lockId] = time.Now().Add(time.Duration(ld.TTL) * time.Second)
+	return nil
+}
+
+func (f *fakeLocker) SLock(ctx context.Context, resourceName, lockId string, ld lock.LockDetails, maxConcurrent int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.locks[lockId] = resourceName
+	f.expires[lockId] = time.Now().Add(time.Duration(ld.TTL) * time.Second)
+	return nil
+}
+
+func (f *fakeLocker) Unlock(ctx context.Context, lockId string) ([]lock.LockStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.locks, lockId)
+	delete(f.expires, lockId)
+	f.unlocked = append(f.unlocked, lockId)
+	return nil, nil
+}
+
+func (f *fakeLocker) Renew(ctx context.Context, lockId string, ttl uint) ([]lock.LockStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.renewErr != nil {
+		return nil, f.renewErr
+	}
+	if _, ok := f.locks[lockId]; !ok {
+		return nil, lock.ErrLockNotFound
+	}
+	f.expires[lockId] = time.Now().Add(time.Duration(ttl) * time.Second)
+	return []lock.LockStatus{{LockId: lockId, Resource: f.locks[lockId]}}, nil
+}
+
+// expire drops a lock as if its ttl ran out and another holder took it over
+func (f *fakeLocker) expire(lockId string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.locks, lockId)
+	delete(f.expires, lockId)
+}
+
+func (f *fakeLocker) expiry(lockId string) time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.expires[lockId]
+}
+
+func (f *fakeLocker) setExpiry(lockId string, expiry time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.expires[lockId] = expiry
+}
+
+// fakeTokens counts fencing tokens per resource like the fencing token collection
+type fakeTokens struct {
+	mu     sync.Mutex
+	tokens map[string]int64
+	err    error
+}
+
+func (f *fakeTokens) NextToken(ctx context.Context, resourceId string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.tokens[resourceId] += 1
+	return f.tokens[resourceId], nil
+}
+
+func NewTestLockManager(locker *fakeLocker, tokens *fakeTokens) *LockManager {
+	return &LockManager{
+		locker:        locker,
+		tokens:        tokens,
+		ttl:           time.Minute,
+		renewInterval: time.Millisecond,
+		timeout:       time.Second,
+		leases:        make(map[string]*lease),
+		stop:          make(chan struct{}),
+	}
+}
+
+func TestLockManagerXLock(t *testing.T) {
+
+	t.Run("Tokens increase per resource", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		lockId, first, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+		assert.Nil(t, m.Unlock(lockId))
+
+		lockId, second, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+		assert.Nil(t, m.Unlock(lockId))
+
+		_, other, err := m.XLock("mints/0x2")
+		assert.Nil(t, err)
+
+		assert.Equal(t, int64(1), first)
+		assert.Equal(t, int64(2), second)
+		assert.Equal(t, int64(1), other)
+	})
+
+	t.Run("Held resource", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		_, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+
+		_, _, err = m.XLock("mints/0x1")
+		assert.ErrorIs(t, err, lock.ErrAlreadyLocked)
+		assert.Len(t, m.leases, 1)
+	})
+
+	t.Run("Token error releases the lock", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64), err: errors.New("error")})
+
+		_, _, err := m.XLock("mints/0x1")
+
+		assert.NotNil(t, err)
+		assert.Len(t, locker.unlocked, 1)
+		assert.Empty(t, locker.locks)
+		assert.Empty(t, m.leases)
+	})
+}
+
+func TestLockManagerRenew(t *testing.T) {
+
+	t.Run("Renewal extends the lease", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		lockId, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+
+		soon := time.Now().Add(time.Second)
+		locker.setExpiry(lockId, soon)
+
+		err = m.Renew(lockId)
+
+		assert.Nil(t, err)
+		assert.True(t, locker.expiry(lockId).After(soon))
+		assert.Contains(t, m.leases, lockId)
+	})
+
+	t.Run("Lock not found drops the lease", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		lockId, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+
+		locker.expire(lockId)
+		err = m.Renew(lockId)
+
+		assert.ErrorIs(t, err, ErrLeaseLost)
+		assert.NotContains(t, m.leases, lockId)
+
+		err = m.Renew(lockId)
+
+		assert.ErrorIs(t, err, ErrLeaseLost)
+	})
+
+	t.Run("Renew error keeps the lease", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		lockId, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+
+		locker.renewErr = errors.New("error")
+		err = m.Renew(lockId)
+
+		assert.NotNil(t, err)
+		assert.NotErrorIs(t, err, ErrLeaseLost)
+		assert.Contains(t, m.leases, lockId)
+	})
+
+	t.Run("Renew all drops lost leases", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		held, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+		lost, _, err := m.XLock("mints/0x2")
+		assert.Nil(t, err)
+
+		soon := time.Now().Add(time.Second)
+		locker.setExpiry(held, soon)
+		locker.expire(lost)
+
+		m.RenewAll()
+
+		assert.True(t, locker.expiry(held).After(soon))
+		assert.Contains(t, m.leases, held)
+		assert.NotContains(t, m.leases, lost)
+	})
+
+	t.Run("Background renewal", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		lockId, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+
+		soon := time.Now().Add(time.Second)
+		locker.setExpiry(lockId, soon)
+
+		m.Start()
+		assert.Eventually(t, func() bool {
+			return locker.expiry(lockId).After(soon)
+		}, time.Second, time.Millisecond)
+		m.Stop()
+	})
+}
+
+func TestLockManagerStop(t *testing.T) {
+
+	t.Run("Releases held locks", func(t *testing.T) {
+		locker := newFakeLocker()
+		m := NewTestLockManager(locker, &fakeTokens{tokens: make(map[string]int64)})
+
+		xLockId, _, err := m.XLock("mints/0x1")
+		assert.Nil(t, err)
+		sLockId, err := m.SLock("burns")
+		assert.Nil(t, err)
+		released, _, err := m.XLock("mints/0x2")
+		assert.Nil(t, err)
+		assert.Nil(t, m.Unlock(released))
+
+		m.Start()
+		m.Stop()
+
+		assert.Empty(t, locker.locks)
+		assert.Empty(t, m.leases)
+		assert.ElementsMatch(t, []string{released, xLockId, sLockId}, locker.unlocked)
+	})
+}
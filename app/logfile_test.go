@@ -0,0 +1,46 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFile(t *testing.T) {
+	t.Run("Rotates once full", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "validator.log")
+		file, err := NewRotatingFile(path, 10, 2)
+		assert.Nil(t, err)
+		defer file.Close()
+
+		for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+			_, err := file.Write([]byte(line))
+			assert.Nil(t, err)
+		}
+
+		contents, _ := os.ReadFile(path)
+		assert.Equal(t, "fourth\n", string(contents))
+		contents, _ = os.ReadFile(path + ".1")
+		assert.Equal(t, "third\n", string(contents))
+		contents, _ = os.ReadFile(path + ".2")
+		assert.Equal(t, "second\n", string(contents))
+		_, err = os.Stat(path + ".3")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Appends to existing file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "validator.log")
+		assert.Nil(t, os.WriteFile(path, []byte("before\n"), 0600))
+
+		file, err := NewRotatingFile(path, 0, 0)
+		assert.Nil(t, err)
+		_, err = file.Write([]byte("after\n"))
+		assert.Nil(t, err)
+		file.Close()
+
+		contents, _ := os.ReadFile(path)
+		assert.Equal(t, "before\nafter\n", string(contents))
+	})
+}
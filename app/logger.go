@@ -1,13 +1,37 @@
 package app
 
 import (
+	"errors"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+
+	redacted = "<redacted>"
+	// minRedactLength keeps short values such as empty or placeholder secrets from redacting every line
+	minRedactLength = 8
+)
+
+var (
+	logMu          sync.RWMutex
+	logValidatorId string
+	logRedactions  []string
+	logFile        *RotatingFile
 )
 
 func InitLogger() {
-	logLevel := strings.ToLower(Config.Logger.Level)
+	logLevel := strings.ToLower(LiveConfig().Logger.Level)
 	log.Debug("[LOGGER] Initializing logger with level: ", logLevel)
 
 	if logLevel == "debug" {
@@ -18,5 +42,151 @@ func InitLogger() {
 		log.SetLevel(log.WarnLevel)
 	}
 
+	format := strings.ToLower(Config.Logger.Format)
+	if format == LogFormatJSON {
+		log.SetFormatter(&LogFormatter{Formatter: &log.JSONFormatter{}, Structured: true})
+	} else {
+		log.SetFormatter(&LogFormatter{Formatter: &log.TextFormatter{FullTimestamp: true}})
+	}
+	UpdateLogRedactions()
+
+	if Config.Logger.File != "" && (logFile == nil || logFile.Path() != Config.Logger.File) {
+		file, err := NewRotatingFile(Config.Logger.File, Config.Logger.MaxSizeMB*1024*1024, int(Config.Logger.MaxBackups))
+		if err != nil {
+			log.Fatal("[LOGGER] Error opening log file: ", err)
+		}
+		log.SetOutput(file)
+		if logFile != nil {
+			logFile.Close()
+		}
+		logFile = file
+	}
+
 	log.Info("[LOGGER] Logger initialized with level: ", logLevel)
 }
+
+// SetLogValidatorId adds the validator id to every structured log line
+func SetLogValidatorId(validatorId string) {
+	logMu.Lock()
+	defer logMu.Unlock()
+
+	logValidatorId = validatorId
+}
+
+// UpdateLogRedactions collects the secrets of the running config so that they never reach the logs
+func UpdateLogRedactions() {
+	config := LiveConfig()
+	values := []string{
+		config.MongoDB.URI,
+		config.Ethereum.PrivateKey,
+		config.Pocket.PrivateKey,
+		config.HashiCorpVault.Token,
+	}
+	if uri, err := url.Parse(config.MongoDB.URI); err == nil && uri.User != nil {
+		if password, ok := uri.User.Password(); ok {
+			values = append(values, password)
+		}
+	}
+
+	secretsMu.Lock()
+	for path := range secretRefs {
+		if field := configField(reflect.ValueOf(&config).Elem(), path); field.IsValid() {
+			values = append(values, field.String())
+		}
+	}
+	secretsMu.Unlock()
+
+	redactions := []string{}
+	for _, value := range values {
+		if len(value) >= minRedactLength {
+			redactions = append(redactions, value)
+		}
+	}
+	// longer secrets first, so a secret containing another is redacted as a whole
+	sort.Slice(redactions, func(i, j int) bool { return len(redactions[i]) > len(redactions[j]) })
+
+	logMu.Lock()
+	defer logMu.Unlock()
+
+	logRedactions = redactions
+}
+
+// Redact replaces every secret of the running config in a string
+func Redact(value string) string {
+	logMu.RLock()
+	defer logMu.RUnlock()
+
+	for _, secret := range logRedactions {
+		value = strings.ReplaceAll(value, secret, redacted)
+	}
+	return value
+}
+
+var servicePrefix = regexp.MustCompile(`^\[([A-Z0-9 ]+)\] ?`)
+
+// LogFormatter redacts secrets from every log line. In structured mode it also moves the
+// [SERVICE] prefix of the message into the service field and adds the validator id.
+type LogFormatter struct {
+	Formatter  log.Formatter
+	Structured bool
+}
+
+func (f *LogFormatter) Format(entry *log.Entry) ([]byte, error) {
+	data := make(log.Fields, len(entry.Data)+2)
+	for key, value := range entry.Data {
+		switch v := value.(type) {
+		case string:
+			data[key] = Redact(v)
+		case error:
+			data[key] = errors.New(Redact(v.Error()))
+		default:
+			data[key] = value
+		}
+	}
+	message := Redact(entry.Message)
+
+	if f.Structured {
+		if match := servicePrefix.FindStringSubmatch(message); match != nil {
+			data["service"] = match[1]
+			message = message[len(match[0]):]
+		}
+		logMu.RLock()
+		if logValidatorId != "" {
+			data["validator_id"] = logValidatorId
+		}
+		logMu.RUnlock()
+	}
+
+	formatted := *entry
+	formatted.Data = data
+	formatted.Message = message
+	return f.Formatter.Format(&formatted)
+}
+
+// TransferFields identify a mint, burn or invalid mint in the logs of every service
+func TransferFields(docType string, docId *primitive.ObjectID, txHash string) log.Fields {
+	fields := log.Fields{
+		"doc_type": docType,
+		"tx_hash":  txHash,
+	}
+	if docId != nil {
+		fields["doc_id"] = docId.Hex()
+	}
+	return fields
+}
+
+// TransferLogger returns a logger with the fields of a mint, burn or invalid mint
+func TransferLogger(docType string, docId *primitive.ObjectID, txHash string) *log.Entry {
+	return log.WithFields(TransferFields(docType, docId, txHash))
+}
+
+// StatusFields record the status a document moves from and the status set by its update
+func StatusFields(statusFrom string, update bson.M) log.Fields {
+	fields := log.Fields{"status_from": statusFrom}
+	if set, ok := update["$set"].(bson.M); ok {
+		if statusTo, ok := set["status"].(string); ok {
+			fields["status_to"] = statusTo
+		}
+	}
+	return fields
+}
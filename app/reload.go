@@ -0,0 +1,203 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// ConfigChange is a field that differs between the running config and a reloaded one
+type ConfigChange struct {
+	Path string
+	From string
+	To   string
+}
+
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, c.From, c.To)
+}
+
+var serviceConfigPaths = []string{
+	"MintMonitor",
+	"MintSigner",
+	"MintExecutor",
+	"BurnMonitor",
+	"BurnSigner",
+	"BurnExecutor",
+	"StuckDetector",
+	"SolvencyChecker",
+	"ValidatorSetMonitor",
+}
+
+// reloadablePaths are the fields that are applied to a running validator on reload
+var reloadablePaths = func() map[string]bool {
+	paths := map[string]bool{
+		"Logger.Level":               true,
+		"Ethereum.Confirmations":     true,
+		"Pocket.Confirmations":       true,
+		"HealthCheck.IntervalMillis": true,
+
+		"StuckTransfers.PendingMillis":   true,
+		"StuckTransfers.ConfirmedMillis": true,
+		"StuckTransfers.SignedMillis":    true,
+		"StuckTransfers.SubmittedMillis": true,
+		"Solvency.Tolerance":             true,
+
+		"CircuitBreaker.SolvencyMismatch": true,
+		"CircuitBreaker.UnexpectedMint":   true,
+		"CircuitBreaker.VolumeLimit":      true,
+		"CircuitBreaker.Paused":           true,
+
+		"Screening.Enabled":       true,
+		"Screening.DenyListFile":  true,
+		"Screening.AllowListFile": true,
+
+		"Secrets.EthereumAddress": true,
+	}
+	for _, field := range []string{"MintThreshold", "BurnThreshold", "Quorum", "OperatorAddresses"} {
+		paths["Approvals."+field] = true
+	}
+	for _, limits := range []string{"VolumeLimits.Mints", "VolumeLimits.Burns"} {
+		for _, field := range []string{"RecipientHourly", "RecipientDaily", "SenderHourly", "SenderDaily", "GlobalHourly", "GlobalDaily"} {
+			paths[limits+"."+field] = true
+		}
+	}
+	for _, service := range serviceConfigPaths {
+		paths[service+".Enabled"] = true
+		paths[service+".IntervalMillis"] = true
+	}
+	return paths
+}()
+
+// ErrUnsafeConfigChange is returned when a reload changes fields that need a restart
+var ErrUnsafeConfigChange = errors.New("config changes require a restart")
+
+// ReloadConfig reads the config file and env again and applies the changes that are safe
+// to make while running. The reload is rejected as a whole if any other field changed.
+// It returns the config that was running before the reload.
+func ReloadConfig(configFile string, envFile string) (models.Config, error) {
+	log.Info("[CONFIG] Reloading config")
+	previous := LiveConfig()
+
+	loadEnvFile(envFile)
+
+	var next models.Config
+	if configFile != "" {
+		if err := loadConfigFile(&next, configFile); err != nil {
+			log.Error("[CONFIG] Reload failed: ", err.Error())
+			return previous, err
+		}
+	}
+	readEnv(&next)
+	refs, err := readSecrets(&next)
+	if err != nil {
+		log.Error("[CONFIG] Reload failed: ", err.Error())
+		return previous, err
+	}
+
+	if errs := ValidateConfig(next); len(errs) > 0 {
+		for _, err := range errs {
+			log.Error("[CONFIG] Reload failed: ", err.Error())
+		}
+		return previous, errs
+	}
+
+	changes := DiffConfig(previous, next)
+	unsafe := false
+	for _, change := range changes {
+		if !reloadablePaths[change.Path] {
+			log.Error("[CONFIG] Cannot reload ", change)
+			unsafe = true
+		}
+	}
+	if unsafe {
+		log.Error("[CONFIG] Reload rejected, restart the validator to apply these changes")
+		return previous, ErrUnsafeConfigChange
+	}
+
+	setSecretRefs(refs)
+
+	if len(changes) == 0 {
+		log.Info("[CONFIG] Config reloaded, nothing changed")
+		return previous, nil
+	}
+
+	// only the changed fields are written, so services reading the others are not disturbed
+	configMu.Lock()
+	for _, change := range changes {
+		setConfigPath(&Config, next, change.Path)
+	}
+	configMu.Unlock()
+	for _, change := range changes {
+		log.Info("[CONFIG] Reloaded ", change)
+	}
+
+	if previous.Logger.Level != next.Logger.Level {
+		InitLogger()
+	}
+
+	return previous, nil
+}
+
+// setConfigPath copies a field, such as Pocket.Confirmations, from one config to another
+func setConfigPath(config *models.Config, from models.Config, path string) {
+	to := reflect.ValueOf(config).Elem()
+	value := reflect.ValueOf(from)
+	for _, name := range strings.Split(path, ".") {
+		to = to.FieldByName(name)
+		value = value.FieldByName(name)
+	}
+	to.Set(value)
+}
+
+// ServiceConfig returns the config section of a service by its field name, such as MintSigner
+func ServiceConfig(config models.Config, path string) models.ServiceConfig {
+	field := reflect.ValueOf(config).FieldByName(path)
+	if !field.IsValid() {
+		return models.ServiceConfig{}
+	}
+	service, _ := field.Interface().(models.ServiceConfig)
+	return service
+}
+
+// DiffConfig lists the fields that differ between two configs, with secrets redacted
+func DiffConfig(from models.Config, to models.Config) []ConfigChange {
+	return diffValues("", reflect.ValueOf(from), reflect.ValueOf(to))
+}
+
+func diffValues(path string, from reflect.Value, to reflect.Value) []ConfigChange {
+	if from.Kind() == reflect.Struct {
+		var changes []ConfigChange
+		for i := 0; i < from.NumField(); i++ {
+			name := from.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			changes = append(changes, diffValues(name, from.Field(i), to.Field(i))...)
+		}
+		return changes
+	}
+
+	if reflect.DeepEqual(from.Interface(), to.Interface()) {
+		return nil
+	}
+
+	change := ConfigChange{
+		Path: path,
+		From: fmt.Sprintf("%v", from.Interface()),
+		To:   fmt.Sprintf("%v", to.Interface()),
+	}
+	if isSecretPath(path) {
+		change.From = "<redacted>"
+		change.To = "<redacted>"
+	}
+	return []ConfigChange{change}
+}
+
+func isSecretPath(path string) bool {
+	return strings.HasSuffix(path, ".PrivateKey") || path == "MongoDB.URI" || path == "HashiCorpVault.Token"
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfigFile(t *testing.T, path string, replacements ...string) {
+	sample, err := os.ReadFile("../config.sample.yml")
+	assert.Nil(t, err)
+	contents := strings.NewReplacer(replacements...).Replace(string(sample))
+	assert.Nil(t, os.WriteFile(path, []byte(contents), 0600))
+}
+
+func TestReloadConfig(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+
+	setup := func(t *testing.T) {
+		for key := range envFileKeys {
+			t.Setenv(key, "")
+		}
+		writeConfigFile(t, configFile)
+		Config = models.Config{}
+		assert.Nil(t, loadConfigFile(&Config, configFile))
+		readEnv(&Config)
+		t.Cleanup(func() { Config = models.Config{} })
+	}
+
+	t.Run("Nothing changed", func(t *testing.T) {
+		setup(t)
+		running := Config
+
+		previous, err := ReloadConfig(configFile, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, running, previous)
+		assert.Equal(t, running, Config)
+	})
+
+	t.Run("Safe changes", func(t *testing.T) {
+		setup(t)
+		running := Config
+		writeConfigFile(t, configFile,
+			"  confirmations: 0", "  confirmations: 3",
+			"enabled: false\n  interval_ms: 5000", "enabled: true\n  interval_ms: 7000",
+		)
+
+		previous, err := ReloadConfig(configFile, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, running, previous)
+		assert.Equal(t, int64(3), Config.Ethereum.Confirmations)
+		assert.Equal(t, int64(3), Config.Pocket.Confirmations)
+		assert.True(t, Config.MintMonitor.Enabled)
+		assert.Equal(t, models.Millis(7000), Config.MintMonitor.IntervalMillis)
+	})
+
+	t.Run("Concurrent readers", func(t *testing.T) {
+		setup(t)
+		writeConfigFile(t, configFile, "  confirmations: 0", "  confirmations: 3")
+
+		done := make(chan struct{})
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					_ = LiveConfig().Pocket.Confirmations
+					_ = Config.Ethereum.ChainId
+				}
+			}
+		}()
+
+		_, err := ReloadConfig(configFile, "")
+		close(done)
+		<-stopped
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), LiveConfig().Pocket.Confirmations)
+	})
+
+	t.Run("Unsafe changes", func(t *testing.T) {
+		setup(t)
+		running := Config
+		writeConfigFile(t, configFile,
+			"  confirmations: 0", "  confirmations: 3",
+			`mint_controller_address: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"`, `mint_controller_address: "0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0"`,
+		)
+
+		_, err := ReloadConfig(configFile, "")
+
+		assert.Equal(t, ErrUnsafeConfigChange, err)
+		assert.Equal(t, running, Config)
+	})
+
+	t.Run("Invalid interval", func(t *testing.T) {
+		setup(t)
+		running := Config
+		writeConfigFile(t, configFile, "interval_ms: 5000", "interval_ms: 0")
+
+		_, err := ReloadConfig(configFile, "")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, running, Config)
+	})
+
+	t.Run("Missing config file", func(t *testing.T) {
+		setup(t)
+		running := Config
+
+		_, err := ReloadConfig(configFile+".missing", "")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, running, Config)
+	})
+}
+
+func TestDiffConfig(t *testing.T) {
+	from := models.Config{}
+	from.Ethereum.PrivateKey = "secret"
+	from.Ethereum.ValidatorAddresses = []string{"0x1"}
+	from.MintSigner.IntervalMillis = 1000
+
+	to := from
+	to.Ethereum.PrivateKey = "rotated"
+	to.Ethereum.ValidatorAddresses = []string{"0x1", "0x2"}
+	to.MintSigner.IntervalMillis = 2000
+
+	changes := DiffConfig(from, to)
+
+	assert.Equal(t, []ConfigChange{
+		{Path: "Ethereum.PrivateKey", From: "<redacted>", To: "<redacted>"},
+		{Path: "Ethereum.ValidatorAddresses", From: "[0x1]", To: "[0x1 0x2]"},
+		{Path: "MintSigner.IntervalMillis", From: "1000", To: "2000"},
+	}, changes)
+	assert.Equal(t, "MintSigner.IntervalMillis: 1000 -> 2000", changes[2].String())
+}
+
+func TestServiceConfig(t *testing.T) {
+	config := models.Config{}
+	config.BurnSigner = models.ServiceConfig{Enabled: true, IntervalMillis: 1000}
+
+	assert.Equal(t, config.BurnSigner, ServiceConfig(config, "BurnSigner"))
+	assert.Equal(t, models.ServiceConfig{}, ServiceConfig(config, "Unknown"))
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RetryDueFilter is an $expr matching documents whose backoff for a validator has elapsed, using
+// the database clock. A document the validator has not failed yet has no backoff and is always due.
+func RetryDueFilter(validatorId string) bson.M {
+	return bson.M{"$lte": bson.A{"$next_attempt_at." + validatorId, "$$NOW"}}
+}
+
+// RetryBackoff returns the delay before the next attempt after the given number of failed attempts
+func RetryBackoff(attempts int64) time.Duration {
+	base := Config.Retry.BackoffBaseMillis.Duration()
+	max := Config.Retry.BackoffMaxMillis.Duration()
+	if base <= 0 || attempts <= 0 {
+		return 0
+	}
+
+	backoff := base
+	for i := int64(1); i < attempts; i++ {
+		backoff *= 2
+		if max > 0 && backoff >= max {
+			return max
+		}
+	}
+	if max > 0 && backoff > max {
+		return max
+	}
+	return backoff
+}
+
+// RecordFailure counts a failed attempt of a validator on a document and schedules its next one,
+// moving the document to needs_attention once the validator spent its retry budget. Every validator
+// keeps its own count and backoff, so failures of the others neither use up its budget nor delay it.
+func RecordFailure(validatorId string, collection string, id *primitive.ObjectID, status string, attempts map[string]int64, lockToken int64, cause error) bool {
+	count := attempts[validatorId] + 1
+
+	set := bson.M{
+		"attempts":        perValidator("attempts", validatorId, count),
+		"last_error":      bson.M{"$literal": cause.Error()},
+		"next_attempt_at": perValidator("next_attempt_at", validatorId, time.Now().Add(RetryBackoff(count))),
+		"lock_token":      lockToken,
+		"updated_at":      time.Now(),
+	}
+
+	if Config.Retry.MaxAttempts > 0 && count >= Config.Retry.MaxAttempts {
+		log.WithFields(TransferFields(collection, id, "")).WithFields(log.Fields{
+			"status_from": status,
+			"status_to":   models.StatusNeedsAttention,
+		}).Warnf("[RETRY] Document %s in %s exhausted %d attempts of %s, needs attention", id.Hex(), collection, count, validatorId)
+		set["status"] = models.StatusNeedsAttention
+		set["previous_status"] = status
+	}
+
+	filter := bson.M{
+		"_id": id,
+		"status": bson.M{"$in": []string{
+			models.StatusPending,
+			models.StatusConfirmed,
+			models.StatusSigned,
+			models.StatusSubmitted,
+		}},
+		"lock_token": LockTokenFilter(lockToken),
+	}
+
+	// a pipeline update, so that documents which stored these fields before they were keyed by validator can be updated
+	err := DB.UpdateOne(collection, filter, bson.A{bson.M{"$set": set}})
+	if err != nil {
+		log.Error("[RETRY] Error recording failed attempt: ", err)
+		return false
+	}
+	return true
+}
+
+// perValidator sets the value of a validator in a field keyed by validator id, dropping what the
+// field held if it is not keyed yet
+func perValidator(field string, validatorId string, value interface{}) bson.M {
+	current := bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{bson.M{"$type": "$" + field}, "object"}}, "$" + field, bson.M{}}}
+	return bson.M{"$mergeObjects": bson.A{current, bson.M{validatorId: bson.M{"$literal": value}}}}
+}
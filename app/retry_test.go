@@ -0,0 +1,136 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	Config.Retry.BackoffBaseMillis = 1000
+	Config.Retry.BackoffMaxMillis = 5000
+
+	assert.Equal(t, time.Duration(0), RetryBackoff(0))
+	assert.Equal(t, 1*time.Second, RetryBackoff(1))
+	assert.Equal(t, 2*time.Second, RetryBackoff(2))
+	assert.Equal(t, 4*time.Second, RetryBackoff(3))
+	assert.Equal(t, 5*time.Second, RetryBackoff(4))
+	assert.Equal(t, 5*time.Second, RetryBackoff(100))
+}
+
+// recordedSet returns the fields set by the pipeline update of RecordFailure
+func recordedSet(update interface{}) bson.M {
+	return update.(bson.A)[0].(bson.M)["$set"].(bson.M)
+}
+
+// recordedValue returns the value RecordFailure set for a validator in a field keyed by validator
+func recordedValue(set bson.M, field string, validatorId string) interface{} {
+	merged := set[field].(bson.M)["$mergeObjects"].(bson.A)[1].(bson.M)
+	value, ok := merged[validatorId]
+	if !ok {
+		return nil
+	}
+	return value.(bson.M)["$literal"]
+}
+
+func TestRetryDueFilter(t *testing.T) {
+	assert.Equal(t, bson.M{"$lte": bson.A{"$next_attempt_at.wpokt-validator-01", "$$NOW"}}, RetryDueFilter("wpokt-validator-01"))
+}
+
+func TestRecordFailure(t *testing.T) {
+	id := primitive.NewObjectID()
+	Config.Retry.BackoffBaseMillis = 1000
+	Config.Retry.BackoffMaxMillis = 5000
+
+	t.Run("Schedules next attempt", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		Config.Retry.MaxAttempts = 3
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, update interface{}) {
+				assert.Equal(t, &id, filter.(bson.M)["_id"])
+				assert.Equal(t, LockTokenFilter(7), filter.(bson.M)["lock_token"])
+				set := recordedSet(update)
+				assert.Equal(t, int64(1), recordedValue(set, "attempts", "wpokt-validator-01"))
+				nextAttemptAt := recordedValue(set, "next_attempt_at", "wpokt-validator-01").(time.Time)
+				assert.WithinDuration(t, time.Now().Add(time.Second), nextAttemptAt, 100*time.Millisecond)
+				assert.Equal(t, bson.M{"$literal": "error"}, set["last_error"])
+				assert.Equal(t, int64(7), set["lock_token"])
+				assert.NotContains(t, set, "status")
+			}).Once()
+
+		success := RecordFailure("wpokt-validator-01", models.CollectionMints, &id, models.StatusPending, nil, 7, errors.New("error"))
+
+		assert.True(t, success)
+	})
+
+	t.Run("Exhausted attempts", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		Config.Retry.MaxAttempts = 3
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := recordedSet(update)
+				assert.Equal(t, int64(3), recordedValue(set, "attempts", "wpokt-validator-01"))
+				assert.Equal(t, models.StatusNeedsAttention, set["status"])
+				assert.Equal(t, models.StatusSigned, set["previous_status"])
+			}).Once()
+
+		success := RecordFailure("wpokt-validator-01", models.CollectionBurns, &id, models.StatusSigned, map[string]int64{"wpokt-validator-01": 2}, 0, errors.New("error"))
+
+		assert.True(t, success)
+	})
+
+	t.Run("Attempts and backoff of other validators", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		Config.Retry.MaxAttempts = 3
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := recordedSet(update)
+				assert.Equal(t, int64(1), recordedValue(set, "attempts", "wpokt-validator-01"))
+				assert.Nil(t, recordedValue(set, "attempts", "wpokt-validator-02"))
+				assert.Nil(t, recordedValue(set, "next_attempt_at", "wpokt-validator-02"))
+				assert.NotContains(t, set, "status")
+			}).Once()
+
+		success := RecordFailure("wpokt-validator-01", models.CollectionBurns, &id, models.StatusSigned, map[string]int64{"wpokt-validator-02": 5}, 0, errors.New("error"))
+
+		assert.True(t, success)
+	})
+
+	t.Run("Unlimited attempts", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		Config.Retry.MaxAttempts = 0
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.NotContains(t, recordedSet(update), "status")
+			}).Once()
+
+		success := RecordFailure("wpokt-validator-01", models.CollectionBurns, &id, models.StatusSigned, map[string]int64{"wpokt-validator-01": 100}, 0, errors.New("error"))
+
+		assert.True(t, success)
+	})
+
+	t.Run("Update failed", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		success := RecordFailure("wpokt-validator-01", models.CollectionBurns, &id, models.StatusSigned, nil, 0, errors.New("error"))
+
+		assert.False(t, success)
+	})
+}
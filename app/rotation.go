@@ -0,0 +1,259 @@
+package app
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	poktCrypto "github.com/pokt-network/pocket-core/crypto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SecretRotationName = "SECRETS"
+	SecretOperator     = "validator"
+)
+
+var (
+	secretsMu sync.Mutex
+	// secretRefs is the reference of each config field read from a secret, by field path
+	secretRefs = map[string]string{}
+	// secretValues caches secrets by reference so that a reload does not read them again
+	secretValues = map[string]string{}
+	// refusedSecrets is a hash of the last refused value of each field, so a refusal is only reported once
+	refusedSecrets = map[string][32]byte{}
+
+	secretsGeneration int64
+)
+
+// SecretsGeneration is incremented every time a secret is rotated, so that services
+// holding keys parsed from the config know to parse them again
+func SecretsGeneration() int64 {
+	return atomic.LoadInt64(&secretsGeneration)
+}
+
+func setSecretRefs(refs map[string]string) {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	secretRefs = refs
+}
+
+// cachedSecretProvider returns the cached secret for a reference, reading it only once
+type cachedSecretProvider struct {
+	provider SecretProvider
+}
+
+func (p *cachedSecretProvider) Fetch(ref *url.URL) (string, error) {
+	secretsMu.Lock()
+	value, ok := secretValues[ref.String()]
+	secretsMu.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := p.provider.Fetch(ref)
+	if err != nil {
+		return "", err
+	}
+
+	secretsMu.Lock()
+	secretValues[ref.String()] = value
+	secretsMu.Unlock()
+	return value, nil
+}
+
+// RotateSecrets reads every secret in the config again and applies the ones that changed.
+// A rotation is refused if it cannot be applied while running or if a rotated key does not
+// belong to the configured validator set. Every rotation and refusal is audited.
+func RotateSecrets() {
+	log.Debug("[SECRETS] Checking secrets for rotation")
+
+	secretsMu.Lock()
+	paths := make([]string, 0, len(secretRefs))
+	refs := make(map[string]string, len(secretRefs))
+	for path, ref := range secretRefs {
+		paths = append(paths, path)
+		refs[path] = ref
+	}
+	secretsMu.Unlock()
+	sort.Strings(paths)
+
+	providers := NewSecretProviders(LiveConfig())
+	for _, path := range paths {
+		ref, provider, ok := secretReference(refs[path], providers)
+		if !ok {
+			continue
+		}
+		value, err := provider.Fetch(ref)
+		if err != nil {
+			log.Errorf("[SECRETS] Error reading %s from %s: %s", path, redactReference(ref), err.Error())
+			continue
+		}
+
+		field := configField(reflect.ValueOf(&Config).Elem(), path)
+		if !field.IsValid() || field.Kind() != reflect.String || field.String() == value {
+			continue
+		}
+
+		hash := sha256.Sum256([]byte(value))
+		secretsMu.Lock()
+		alreadyRefused := refusedSecrets[path] == hash
+		secretsMu.Unlock()
+		if alreadyRefused {
+			continue
+		}
+
+		if err := applySecret(path, value); err != nil {
+			log.Errorf("[SECRETS] Refused rotation of %s: %s", path, err.Error())
+			secretsMu.Lock()
+			refusedSecrets[path] = hash
+			secretsMu.Unlock()
+			auditRotation(models.AuditActionRotateSecretRefused, path, err.Error())
+			continue
+		}
+
+		configMu.Lock()
+		field.SetString(value)
+		configMu.Unlock()
+
+		secretsMu.Lock()
+		secretValues[ref.String()] = value
+		delete(refusedSecrets, path)
+		secretsMu.Unlock()
+		atomic.AddInt64(&secretsGeneration, 1)
+		UpdateLogRedactions()
+
+		log.Infof("[SECRETS] Rotated %s", path)
+		auditRotation(models.AuditActionRotateSecret, path, "read new version from "+redactReference(ref))
+	}
+}
+
+func auditRotation(action string, path string, reason string) {
+	RecordAudit(models.AuditLog{
+		Action:   action,
+		Operator: SecretOperator,
+		Field:    path,
+		Reason:   reason,
+	})
+}
+
+// applySecret prepares the running validator for a rotated secret
+func applySecret(path string, value string) error {
+	switch path {
+	case "MongoDB.URI":
+		db, ok := DB.(interface{ Reconnect(uri string) error })
+		if !ok {
+			return errors.New("database does not support reconnecting")
+		}
+		if err := db.Reconnect(value); err != nil {
+			return fmt.Errorf("error connecting with rotated uri: %w", err)
+		}
+
+	case "Ethereum.PrivateKey":
+		key, err := ethCrypto.HexToECDSA(value)
+		if err != nil {
+			return fmt.Errorf("rotated key is not a valid private key: %w", err)
+		}
+		address := ethCrypto.PubkeyToAddress(key.PublicKey).Hex()
+		if !containsFold(Config.Ethereum.ValidatorAddresses, address) {
+			return fmt.Errorf("address %s of rotated key is not in Ethereum.ValidatorAddresses", address)
+		}
+		// another validator's key is in the set too, so the key must keep this validator's address
+		// unless the operator named the address it moves to
+		expected := LiveConfig().Secrets.EthereumAddress
+		if expected == "" {
+			current, err := ethCrypto.HexToECDSA(Config.Ethereum.PrivateKey)
+			if err != nil {
+				return fmt.Errorf("running key is not a valid private key: %w", err)
+			}
+			expected = ethCrypto.PubkeyToAddress(current.PublicKey).Hex()
+		}
+		if !strings.EqualFold(address, expected) {
+			return fmt.Errorf("address %s of rotated key is not the validator address %s, set Secrets.EthereumAddress to move to it", address, expected)
+		}
+
+	case "Pocket.PrivateKey":
+		key, err := poktCrypto.NewPrivateKey(value)
+		if err != nil {
+			return fmt.Errorf("rotated key is not a valid private key: %w", err)
+		}
+		publicKey := key.PublicKey().RawString()
+		if !containsFold(Config.Pocket.MultisigPublicKeys, publicKey) {
+			return fmt.Errorf("public key %s of rotated key is not in Pocket.MultisigPublicKeys", publicKey)
+		}
+
+	case "HashiCorpVault.Token":
+
+	default:
+		return errors.New("field cannot be rotated while running, restart to apply")
+	}
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+var indexedPath = regexp.MustCompile(`^(\w+)\[(\d+)\]$`)
+
+// configField returns the field of a config struct at a path such as Pocket.MultisigPublicKeys[1]
+func configField(value reflect.Value, path string) reflect.Value {
+	for _, name := range strings.Split(path, ".") {
+		index := -1
+		if match := indexedPath.FindStringSubmatch(name); match != nil {
+			name = match[1]
+			index, _ = strconv.Atoi(match[2])
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		value = value.FieldByName(name)
+		if !value.IsValid() {
+			return value
+		}
+		if index >= 0 {
+			if value.Kind() != reflect.Slice || index >= value.Len() {
+				return reflect.Value{}
+			}
+			value = value.Index(index)
+		}
+	}
+	return value
+}
+
+// SecretRotationRunner reads secrets again on every run
+type SecretRotationRunner struct{}
+
+func (x *SecretRotationRunner) Run() {
+	RotateSecrets()
+}
+
+func (x *SecretRotationRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
+// NewSecretRotationService checks secrets for rotation on every replica, leader or not
+func NewSecretRotationService(wg *sync.WaitGroup) Service {
+	if Config.Secrets.RotationIntervalMillis == 0 {
+		log.Debug("[SECRETS] Secret rotation is disabled")
+		return NewEmptyService(wg)
+	}
+
+	return NewRunnerService(SecretRotationName, &SecretRotationRunner{}, wg, Config.Secrets.RotationIntervalMillis.Duration(), WithoutLeaderElection())
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type reconnectingDatabase struct {
+	*MockDatabase
+	uri string
+	err error
+}
+
+func (d *reconnectingDatabase) Reconnect(uri string) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.uri = uri
+	return nil
+}
+
+func TestRotateSecrets(t *testing.T) {
+	const (
+		ethKey        = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+		otherEthKey   = "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d"
+		unknownEthKey = "7c852118294e51e653712a81e05800f419141751be58f605c371e15141b007a6"
+	)
+
+	setup := func(t *testing.T, path string, value string, rotated string) (*MockDatabase, string) {
+		file := filepath.Join(t.TempDir(), "secret")
+		assert.Nil(t, os.WriteFile(file, []byte(rotated), 0600))
+		ref := "file://" + file
+
+		Config = models.Config{}
+		Config.Ethereum.PrivateKey = ethKey
+		Config.Ethereum.ValidatorAddresses = []string{
+			"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		}
+		configField(reflect.ValueOf(&Config).Elem(), path).SetString(value)
+
+		secretRefs = map[string]string{path: ref}
+		secretValues = map[string]string{ref: value}
+		refusedSecrets = map[string][32]byte{}
+		secretsGeneration = 0
+
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		t.Cleanup(func() {
+			Config = models.Config{}
+			DB = nil
+			secretRefs = map[string]string{}
+			secretValues = map[string]string{}
+		})
+		return mockDB, ref
+	}
+
+	auditFor := func(action string, path string) interface{} {
+		return mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == action && audit.Field == path && audit.Operator == SecretOperator
+		})
+	}
+
+	t.Run("Nothing rotated", func(t *testing.T) {
+		setup(t, "Ethereum.PrivateKey", ethKey, ethKey)
+
+		RotateSecrets()
+
+		assert.Equal(t, ethKey, Config.Ethereum.PrivateKey)
+		assert.Equal(t, int64(0), SecretsGeneration())
+	})
+
+	t.Run("Rotates key to the named address", func(t *testing.T) {
+		mockDB, ref := setup(t, "Ethereum.PrivateKey", ethKey, otherEthKey+"\n")
+		Config.Secrets.EthereumAddress = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionRotateSecret, "Ethereum.PrivateKey")).Return(nil).Once()
+
+		RotateSecrets()
+
+		assert.Equal(t, otherEthKey, Config.Ethereum.PrivateKey)
+		assert.Equal(t, otherEthKey, secretValues[ref])
+		assert.Equal(t, int64(1), SecretsGeneration())
+	})
+
+	t.Run("Refuses key of another validator", func(t *testing.T) {
+		mockDB, _ := setup(t, "Ethereum.PrivateKey", ethKey, otherEthKey)
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == models.AuditActionRotateSecretRefused &&
+				audit.Reason == "address 0x70997970C51812dc3A010C7d01b50e0d17dc79C8 of rotated key is not the validator address 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266, set Secrets.EthereumAddress to move to it"
+		})).Return(nil).Once()
+
+		RotateSecrets()
+
+		assert.Equal(t, ethKey, Config.Ethereum.PrivateKey)
+		assert.Equal(t, int64(0), SecretsGeneration())
+	})
+
+	t.Run("Refuses key outside validator set once", func(t *testing.T) {
+		mockDB, _ := setup(t, "Ethereum.PrivateKey", ethKey, unknownEthKey)
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == models.AuditActionRotateSecretRefused &&
+				audit.Reason == "address 0x90F79bf6EB2c4f870365E785982E1f101E93b906 of rotated key is not in Ethereum.ValidatorAddresses"
+		})).Return(nil).Once()
+
+		RotateSecrets()
+		RotateSecrets()
+
+		assert.Equal(t, ethKey, Config.Ethereum.PrivateKey)
+		assert.Equal(t, int64(0), SecretsGeneration())
+	})
+
+	t.Run("Refuses fields that need a restart", func(t *testing.T) {
+		mockDB, _ := setup(t, "Ethereum.RPCURL", "https://eth.example.com", "https://eth2.example.com")
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionRotateSecretRefused, "Ethereum.RPCURL")).Return(nil).Once()
+
+		RotateSecrets()
+
+		assert.Equal(t, "https://eth.example.com", Config.Ethereum.RPCURL)
+	})
+
+	t.Run("Reconnects with rotated mongo uri", func(t *testing.T) {
+		mockDB, _ := setup(t, "MongoDB.URI", "mongodb://old@localhost", "mongodb://new@localhost")
+		db := &reconnectingDatabase{MockDatabase: mockDB}
+		DB = db
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionRotateSecret, "MongoDB.URI")).Return(nil).Once()
+
+		RotateSecrets()
+
+		assert.Equal(t, "mongodb://new@localhost", db.uri)
+		assert.Equal(t, "mongodb://new@localhost", Config.MongoDB.URI)
+	})
+
+	t.Run("Keeps mongo uri when reconnecting fails", func(t *testing.T) {
+		mockDB, _ := setup(t, "MongoDB.URI", "mongodb://old@localhost", "mongodb://new@localhost")
+		DB = &reconnectingDatabase{MockDatabase: mockDB, err: errors.New("auth failed")}
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, auditFor(models.AuditActionRotateSecretRefused, "MongoDB.URI")).Return(nil).Once()
+
+		RotateSecrets()
+
+		assert.Equal(t, "mongodb://old@localhost", Config.MongoDB.URI)
+	})
+}
+
+func TestConfigField(t *testing.T) {
+	config := models.Config{}
+	config.Pocket.MultisigPublicKeys = []string{"a", "b"}
+
+	assert.Equal(t, "b", configField(reflect.ValueOf(&config).Elem(), "Pocket.MultisigPublicKeys[1]").String())
+	assert.False(t, configField(reflect.ValueOf(&config).Elem(), "Pocket.MultisigPublicKeys[2]").IsValid())
+	assert.False(t, configField(reflect.ValueOf(&config).Elem(), "Pocket.Missing").IsValid())
+}
@@ -1,11 +1,13 @@
 package app
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/dan13ram/wpokt-validator/models"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Runner interface {
@@ -13,13 +15,27 @@ type Runner interface {
 	Status() models.RunnerStatus
 }
 
+// RunnerOption configures a runner service when it is created
+type RunnerOption func(*RunnerService)
+
+// WithoutLeaderElection runs the service on every replica, leader or not
+func WithoutLeaderElection() RunnerOption {
+	return func(x *RunnerService) {
+		x.leader = nil
+	}
+}
+
 type RunnerService struct {
-	wg       *sync.WaitGroup
-	name     string
-	runner   Runner
-	interval time.Duration
+	wg     *sync.WaitGroup
+	name   string
+	runner Runner
+	leader *LeaderElection
+
+	intervalMu sync.RWMutex
+	interval   time.Duration
 
 	stop chan struct{}
+	done chan struct{}
 
 	healthMu sync.RWMutex
 	health   models.ServiceHealth
@@ -29,21 +45,79 @@ func (x *RunnerService) Start() {
 	log.Infof("[%s] Service started", x.name)
 	stop := false
 	for !stop {
-		log.Infof("[%s] Run started", x.name)
+		interval := x.Interval()
+		leading := x.leader == nil || x.leader.Campaign(x.name)
 
-		x.runner.Run()
+		if leading {
+			log.Infof("[%s] Run started", x.name)
 
-		x.updateHealth(x.runner.Status())
+			stopRenewing := x.renewLease()
+			x.run()
+			stopRenewing()
 
-		log.Infof("[%s] Run complete, next run in %s", x.name, x.interval)
+			x.updateHealth(x.runner.Status(), leading, interval)
+			if failedRuns := x.Health().FailedRuns; failedRuns > 0 {
+				AlertServiceUnhealthy(x.name, failedRuns)
+			}
+
+			log.Infof("[%s] Run complete, next run in %s", x.name, interval)
+		} else {
+			x.updateHealth(x.runner.Status(), leading, interval)
+
+			log.Infof("[%s] Standing by, next check in %s", x.name, interval)
+		}
 
 		select {
 		case <-x.stop:
 			log.Infof("[%s] Service stopped", x.name)
+			close(x.done)
 			x.wg.Done()
 			stop = true
-		case <-time.After(x.interval):
+		case <-time.After(interval):
+		}
+	}
+}
+
+// run runs the runner in a span, which traced runners make the parent of their handlers
+func (x *RunnerService) run() {
+	name := x.name + " Run"
+	service := attribute.String("service", x.name)
+
+	if runner, ok := x.runner.(TracedRunner); ok {
+		end := runner.StartSpan(name, service)
+		defer end()
+	} else {
+		_, span := StartSpan(context.Background(), name, service)
+		defer span.End()
+	}
+
+	x.runner.Run()
+}
+
+// renewLease keeps the leader lease alive while a run takes longer than the lease allows,
+// so that a standby does not take over in the middle of the run
+func (x *RunnerService) renewLease() func() {
+	if x.leader == nil {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(x.leader.RenewInterval()):
+				x.leader.Campaign(x.name)
+			}
 		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
 	}
 }
 
@@ -54,19 +128,54 @@ func (x *RunnerService) Health() models.ServiceHealth {
 	return x.health
 }
 
-func (x *RunnerService) updateHealth(status models.RunnerStatus) {
+// Interval returns the time between runs
+func (x *RunnerService) Interval() time.Duration {
+	x.intervalMu.RLock()
+	defer x.intervalMu.RUnlock()
+
+	return x.interval
+}
+
+// SetInterval changes the time between runs, starting after the current wait
+func (x *RunnerService) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	x.intervalMu.Lock()
+	defer x.intervalMu.Unlock()
+
+	if x.interval != interval {
+		log.Infof("[%s] Interval changed from %s to %s", x.name, x.interval, interval)
+	}
+	x.interval = interval
+}
+
+func (x *RunnerService) updateHealth(status models.RunnerStatus, leader bool, interval time.Duration) {
 	x.healthMu.Lock()
 	defer x.healthMu.Unlock()
 
 	lastSyncTime := time.Now()
 
+	// count the runs that failed in a row, standbys do not run
+	failedRuns := int64(0)
+	if leader && status.Failed {
+		failedRuns = x.health.FailedRuns + 1
+	}
+
 	x.health = models.ServiceHealth{
 		Name:           x.name,
 		LastSyncTime:   lastSyncTime,
-		NextSyncTime:   lastSyncTime.Add(x.interval),
+		NextSyncTime:   lastSyncTime.Add(interval),
 		PoktHeight:     status.PoktHeight,
 		EthBlockNumber: status.EthBlockNumber,
-		Healthy:        true,
+		Healthy:        !status.Failed && (status.Solvency == nil || status.Solvency.WithinTolerance) && (status.ValidatorSet == nil || status.ValidatorSet.InSync),
+		Leader:         leader,
+		FailedRuns:     failedRuns,
+		Solvency:       status.Solvency,
+		Pause:          status.Pause,
+		ValidatorSet:   status.ValidatorSet,
+		Fee:            status.Fee,
 	}
 }
 
@@ -75,26 +184,37 @@ func (x *RunnerService) Stop() {
 	close(x.stop)
 }
 
+// Done is closed once the current run finished after Stop
+func (x *RunnerService) Done() <-chan struct{} {
+	return x.done
+}
+
 func NewRunnerService(
 	name string,
 	runner Runner,
 	wg *sync.WaitGroup,
 	interval time.Duration,
-
+	opts ...RunnerOption,
 ) Service {
 	if (name == "") || (runner == nil) || (wg == nil) || (interval == 0) {
 		log.Debug("[RUNNER] Invalid parameters")
 		return nil
 	}
 
-	return &RunnerService{
+	x := &RunnerService{
 		name:     name,
 		runner:   runner,
 		wg:       wg,
 		interval: interval,
+		leader:   Leader,
 		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
 		health: models.ServiceHealth{
 			Name: name,
 		},
 	}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
 }
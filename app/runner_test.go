@@ -8,6 +8,7 @@ import (
 
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type MockRunner struct {
@@ -42,6 +43,7 @@ func TestRunnerService(t *testing.T) {
 
 	health := service.Health()
 	assert.True(t, health.Healthy)
+	assert.True(t, health.Leader)
 	assert.Equal(t, "TestService", health.Name)
 	runs, err := strconv.Atoi(health.PoktHeight)
 	assert.NoError(t, err)
@@ -61,3 +63,156 @@ func TestRunnerServiceStop(t *testing.T) {
 	service := NewRunnerService("TestService", mockRunner, wg, 100*time.Millisecond)
 	service.Stop()
 }
+
+func TestRunnerServiceSetInterval(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+
+	service.SetInterval(200 * time.Millisecond)
+	assert.Equal(t, 200*time.Millisecond, service.Interval())
+
+	service.SetInterval(0)
+	assert.Equal(t, 200*time.Millisecond, service.Interval())
+}
+
+func TestRunnerServiceFailedRuns(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+
+	service.updateHealth(models.RunnerStatus{Failed: true}, true, time.Second)
+	service.updateHealth(models.RunnerStatus{Failed: true}, true, time.Second)
+	assert.False(t, service.Health().Healthy)
+	assert.Equal(t, int64(2), service.Health().FailedRuns)
+
+	service.updateHealth(models.RunnerStatus{}, true, time.Second)
+	assert.True(t, service.Health().Healthy)
+	assert.Equal(t, int64(0), service.Health().FailedRuns)
+}
+
+func TestRunnerServiceSolvency(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+
+	service.updateHealth(models.RunnerStatus{Solvency: &models.SolvencyReport{Discrepancy: "-100", WithinTolerance: false}}, true, time.Second)
+	assert.False(t, service.Health().Healthy)
+	assert.Equal(t, "-100", service.Health().Solvency.Discrepancy)
+
+	service.updateHealth(models.RunnerStatus{Solvency: &models.SolvencyReport{Discrepancy: "0", WithinTolerance: true}}, true, time.Second)
+	assert.True(t, service.Health().Healthy)
+}
+
+func TestRunnerServicePause(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+
+	service.updateHealth(models.RunnerStatus{Pause: &models.PauseState{Paused: true, BlockNumber: "100"}}, true, time.Second)
+	assert.True(t, service.Health().Healthy)
+	assert.True(t, service.Health().Pause.Paused)
+}
+
+func TestRunnerServiceValidatorSet(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+
+	service.updateHealth(models.RunnerStatus{ValidatorSet: &models.ValidatorSetReport{InSync: true}}, true, time.Second)
+	assert.True(t, service.Health().Healthy)
+
+	service.updateHealth(models.RunnerStatus{ValidatorSet: &models.ValidatorSetReport{InSync: false}}, true, time.Second)
+	assert.False(t, service.Health().Healthy)
+	assert.False(t, service.Health().ValidatorSet.InSync)
+}
+
+func TestRunnerServiceWithoutLeaderElection(t *testing.T) {
+	Leader = NewTestLeaderElection(models.LeaderScopeValidator)
+	t.Cleanup(func() { Leader = nil })
+
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond, WithoutLeaderElection()).(*RunnerService)
+	assert.Nil(t, service.leader)
+
+	service = NewRunnerService("TestService", &MockRunner{}, wg, 100*time.Millisecond).(*RunnerService)
+	assert.Equal(t, Leader, service.leader)
+}
+
+type SlowRunner struct {
+	duration time.Duration
+}
+
+func (m *SlowRunner) Run() {
+	time.Sleep(m.duration)
+}
+
+func (m *SlowRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
+func TestRunnerServiceRenewsLeaseDuringRun(t *testing.T) {
+	mockDB := NewMockDatabase(t)
+	DB = mockDB
+	Leader = NewTestLeaderElection(models.LeaderScopeValidator)
+	Leader.ttl = 30 * time.Millisecond
+	t.Cleanup(func() {
+		DB = nil
+		Leader = nil
+	})
+
+	campaigns := 0
+	var mu sync.Mutex
+	mockDB.EXPECT().UpsertOne(models.CollectionLeaders, mock.Anything, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, _ interface{}) {
+			mu.Lock()
+			campaigns += 1
+			mu.Unlock()
+		})
+
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &SlowRunner{duration: 200 * time.Millisecond}, wg, time.Hour)
+	wg.Add(1)
+
+	go service.Start()
+	time.Sleep(100 * time.Millisecond)
+
+	mu.Lock()
+	during := campaigns
+	mu.Unlock()
+
+	service.Stop()
+	wg.Wait()
+
+	// one campaign before the run, the rest renew the lease while it runs
+	assert.Greater(t, during, 1)
+}
+
+func TestRunnerServiceDone(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &SlowRunner{duration: 100 * time.Millisecond}, wg, time.Hour)
+	wg.Add(1)
+
+	go service.Start()
+	time.Sleep(20 * time.Millisecond)
+	service.Stop()
+
+	select {
+	case <-service.Done():
+		t.Fatal("service done before its run finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	select {
+	case <-service.Done():
+	case <-time.After(time.Second):
+		t.Fatal("service not done after its run finished")
+	}
+	wg.Wait()
+}
+
+func TestEmptyServiceDone(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	service := NewEmptyService(wg)
+
+	service.Stop()
+
+	<-service.Done()
+	wg.Wait()
+}
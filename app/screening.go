@@ -0,0 +1,125 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// QuarantineError is returned by validation when a transfer involves a denied address
+type QuarantineError struct {
+	Reason string
+}
+
+func (e *QuarantineError) Error() string {
+	return "quarantined: " + e.Reason
+}
+
+// ScreenedAddress is an address of a transfer and its role in the transfer
+type ScreenedAddress struct {
+	Role    string
+	Address string
+}
+
+// normalizeAddress lets list files hold Ethereum and Pocket addresses in any case, with or
+// without 0x
+func normalizeAddress(address string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(address)), "0x")
+}
+
+// ParseAddressList reads a list file with one address per line. Blank lines and anything
+// after a # are ignored.
+func ParseAddressList(contents []byte) map[string]bool {
+	addresses := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if address := normalizeAddress(line); address != "" {
+			addresses[address] = true
+		}
+	}
+	return addresses
+}
+
+// addressList is a list file that is read again whenever it changes on disk
+type addressList struct {
+	path      string
+	modTime   time.Time
+	size      int64
+	addresses map[string]bool
+}
+
+func (l *addressList) refresh(path string) error {
+	if path == "" {
+		*l = addressList{}
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if path == l.path && info.ModTime().Equal(l.modTime) && info.Size() == l.size {
+		return nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	*l = addressList{
+		path:      path,
+		modTime:   info.ModTime(),
+		size:      info.Size(),
+		addresses: ParseAddressList(contents),
+	}
+	log.Infof("[SCREENING] Loaded %d addresses from %s", len(l.addresses), path)
+	return nil
+}
+
+// Screener checks addresses against the deny and allow lists
+type Screener struct {
+	mu    sync.Mutex
+	deny  addressList
+	allow addressList
+}
+
+// Screen returns a QuarantineError for the first address that is denied and not allowed.
+// Lists that cannot be read are an error, so that nothing is signed without screening.
+func (s *Screener) Screen(config models.ScreeningConfig, addresses ...ScreenedAddress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.deny.refresh(config.DenyListFile); err != nil {
+		return fmt.Errorf("error loading deny list: %w", err)
+	}
+	if err := s.allow.refresh(config.AllowListFile); err != nil {
+		return fmt.Errorf("error loading allow list: %w", err)
+	}
+
+	for _, screened := range addresses {
+		address := normalizeAddress(screened.Address)
+		if s.deny.addresses[address] && !s.allow.addresses[address] {
+			return &QuarantineError{Reason: fmt.Sprintf("%s %s is on the deny list", screened.Role, strings.ToLower(screened.Address))}
+		}
+	}
+	return nil
+}
+
+var screener = &Screener{}
+
+// ScreenAddresses screens the addresses of a transfer if screening is enabled
+func ScreenAddresses(addresses ...ScreenedAddress) error {
+	config := LiveConfig().Screening
+	if !config.Enabled {
+		return nil
+	}
+	return screener.Screen(config, addresses...)
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddressList(t *testing.T) {
+	addresses := ParseAddressList([]byte(`
+# exploit
+0xABCDEF0123456789ABCDEF0123456789ABCDEF01
+1234567890abcdef1234567890abcdef12345678 # pokt address
+
+`))
+
+	assert.Equal(t, map[string]bool{
+		"abcdef0123456789abcdef0123456789abcdef01": true,
+		"1234567890abcdef1234567890abcdef12345678": true,
+	}, addresses)
+}
+
+func TestScreener(t *testing.T) {
+	dir := t.TempDir()
+	denyFile := filepath.Join(dir, "deny.txt")
+	allowFile := filepath.Join(dir, "allow.txt")
+	assert.Nil(t, os.WriteFile(denyFile, []byte("0xAAAA\nbbbb\n"), 0600))
+	assert.Nil(t, os.WriteFile(allowFile, []byte("bbbb\n"), 0600))
+
+	config := models.ScreeningConfig{Enabled: true, DenyListFile: denyFile, AllowListFile: allowFile}
+
+	t.Run("Denied", func(t *testing.T) {
+		s := &Screener{}
+
+		err := s.Screen(config, ScreenedAddress{"sender", "cccc"}, ScreenedAddress{"recipient", "0xaaaa"})
+
+		var quarantine *QuarantineError
+		assert.True(t, errors.As(err, &quarantine))
+		assert.Equal(t, "recipient 0xaaaa is on the deny list", quarantine.Reason)
+	})
+
+	t.Run("Allowed overrides denied", func(t *testing.T) {
+		s := &Screener{}
+
+		assert.Nil(t, s.Screen(config, ScreenedAddress{"sender", "0xBBBB"}))
+	})
+
+	t.Run("Reloads changed lists", func(t *testing.T) {
+		s := &Screener{}
+		assert.Nil(t, s.Screen(config, ScreenedAddress{"sender", "dddd"}))
+
+		assert.Nil(t, os.WriteFile(denyFile, []byte("0xAAAA\nbbbb\ndddd\n"), 0600))
+		later := time.Now().Add(time.Minute)
+		assert.Nil(t, os.Chtimes(denyFile, later, later))
+
+		assert.NotNil(t, s.Screen(config, ScreenedAddress{"sender", "dddd"}))
+	})
+
+	t.Run("Missing list fails closed", func(t *testing.T) {
+		s := &Screener{}
+		missing := config
+		missing.DenyListFile = filepath.Join(dir, "missing.txt")
+
+		err := s.Screen(missing, ScreenedAddress{"sender", "cccc"})
+
+		var quarantine *QuarantineError
+		assert.NotNil(t, err)
+		assert.False(t, errors.As(err, &quarantine))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		Config.Screening = models.ScreeningConfig{DenyListFile: denyFile}
+		t.Cleanup(func() { Config = models.Config{} })
+
+		assert.Nil(t, ScreenAddresses(ScreenedAddress{"sender", "0xaaaa"}))
+	})
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// SecretProvider fetches the secrets referenced by config values with its URI scheme,
+// such as vault://secret/data/wpokt#eth_private_key or file:///run/secrets/eth
+type SecretProvider interface {
+	Fetch(ref *url.URL) (string, error)
+}
+
+// NewSecretProviders returns the secret providers available with the config, by URI scheme
+func NewSecretProviders(config models.Config) map[string]SecretProvider {
+	return map[string]SecretProvider{
+		SchemeFile:  &FileSecretProvider{},
+		SchemeGSM:   &GSMSecretProvider{},
+		SchemeVault: NewVaultSecretProvider(config.HashiCorpVault),
+	}
+}
+
+// readSecrets replaces secret references in the config with the secrets, reusing the
+// secrets that were already read. It returns the reference of each field read from a secret.
+func readSecrets(config *models.Config) (map[string]string, error) {
+	useGSMSecretNames(config)
+
+	providers := NewSecretProviders(*config)
+	for scheme, provider := range providers {
+		providers[scheme] = &cachedSecretProvider{provider: provider}
+	}
+	return ResolveSecrets(config, providers)
+}
+
+// secretReference parses a config value as a reference to a secret of one of the providers
+func secretReference(value string, providers map[string]SecretProvider) (*url.URL, SecretProvider, bool) {
+	scheme, _, found := strings.Cut(value, "://")
+	if !found {
+		return nil, nil, false
+	}
+	provider, ok := providers[scheme]
+	if !ok {
+		return nil, nil, false
+	}
+	ref, err := url.Parse(value)
+	if err != nil {
+		return nil, nil, false
+	}
+	return ref, provider, true
+}
+
+// ResolveSecrets replaces every string config value that references a secret with the secret.
+// It returns the reference of each field read from a secret, by field path.
+func ResolveSecrets(config *models.Config, providers map[string]SecretProvider) (map[string]string, error) {
+	refs := make(map[string]string)
+	err := resolveSecretsInValue(reflect.ValueOf(config).Elem(), "", providers, refs)
+	return refs, err
+}
+
+func resolveSecretsInValue(value reflect.Value, path string, providers map[string]SecretProvider, refs map[string]string) error {
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			name := value.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			if err := resolveSecretsInValue(value.Field(i), name, providers, refs); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := resolveSecretsInValue(value.Index(i), fmt.Sprintf("%s[%d]", path, i), providers, refs); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		ref, provider, ok := secretReference(value.String(), providers)
+		if !ok {
+			return nil
+		}
+		log.Debugf("[SECRETS] Reading %s from %s", path, ref.Scheme)
+		secret, err := provider.Fetch(ref)
+		if err != nil {
+			return fmt.Errorf("error reading %s from %s: %w", path, redactReference(ref), err)
+		}
+		refs[path] = ref.String()
+		value.SetString(secret)
+		log.Infof("[SECRETS] Successfully read %s from %s", path, ref.Scheme)
+	}
+	return nil
+}
+
+// redactReference drops any credentials from a secret reference so it can be logged
+func redactReference(ref *url.URL) string {
+	redacted := *ref
+	redacted.User = nil
+	return redacted.String()
+}
+
+const SchemeFile = "file"
+
+// FileSecretProvider reads secrets from mounted files, such as Kubernetes secrets
+type FileSecretProvider struct{}
+
+func (p *FileSecretProvider) Fetch(ref *url.URL) (string, error) {
+	if ref.Path == "" {
+		return "", fmt.Errorf("file path is empty")
+	}
+	data, err := os.ReadFile(ref.Path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -11,16 +11,24 @@ type Service interface {
 	Start()
 	Health() models.ServiceHealth
 	Stop()
+	// Done is closed once the service has stopped
+	Done() <-chan struct{}
 }
 
 type EmptyService struct {
-	wg *sync.WaitGroup
+	wg   *sync.WaitGroup
+	done chan struct{}
 }
 
 func (e *EmptyService) Start() {}
 
 func (e *EmptyService) Stop() {
 	e.wg.Done()
+	close(e.done)
+}
+
+func (e *EmptyService) Done() <-chan struct{} {
+	return e.done
 }
 
 const EmptyServiceName = "empty"
@@ -38,6 +46,7 @@ func (e *EmptyService) Health() models.ServiceHealth {
 
 func NewEmptyService(wg *sync.WaitGroup) Service {
 	return &EmptyService{
-		wg: wg,
+		wg:   wg,
+		done: make(chan struct{}),
 	}
 }
@@ -0,0 +1,305 @@
+package app
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	poktCrypto "github.com/pokt-network/pocket-core/crypto"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	StuckDetectorName = "STUCK DETECTOR"
+)
+
+// StuckDetectorRunner reports mints, burns and invalid mints that stay in a non-terminal
+// status for longer than the threshold of that status
+type StuckDetectorRunner struct {
+	Traced
+
+	wpoktAddress string
+	vaultAddress string
+	failed       bool
+}
+
+func (x *StuckDetectorRunner) Run() {
+	x.failed = !x.Detect()
+}
+
+func (x *StuckDetectorRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{
+		Failed: x.failed,
+	}
+}
+
+// stuckFilter matches documents past the threshold of their status. Pending documents are
+// measured from creation, since the signers update them on every run while they confirm.
+func stuckFilter(now time.Time) bson.A {
+	thresholds := []struct {
+		status    string
+		threshold time.Duration
+		field     string
+	}{
+		{models.StatusPending, LiveConfig().StuckTransfers.PendingMillis.Duration(), "created_at"},
+		{models.StatusConfirmed, LiveConfig().StuckTransfers.ConfirmedMillis.Duration(), "updated_at"},
+		{models.StatusSigned, LiveConfig().StuckTransfers.SignedMillis.Duration(), "updated_at"},
+		{models.StatusSubmitted, LiveConfig().StuckTransfers.SubmittedMillis.Duration(), "updated_at"},
+	}
+
+	clauses := bson.A{}
+	for _, t := range thresholds {
+		if t.threshold <= 0 {
+			continue
+		}
+		clauses = append(clauses, bson.M{
+			"status": t.status,
+			t.field:  bson.M{"$lt": now.Add(-t.threshold)},
+		})
+	}
+	return clauses
+}
+
+// ownSigners returns how this validator appears in the signers of mints and of burns
+func ownSigners() (string, string) {
+	config := LiveConfig()
+	var ethSigner, poktSigner string
+	if key, err := ethCrypto.HexToECDSA(config.Ethereum.PrivateKey); err == nil {
+		ethSigner = strings.ToLower(ethCrypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+	if key, err := poktCrypto.NewPrivateKey(config.Pocket.PrivateKey); err == nil {
+		poktSigner = strings.ToLower(key.PublicKey().RawString())
+	}
+	return ethSigner, poktSigner
+}
+
+// MissingSigners lists the expected signers that have not signed yet
+func MissingSigners(expected []string, signers []string) []string {
+	signed := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		signed[strings.ToLower(signer)] = true
+	}
+	missing := []string{}
+	for _, signer := range expected {
+		if !signed[strings.ToLower(signer)] {
+			missing = append(missing, strings.ToLower(signer))
+		}
+	}
+	return missing
+}
+
+// NewStuckTransfer describes why a document is stuck in its status
+func NewStuckTransfer(
+	docType string,
+	id *primitive.ObjectID,
+	txHash string,
+	status string,
+	since time.Time,
+	confirmations string,
+	requiredConfirmations int64,
+	signers []string,
+	expectedSigners []string,
+	ownSigner string,
+) models.StuckTransfer {
+	missing := MissingSigners(expectedSigners, signers)
+
+	var reason string
+	switch status {
+	case models.StatusPending:
+		reason = models.StuckReasonAwaitingConfirmations
+		if count, err := strconv.ParseInt(confirmations, 10, 64); err == nil && count >= requiredConfirmations {
+			reason = models.StuckReasonConfirmedButPending
+		}
+	case models.StatusConfirmed:
+		reason = models.StuckReasonMissingSignatures
+		for _, signer := range missing {
+			if signer == ownSigner {
+				reason = models.StuckReasonMissingOurSignature
+			}
+		}
+	case models.StatusSigned:
+		reason = models.StuckReasonNotSubmitted
+		if docType == models.CollectionMints {
+			reason = models.StuckReasonNotExecuted
+		}
+	case models.StatusSubmitted:
+		reason = models.StuckReasonNotConfirmed
+	}
+
+	if signers == nil {
+		signers = []string{}
+	}
+
+	return models.StuckTransfer{
+		DocType:               docType,
+		DocumentId:            id,
+		TransactionHash:       txHash,
+		Status:                status,
+		Reason:                reason,
+		Since:                 since,
+		Confirmations:         confirmations,
+		RequiredConfirmations: requiredConfirmations,
+		Signers:               signers,
+		MissingSigners:        missing,
+	}
+}
+
+// since is when a document entered its status, as far as the document records it
+func since(status string, createdAt time.Time, updatedAt time.Time) time.Time {
+	if status == models.StatusPending {
+		return createdAt
+	}
+	return updatedAt
+}
+
+func (x *StuckDetectorRunner) FindStuckTransfers(now time.Time) ([]models.StuckTransfer, bool) {
+	clauses := stuckFilter(now)
+	if len(clauses) == 0 {
+		log.Debug("[STUCK DETECTOR] Every status threshold is disabled")
+		return nil, true
+	}
+
+	ethSigner, poktSigner := ownSigners()
+	var findings []models.StuckTransfer
+	success := true
+
+	var mints []models.Mint
+	filter := bson.M{"wpokt_address": x.wpoktAddress, "vault_address": x.vaultAddress, "$or": clauses}
+	if err := x.DB().FindMany(models.CollectionMints, filter, &mints); err != nil {
+		log.Error("[STUCK DETECTOR] Error fetching mints: ", err)
+		success = false
+	}
+	for _, doc := range mints {
+		findings = append(findings, NewStuckTransfer(
+			models.CollectionMints, doc.Id, doc.TransactionHash, doc.Status,
+			since(doc.Status, doc.CreatedAt, doc.UpdatedAt),
+			doc.Confirmations, LiveConfig().Pocket.Confirmations,
+			doc.Signers, Config.Ethereum.ValidatorAddresses, ethSigner,
+		))
+	}
+
+	var burns []models.Burn
+	filter = bson.M{"wpokt_address": x.wpoktAddress, "$or": clauses}
+	if err := x.DB().FindMany(models.CollectionBurns, filter, &burns); err != nil {
+		log.Error("[STUCK DETECTOR] Error fetching burns: ", err)
+		success = false
+	}
+	for _, doc := range burns {
+		findings = append(findings, NewStuckTransfer(
+			models.CollectionBurns, doc.Id, doc.TransactionHash, doc.Status,
+			since(doc.Status, doc.CreatedAt, doc.UpdatedAt),
+			doc.Confirmations, LiveConfig().Ethereum.Confirmations,
+			doc.Signers, Config.Pocket.MultisigPublicKeys, poktSigner,
+		))
+	}
+
+	var invalidMints []models.InvalidMint
+	filter = bson.M{"vault_address": x.vaultAddress, "$or": clauses}
+	if err := x.DB().FindMany(models.CollectionInvalidMints, filter, &invalidMints); err != nil {
+		log.Error("[STUCK DETECTOR] Error fetching invalid mints: ", err)
+		success = false
+	}
+	for _, doc := range invalidMints {
+		findings = append(findings, NewStuckTransfer(
+			models.CollectionInvalidMints, doc.Id, doc.TransactionHash, doc.Status,
+			since(doc.Status, doc.CreatedAt, doc.UpdatedAt),
+			doc.Confirmations, LiveConfig().Pocket.Confirmations,
+			doc.Signers, Config.Pocket.MultisigPublicKeys, poktSigner,
+		))
+	}
+
+	return findings, success
+}
+
+// Detect records the documents that are stuck now and resolves the findings of documents
+// that moved on since the last run
+func (x *StuckDetectorRunner) Detect() bool {
+	log.Debug("[STUCK DETECTOR] Detecting stuck transfers")
+	now := time.Now()
+
+	findings, success := x.FindStuckTransfers(now)
+
+	current := make(map[string]bool, len(findings))
+	for _, finding := range findings {
+		current[finding.DocType+"/"+finding.DocumentId.Hex()] = true
+
+		filter := bson.M{"doc_type": finding.DocType, "document_id": finding.DocumentId}
+		update := bson.M{
+			"$set": bson.M{
+				"transaction_hash":       finding.TransactionHash,
+				"status":                 finding.Status,
+				"reason":                 finding.Reason,
+				"since":                  finding.Since,
+				"confirmations":          finding.Confirmations,
+				"required_confirmations": finding.RequiredConfirmations,
+				"signers":                finding.Signers,
+				"missing_signers":        finding.MissingSigners,
+				"resolved":               false,
+				"updated_at":             now,
+			},
+			"$setOnInsert": bson.M{"detected_at": now},
+		}
+		if err := x.DB().UpsertOne(models.CollectionStuckTransfers, filter, update); err != nil {
+			log.Error("[STUCK DETECTOR] Error recording stuck transfer: ", err)
+			success = false
+			continue
+		}
+		TransferLogger(finding.DocType, finding.DocumentId, finding.TransactionHash).
+			WithField("status", finding.Status).
+			Warnf("[STUCK DETECTOR] %s %s is stuck since %s: %s", finding.DocType, finding.TransactionHash, finding.Since.Format(time.RFC3339), finding.Reason)
+	}
+
+	if !success {
+		// a failed query would resolve findings that are still stuck
+		return false
+	}
+
+	var open []models.StuckTransfer
+	if err := x.DB().FindMany(models.CollectionStuckTransfers, bson.M{"resolved": false}, &open); err != nil {
+		log.Error("[STUCK DETECTOR] Error fetching stuck transfers: ", err)
+		return false
+	}
+	for _, finding := range open {
+		if current[finding.DocType+"/"+finding.DocumentId.Hex()] {
+			continue
+		}
+		filter := bson.M{"_id": finding.Id, "resolved": false}
+		update := bson.M{"$set": bson.M{"resolved": true, "resolved_at": now, "updated_at": now}}
+		err := x.DB().UpdateOne(models.CollectionStuckTransfers, filter, update)
+		if errors.Is(err, ErrNotMatched) {
+			// resolved by another replica meanwhile
+			continue
+		}
+		if err != nil {
+			log.Error("[STUCK DETECTOR] Error resolving stuck transfer: ", err)
+			success = false
+			continue
+		}
+		log.Infof("[STUCK DETECTOR] %s %s is no longer stuck", finding.DocType, finding.TransactionHash)
+	}
+
+	log.Infof("[STUCK DETECTOR] Found %d stuck transfers", len(findings))
+	return success
+}
+
+func NewStuckDetector(wg *sync.WaitGroup, lastHealth models.ServiceHealth) Service {
+	if !Config.StuckDetector.Enabled {
+		log.Debug("[STUCK DETECTOR] Disabled")
+		return NewEmptyService(wg)
+	}
+
+	x := &StuckDetectorRunner{
+		wpoktAddress: strings.ToLower(Config.Ethereum.WrappedPocketAddress),
+		vaultAddress: strings.ToLower(Config.Pocket.VaultAddress),
+	}
+
+	log.Info("[STUCK DETECTOR] Initialized stuck detector")
+
+	return NewRunnerService(StuckDetectorName, x, wg, Config.StuckDetector.IntervalMillis.Duration())
+}
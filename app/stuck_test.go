@@ -0,0 +1,133 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewStuckTransfer(t *testing.T) {
+	id := primitive.NewObjectID()
+	validators := []string{"0xAAA", "0xBBB", "0xCCC"}
+	since := time.Now().Add(-time.Hour)
+
+	t.Run("Pending", func(t *testing.T) {
+		finding := NewStuckTransfer(models.CollectionMints, &id, "hash", models.StatusPending, since, "3", 5, nil, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonAwaitingConfirmations, finding.Reason)
+		assert.Equal(t, []string{}, finding.Signers)
+
+		finding = NewStuckTransfer(models.CollectionMints, &id, "hash", models.StatusPending, since, "5", 5, nil, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonConfirmedButPending, finding.Reason)
+	})
+
+	t.Run("Confirmed", func(t *testing.T) {
+		finding := NewStuckTransfer(models.CollectionMints, &id, "hash", models.StatusConfirmed, since, "5", 5, []string{"0xbbb"}, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonMissingOurSignature, finding.Reason)
+		assert.Equal(t, []string{"0xaaa", "0xccc"}, finding.MissingSigners)
+
+		finding = NewStuckTransfer(models.CollectionMints, &id, "hash", models.StatusConfirmed, since, "5", 5, []string{"0xaaa"}, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonMissingSignatures, finding.Reason)
+		assert.Equal(t, []string{"0xbbb", "0xccc"}, finding.MissingSigners)
+	})
+
+	t.Run("Signed and submitted", func(t *testing.T) {
+		finding := NewStuckTransfer(models.CollectionMints, &id, "hash", models.StatusSigned, since, "5", 5, validators, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonNotExecuted, finding.Reason)
+		assert.Empty(t, finding.MissingSigners)
+
+		finding = NewStuckTransfer(models.CollectionBurns, &id, "hash", models.StatusSigned, since, "5", 5, validators, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonNotSubmitted, finding.Reason)
+
+		finding = NewStuckTransfer(models.CollectionBurns, &id, "hash", models.StatusSubmitted, since, "5", 5, validators, validators, "0xaaa")
+		assert.Equal(t, models.StuckReasonNotConfirmed, finding.Reason)
+	})
+}
+
+func TestStuckFilter(t *testing.T) {
+	defer func() { Config = models.Config{} }()
+	now := time.Now()
+
+	Config.StuckTransfers = models.StuckTransfersConfig{PendingMillis: 60000, SignedMillis: 120000}
+
+	assert.Equal(t, bson.A{
+		bson.M{"status": models.StatusPending, "created_at": bson.M{"$lt": now.Add(-time.Minute)}},
+		bson.M{"status": models.StatusSigned, "updated_at": bson.M{"$lt": now.Add(-2 * time.Minute)}},
+	}, stuckFilter(now))
+
+	Config.StuckTransfers = models.StuckTransfersConfig{}
+	assert.Empty(t, stuckFilter(now))
+}
+
+func TestStuckDetectorDetect(t *testing.T) {
+	setup := func(t *testing.T) (*MockDatabase, *StuckDetectorRunner) {
+		Config = models.Config{}
+		Config.StuckTransfers = models.StuckTransfersConfig{ConfirmedMillis: 60000}
+		Config.Ethereum.PrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+		Config.Ethereum.ValidatorAddresses = []string{
+			"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		}
+
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		t.Cleanup(func() {
+			Config = models.Config{}
+			DB = nil
+		})
+		return mockDB, &StuckDetectorRunner{wpoktAddress: "wpokt", vaultAddress: "vault"}
+	}
+
+	t.Run("Records findings and resolves the ones that moved on", func(t *testing.T) {
+		mockDB, x := setup(t)
+		mintId := primitive.NewObjectID()
+		resolvedId := primitive.NewObjectID()
+		findingId := primitive.NewObjectID()
+		updatedAt := time.Now().Add(-time.Hour)
+
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, result interface{}) {
+				assert.Equal(t, "wpokt", filter.(bson.M)["wpokt_address"])
+				*result.(*[]models.Mint) = []models.Mint{{
+					Id:              &mintId,
+					TransactionHash: "mint",
+					Status:          models.StatusConfirmed,
+					UpdatedAt:       updatedAt,
+					Signers:         []string{"0x70997970c51812dc3a010c7d01b50e0d17dc79c8"},
+				}}
+			}).Once()
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionStuckTransfers, bson.M{"doc_type": models.CollectionMints, "document_id": &mintId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StuckReasonMissingOurSignature, set["reason"])
+				assert.Equal(t, []string{"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"}, set["missing_signers"])
+				assert.Equal(t, updatedAt, set["since"])
+			}).Once()
+		mockDB.EXPECT().FindMany(models.CollectionStuckTransfers, bson.M{"resolved": false}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.StuckTransfer) = []models.StuckTransfer{
+					{Id: &primitive.ObjectID{}, DocType: models.CollectionMints, DocumentId: &mintId},
+					{Id: &findingId, DocType: models.CollectionBurns, DocumentId: &resolvedId, TransactionHash: "burn"},
+				}
+			}).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionStuckTransfers, bson.M{"_id": &findingId, "resolved": false}, mock.Anything).Return(nil).Once()
+
+		assert.True(t, x.Detect())
+	})
+
+	t.Run("Keeps findings open when a query fails", func(t *testing.T) {
+		mockDB, x := setup(t)
+
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.False(t, x.Detect())
+	})
+}
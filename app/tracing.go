@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TracerName         = "github.com/dan13ram/wpokt-validator"
+	TracingServiceName = "wpokt-validator"
+)
+
+var tracerProvider *sdktrace.TracerProvider
+
+// InitTracing exports spans over OTLP/HTTP when tracing is enabled. Otherwise spans are not recorded.
+func InitTracing() {
+	if !Config.Tracing.Enabled {
+		log.Debug("[TRACING] Tracing is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Config.Tracing.TimeoutMillis.Duration())
+	defer cancel()
+
+	exporter, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpointURL(Config.Tracing.Endpoint),
+		otlptracehttp.WithTimeout(Config.Tracing.TimeoutMillis.Duration()),
+	)
+	if err != nil {
+		log.Fatal("[TRACING] Error creating exporter: ", err)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(TracingServiceName),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	log.Info("[TRACING] Exporting traces to ", Config.Tracing.Endpoint)
+}
+
+// ShutdownTracing exports the spans that are still buffered
+func ShutdownTracing() {
+	if tracerProvider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Config.Tracing.TimeoutMillis.Duration())
+	defer cancel()
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		log.Error("[TRACING] Error shutting down tracing: ", err)
+	}
+	tracerProvider = nil
+}
+
+// StartSpan starts a span as a child of the span in ctx, if any
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends a span, marking it as failed if err is set
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TransferAttributes identify a mint, burn or invalid mint in the spans of every service
+func TransferAttributes(docType string, docId *primitive.ObjectID, txHash string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("doc_type", docType),
+		attribute.String("tx_hash", txHash),
+	}
+	if docId != nil {
+		attrs = append(attrs, attribute.String("doc_id", docId.Hex()))
+	}
+	return attrs
+}
+
+// TraceScope provides the context that RPC and database calls are traced under
+type TraceScope interface {
+	TraceContext() context.Context
+}
+
+// Traced is embedded in runners to hold the span they are currently in. Runners run on a
+// single goroutine, so the RPC clients and database calls of a runner are traced as
+// children of the run or document being handled without passing a context around.
+type Traced struct {
+	ctx context.Context
+}
+
+func (t *Traced) TraceContext() context.Context {
+	if t.ctx == nil {
+		return context.Background()
+	}
+	return t.ctx
+}
+
+// StartSpan starts a child of the current span and makes it current until the returned
+// function ends it
+func (t *Traced) StartSpan(name string, attrs ...attribute.KeyValue) func() {
+	parent := t.ctx
+	ctx, span := StartSpan(t.TraceContext(), name, attrs...)
+	t.ctx = ctx
+	return func() {
+		span.End()
+		t.ctx = parent
+	}
+}
+
+// DB returns the database with every operation traced under the current span
+func (t *Traced) DB() Database {
+	return TracedDB(t.TraceContext())
+}
+
+// TracedRunner is a runner that traces its RPC and database calls under the span of its run
+type TracedRunner interface {
+	StartSpan(name string, attrs ...attribute.KeyValue) func()
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestTraced(t *testing.T) {
+	t.Run("Nests spans under the current span", func(t *testing.T) {
+		recorder := recordSpans(t)
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		defer func() { DB = nil }()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, nil, nil).Return(nil).Once()
+
+		x := &Traced{}
+		endRun := x.StartSpan("MINT SIGNER Run")
+		endHandle := x.StartSpan("MINT SIGNER HandleMint", attribute.String("tx_hash", "abc"))
+		x.DB().UpdateOne(models.CollectionMints, nil, nil)
+		endHandle()
+		endRun()
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 3)
+		update, handle, run := spans[0], spans[1], spans[2]
+		assert.Equal(t, "db.UpdateOne", update.Name())
+		assert.Equal(t, handle.SpanContext().SpanID(), update.Parent().SpanID())
+		assert.Equal(t, run.SpanContext().SpanID(), handle.Parent().SpanID())
+		assert.False(t, run.Parent().IsValid())
+		assert.Contains(t, handle.Attributes(), attribute.String("tx_hash", "abc"))
+		assert.Equal(t, run.SpanContext().TraceID(), update.SpanContext().TraceID())
+	})
+
+	t.Run("Starts a new trace after a span ends", func(t *testing.T) {
+		recorder := recordSpans(t)
+
+		x := &Traced{}
+		x.StartSpan("first")()
+		x.StartSpan("second")()
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 2)
+		assert.NotEqual(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	})
+}
+
+func TestInitTracing(t *testing.T) {
+	var mu sync.Mutex
+	var received []*collectortrace.ExportTraceServiceRequest
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		request := &collectortrace.ExportTraceServiceRequest{}
+		assert.Nil(t, proto.Unmarshal(body, request))
+		mu.Lock()
+		received = append(received, request)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+	Config.Tracing = models.TracingConfig{Enabled: true, Endpoint: collector.URL, TimeoutMillis: 5000}
+	defer func() { Config = models.Config{} }()
+
+	InitTracing()
+	x := &Traced{}
+	x.StartSpan("BURN SIGNER Run")()
+	ShutdownTracing()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, received, 1)
+	spans := received[0].ResourceSpans[0].ScopeSpans[0].Spans
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "BURN SIGNER Run", spans[0].Name)
+}
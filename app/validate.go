@@ -0,0 +1,325 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	poktCrypto "github.com/pokt-network/pocket-core/crypto"
+)
+
+// ConfigError is a problem with a single config field
+type ConfigError struct {
+	Path    string
+	Message string
+}
+
+func (e ConfigError) Error() string {
+	return e.Path + " " + e.Message
+}
+
+// ConfigErrors lists every problem found in a config
+type ConfigErrors []ConfigError
+
+func (e ConfigErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("found %d config problems: %s", len(e), strings.Join(messages, "; "))
+}
+
+type configValidator struct {
+	errs ConfigErrors
+}
+
+func (v *configValidator) add(path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, ConfigError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *configValidator) required(path string, missing bool) bool {
+	if missing {
+		v.add(path, "is required")
+	}
+	return !missing
+}
+
+func (v *configValidator) nonNegative(path string, value int64) {
+	if value < 0 {
+		v.add(path, "must not be negative")
+	}
+}
+
+// ValidateConfig checks that every required field is set and that keys, addresses and
+// the validator set are consistent. It returns every problem found, with the path of its field.
+func ValidateConfig(config models.Config) ConfigErrors {
+	v := &configValidator{}
+
+	// mongodb
+	if v.required("MongoDB.URI", config.MongoDB.URI == "") &&
+		!strings.HasPrefix(config.MongoDB.URI, "mongodb://") &&
+		!strings.HasPrefix(config.MongoDB.URI, "mongodb+srv://") {
+		v.add("MongoDB.URI", "must start with mongodb:// or mongodb+srv://")
+	}
+	v.required("MongoDB.Database", config.MongoDB.Database == "")
+	v.required("MongoDB.TimeoutMillis", config.MongoDB.TimeoutMillis == 0)
+	v.nonNegative("MongoDB.TimeoutMillis", int64(config.MongoDB.TimeoutMillis))
+	v.nonNegative("MongoDB.LockTTLMillis", int64(config.MongoDB.LockTTLMillis))
+	v.nonNegative("MongoDB.LockRenewIntervalMillis", int64(config.MongoDB.LockRenewIntervalMillis))
+
+	validateEthereumConfig(v, config.Ethereum)
+	validatePocketConfig(v, config.Pocket)
+	validateApprovalsConfig(v, config.Approvals)
+
+	if config.Screening.Enabled {
+		v.required("Screening.DenyListFile", config.Screening.DenyListFile == "")
+	}
+
+	// services
+	for _, path := range serviceConfigPaths {
+		service := ServiceConfig(config, path)
+		if service.Enabled {
+			v.required(path+".IntervalMillis", service.IntervalMillis == 0)
+		}
+		v.nonNegative(path+".IntervalMillis", int64(service.IntervalMillis))
+	}
+
+	v.nonNegative("StuckTransfers.PendingMillis", int64(config.StuckTransfers.PendingMillis))
+	v.nonNegative("StuckTransfers.ConfirmedMillis", int64(config.StuckTransfers.ConfirmedMillis))
+	v.nonNegative("StuckTransfers.SignedMillis", int64(config.StuckTransfers.SignedMillis))
+	v.nonNegative("StuckTransfers.SubmittedMillis", int64(config.StuckTransfers.SubmittedMillis))
+
+	v.nonNegative("Solvency.Tolerance", config.Solvency.Tolerance)
+
+	for _, volume := range []struct {
+		path   string
+		limits models.VolumeLimitConfig
+	}{
+		{"VolumeLimits.Mints", config.VolumeLimits.Mints},
+		{"VolumeLimits.Burns", config.VolumeLimits.Burns},
+	} {
+		v.nonNegative(volume.path+".RecipientHourly", volume.limits.RecipientHourly)
+		v.nonNegative(volume.path+".RecipientDaily", volume.limits.RecipientDaily)
+		v.nonNegative(volume.path+".SenderHourly", volume.limits.SenderHourly)
+		v.nonNegative(volume.path+".SenderDaily", volume.limits.SenderDaily)
+		v.nonNegative(volume.path+".GlobalHourly", volume.limits.GlobalHourly)
+		v.nonNegative(volume.path+".GlobalDaily", volume.limits.GlobalDaily)
+	}
+
+	v.required("HealthCheck.IntervalMillis", config.HealthCheck.IntervalMillis == 0)
+	v.nonNegative("HealthCheck.IntervalMillis", int64(config.HealthCheck.IntervalMillis))
+
+	// leader election
+	if config.LeaderElection.Enabled {
+		v.required("LeaderElection.LeaseTTLMillis", config.LeaderElection.LeaseTTLMillis == 0)
+		if config.LeaderElection.Scope != models.LeaderScopeValidator && config.LeaderElection.Scope != models.LeaderScopeService {
+			v.add("LeaderElection.Scope", "must be one of: validator, service")
+		}
+	}
+
+	v.nonNegative("HashiCorpVault.TimeoutMillis", int64(config.HashiCorpVault.TimeoutMillis))
+
+	// retry
+	v.nonNegative("Retry.MaxAttempts", config.Retry.MaxAttempts)
+	v.nonNegative("Retry.BackoffBaseMillis", int64(config.Retry.BackoffBaseMillis))
+	v.nonNegative("Retry.BackoffMaxMillis", int64(config.Retry.BackoffMaxMillis))
+
+	v.nonNegative("Secrets.RotationIntervalMillis", int64(config.Secrets.RotationIntervalMillis))
+	if config.Secrets.EthereumAddress != "" && !common.IsHexAddress(config.Secrets.EthereumAddress) {
+		v.add("Secrets.EthereumAddress", "is not a valid hex address")
+	}
+
+	// tracing
+	if config.Tracing.Enabled {
+		if endpoint, err := url.Parse(config.Tracing.Endpoint); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			v.add("Tracing.Endpoint", "must be an http or https url")
+		}
+		v.required("Tracing.TimeoutMillis", config.Tracing.TimeoutMillis == 0)
+		v.nonNegative("Tracing.TimeoutMillis", int64(config.Tracing.TimeoutMillis))
+	}
+
+	// alerts
+	if config.Alerts.Enabled {
+		if config.Alerts.WebhookURL == "" && config.Alerts.SlackWebhookURL == "" && config.Alerts.PagerDutyRoutingKey == "" {
+			v.add("Alerts", "must set a webhook url, slack webhook url or pagerduty routing key when enabled")
+		}
+		for _, field := range []struct{ path, value string }{
+			{"Alerts.WebhookURL", config.Alerts.WebhookURL},
+			{"Alerts.SlackWebhookURL", config.Alerts.SlackWebhookURL},
+		} {
+			if field.value == "" {
+				continue
+			}
+			if u, err := url.Parse(field.value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				v.add(field.path, "must be an http or https url")
+			}
+		}
+		if config.Alerts.PagerDutyRoutingKey != "" {
+			if u, err := url.Parse(config.Alerts.PagerDutyURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				v.add("Alerts.PagerDutyURL", "must be an http or https url")
+			}
+		}
+		v.required("Alerts.TimeoutMillis", config.Alerts.TimeoutMillis == 0)
+		v.nonNegative("Alerts.TimeoutMillis", int64(config.Alerts.TimeoutMillis))
+		v.nonNegative("Alerts.DedupWindowMillis", int64(config.Alerts.DedupWindowMillis))
+		v.nonNegative("Alerts.RateLimit", config.Alerts.RateLimit)
+		v.nonNegative("Alerts.RateLimitWindowMillis", int64(config.Alerts.RateLimitWindowMillis))
+		v.nonNegative("Alerts.UnhealthyRuns", config.Alerts.UnhealthyRuns)
+		v.nonNegative("Alerts.StuckAfterMillis", int64(config.Alerts.StuckAfterMillis))
+	}
+
+	// logger
+	switch strings.ToLower(config.Logger.Level) {
+	case "", "debug", "info", "warn":
+	default:
+		v.add("Logger.Level", "must be one of: debug, info, warn")
+	}
+	switch strings.ToLower(config.Logger.Format) {
+	case "", LogFormatText, LogFormatJSON:
+	default:
+		v.add("Logger.Format", "must be one of: text, json")
+	}
+	v.nonNegative("Logger.MaxSizeMB", config.Logger.MaxSizeMB)
+	v.nonNegative("Logger.MaxBackups", config.Logger.MaxBackups)
+
+	return v.errs
+}
+
+func validateEthereumConfig(v *configValidator, config models.EthereumConfig) {
+	v.required("Ethereum.RPCURL", config.RPCURL == "")
+	if v.required("Ethereum.ChainId", config.ChainId == "") {
+		if _, err := strconv.ParseUint(config.ChainId, 10, 64); err != nil {
+			v.add("Ethereum.ChainId", "must be a number")
+		}
+	}
+	v.required("Ethereum.RPCTimeoutMillis", config.RPCTimeoutMillis == 0)
+	v.nonNegative("Ethereum.RPCTimeoutMillis", int64(config.RPCTimeoutMillis))
+	v.nonNegative("Ethereum.Confirmations", config.Confirmations)
+	v.nonNegative("Ethereum.StartBlockNumber", config.StartBlockNumber)
+
+	ownAddress := ""
+	if v.required("Ethereum.PrivateKey", config.PrivateKey == "") {
+		key, err := ethCrypto.HexToECDSA(config.PrivateKey)
+		if err != nil {
+			v.add("Ethereum.PrivateKey", "is not a valid private key: %s", err.Error())
+		} else {
+			ownAddress = ethCrypto.PubkeyToAddress(key.PublicKey).Hex()
+		}
+	}
+
+	if v.required("Ethereum.WrappedPocketAddress", config.WrappedPocketAddress == "") && !common.IsHexAddress(config.WrappedPocketAddress) {
+		v.add("Ethereum.WrappedPocketAddress", "is not a valid hex address")
+	}
+	if v.required("Ethereum.MintControllerAddress", config.MintControllerAddress == "") && !common.IsHexAddress(config.MintControllerAddress) {
+		v.add("Ethereum.MintControllerAddress", "is not a valid hex address")
+	}
+
+	if !v.required("Ethereum.ValidatorAddresses", len(config.ValidatorAddresses) == 0) {
+		return
+	}
+	seen := make(map[string]int)
+	for i, address := range config.ValidatorAddresses {
+		path := fmt.Sprintf("Ethereum.ValidatorAddresses[%d]", i)
+		if !common.IsHexAddress(address) {
+			v.add(path, "is not a valid hex address")
+			continue
+		}
+		key := strings.ToLower(common.HexToAddress(address).Hex())
+		if j, ok := seen[key]; ok {
+			v.add(path, "duplicates Ethereum.ValidatorAddresses[%d]", j)
+			continue
+		}
+		seen[key] = i
+	}
+	if _, ok := seen[strings.ToLower(ownAddress)]; ownAddress != "" && !ok {
+		v.add("Ethereum.ValidatorAddresses", "does not contain the address of Ethereum.PrivateKey %s", ownAddress)
+	}
+}
+
+func validateApprovalsConfig(v *configValidator, config models.ApprovalsConfig) {
+	v.nonNegative("Approvals.MintThreshold", config.MintThreshold)
+	v.nonNegative("Approvals.BurnThreshold", config.BurnThreshold)
+	if config.MintThreshold <= 0 && config.BurnThreshold <= 0 {
+		return
+	}
+
+	if v.required("Approvals.Quorum", config.Quorum == 0) {
+		v.nonNegative("Approvals.Quorum", config.Quorum)
+	}
+	if !v.required("Approvals.OperatorAddresses", len(config.OperatorAddresses) == 0) {
+		return
+	}
+	seen := make(map[string]int)
+	for i, address := range config.OperatorAddresses {
+		path := fmt.Sprintf("Approvals.OperatorAddresses[%d]", i)
+		if !common.IsHexAddress(address) {
+			v.add(path, "is not a valid hex address")
+			continue
+		}
+		key := strings.ToLower(common.HexToAddress(address).Hex())
+		if j, ok := seen[key]; ok {
+			v.add(path, "duplicates Approvals.OperatorAddresses[%d]", j)
+			continue
+		}
+		seen[key] = i
+	}
+	if config.Quorum > int64(len(config.OperatorAddresses)) {
+		v.add("Approvals.Quorum", "must not be more than the %d operator addresses", len(config.OperatorAddresses))
+	}
+}
+
+func validatePocketConfig(v *configValidator, config models.PocketConfig) {
+	v.required("Pocket.RPCURL", config.RPCURL == "")
+	v.required("Pocket.ChainId", config.ChainId == "")
+	v.required("Pocket.RPCTimeoutMillis", config.RPCTimeoutMillis == 0)
+	v.nonNegative("Pocket.RPCTimeoutMillis", int64(config.RPCTimeoutMillis))
+	v.nonNegative("Pocket.Confirmations", config.Confirmations)
+	v.nonNegative("Pocket.StartHeight", config.StartHeight)
+
+	ownPublicKey := ""
+	if v.required("Pocket.PrivateKey", config.PrivateKey == "") {
+		key, err := poktCrypto.NewPrivateKey(config.PrivateKey)
+		if err != nil {
+			v.add("Pocket.PrivateKey", "is not a valid private key: %s", err.Error())
+		} else {
+			ownPublicKey = strings.ToLower(key.PublicKey().RawString())
+		}
+	}
+
+	hasVault := v.required("Pocket.VaultAddress", config.VaultAddress == "")
+
+	if !v.required("Pocket.MultisigPublicKeys", len(config.MultisigPublicKeys) == 0) {
+		return
+	}
+	var keys []poktCrypto.PublicKey
+	seen := make(map[string]int)
+	for i, pk := range config.MultisigPublicKeys {
+		path := fmt.Sprintf("Pocket.MultisigPublicKeys[%d]", i)
+		key, err := poktCrypto.NewPublicKey(pk)
+		if err != nil {
+			v.add(path, "is not a valid public key: %s", err.Error())
+			continue
+		}
+		raw := strings.ToLower(key.RawString())
+		if j, ok := seen[raw]; ok {
+			v.add(path, "duplicates Pocket.MultisigPublicKeys[%d]", j)
+			continue
+		}
+		seen[raw] = i
+		keys = append(keys, key)
+	}
+	if len(keys) != len(config.MultisigPublicKeys) {
+		return
+	}
+	if _, ok := seen[ownPublicKey]; ownPublicKey != "" && !ok {
+		v.add("Pocket.MultisigPublicKeys", "does not contain the public key of Pocket.PrivateKey %s", ownPublicKey)
+	}
+	multisigAddress := poktCrypto.PublicKeyMultiSignature{PublicKeys: keys}.Address().String()
+	if hasVault && !strings.EqualFold(multisigAddress, config.VaultAddress) {
+		v.add("Pocket.VaultAddress", "does not match the multisig address %s of Pocket.MultisigPublicKeys", strings.ToLower(multisigAddress))
+	}
+}
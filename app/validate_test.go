@@ -0,0 +1,186 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleConfig(t *testing.T) models.Config {
+	var config models.Config
+	assert.Nil(t, loadConfigFile(&config, "../config.sample.yml"))
+	return config
+}
+
+func errorPaths(errs ConfigErrors) []string {
+	paths := []string{}
+	for _, err := range errs {
+		paths = append(paths, err.Path)
+	}
+	return paths
+}
+
+func TestValidateConfigErrors(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		errs := ValidateConfig(sampleConfig(t))
+
+		assert.Empty(t, errs)
+	})
+
+	t.Run("Collects every missing field", func(t *testing.T) {
+		config := models.Config{}
+		config.MintSigner.Enabled = true
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"MongoDB.URI",
+			"MongoDB.Database",
+			"MongoDB.TimeoutMillis",
+			"Ethereum.RPCURL",
+			"Ethereum.ChainId",
+			"Ethereum.RPCTimeoutMillis",
+			"Ethereum.PrivateKey",
+			"Ethereum.WrappedPocketAddress",
+			"Ethereum.MintControllerAddress",
+			"Ethereum.ValidatorAddresses",
+			"Pocket.RPCURL",
+			"Pocket.ChainId",
+			"Pocket.RPCTimeoutMillis",
+			"Pocket.PrivateKey",
+			"Pocket.VaultAddress",
+			"Pocket.MultisigPublicKeys",
+			"MintSigner.IntervalMillis",
+			"HealthCheck.IntervalMillis",
+		}, errorPaths(errs))
+		assert.Equal(t, "MongoDB.URI is required", errs[0].Error())
+		assert.Contains(t, errs.Error(), "found 18 config problems")
+	})
+
+	t.Run("Invalid keys and addresses", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.MongoDB.URI = "localhost:27017"
+		config.Ethereum.ChainId = "goerli"
+		config.Ethereum.PrivateKey = "0x1234"
+		config.Ethereum.WrappedPocketAddress = "0x1234"
+		config.Ethereum.ValidatorAddresses = []string{"0x1234"}
+		config.Pocket.PrivateKey = "1234"
+		config.Pocket.MultisigPublicKeys = []string{"1234"}
+		config.Logger.Level = "trace"
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"MongoDB.URI",
+			"Ethereum.ChainId",
+			"Ethereum.PrivateKey",
+			"Ethereum.WrappedPocketAddress",
+			"Ethereum.ValidatorAddresses[0]",
+			"Pocket.PrivateKey",
+			"Pocket.MultisigPublicKeys[0]",
+			"Logger.Level",
+		}, errorPaths(errs))
+	})
+
+	t.Run("Validator set", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.Ethereum.ValidatorAddresses = []string{
+			config.Ethereum.ValidatorAddresses[1],
+			config.Ethereum.ValidatorAddresses[2],
+			config.Ethereum.ValidatorAddresses[1],
+		}
+		config.Pocket.MultisigPublicKeys = []string{
+			config.Pocket.MultisigPublicKeys[1],
+			config.Pocket.MultisigPublicKeys[1],
+		}
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"Ethereum.ValidatorAddresses[2]",
+			"Ethereum.ValidatorAddresses",
+			"Pocket.MultisigPublicKeys[1]",
+		}, errorPaths(errs))
+		assert.Equal(t, "Ethereum.ValidatorAddresses[2] duplicates Ethereum.ValidatorAddresses[0]", errs[0].Error())
+	})
+
+	t.Run("Own key and vault", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.Pocket.MultisigPublicKeys = config.Pocket.MultisigPublicKeys[1:]
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"Pocket.MultisigPublicKeys",
+			"Pocket.VaultAddress",
+		}, errorPaths(errs))
+	})
+
+	t.Run("Alerts", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.Alerts.Enabled = true
+		config.Alerts.WebhookURL = ""
+		config.Alerts.SlackWebhookURL = ""
+		config.Alerts.PagerDutyRoutingKey = ""
+		config.Alerts.RateLimit = -1
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"Alerts",
+			"Alerts.RateLimit",
+		}, errorPaths(errs))
+
+		config.Alerts.RateLimit = 10
+		config.Alerts.WebhookURL = "hooks.example.com"
+		config.Alerts.PagerDutyRoutingKey = "routing-key"
+		config.Alerts.PagerDutyURL = ""
+
+		errs = ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"Alerts.WebhookURL",
+			"Alerts.PagerDutyURL",
+		}, errorPaths(errs))
+	})
+	t.Run("Approvals", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.Approvals.MintThreshold = 1000000
+		config.Approvals.Quorum = 3
+		config.Approvals.OperatorAddresses = []string{
+			"0x1111111111111111111111111111111111111111",
+			"not an address",
+			"0x1111111111111111111111111111111111111111",
+		}
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{
+			"Approvals.OperatorAddresses[1]",
+			"Approvals.OperatorAddresses[2]",
+		}, errorPaths(errs))
+
+		config.Approvals.Quorum = 0
+		config.Approvals.OperatorAddresses = []string{"0x1111111111111111111111111111111111111111"}
+
+		errs = ValidateConfig(config)
+
+		assert.Equal(t, []string{"Approvals.Quorum"}, errorPaths(errs))
+
+		config.Approvals.Quorum = 2
+
+		errs = ValidateConfig(config)
+
+		assert.Equal(t, []string{"Approvals.Quorum"}, errorPaths(errs))
+	})
+
+	t.Run("Screening", func(t *testing.T) {
+		config := sampleConfig(t)
+		config.Screening.Enabled = true
+
+		errs := ValidateConfig(config)
+
+		assert.Equal(t, []string{"Screening.DenyListFile"}, errorPaths(errs))
+	})
+}
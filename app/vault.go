@@ -0,0 +1,83 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+const SchemeVault = "vault"
+
+// VaultSecretProvider reads secrets from a HashiCorp Vault KV engine over HTTP.
+// A reference vault://secret/data/wpokt#eth_private_key reads the eth_private_key
+// key of the secret at secret/data/wpokt.
+type VaultSecretProvider struct {
+	address   string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+func (p *VaultSecretProvider) Fetch(ref *url.URL) (string, error) {
+	if p.address == "" {
+		return "", fmt.Errorf("hashicorp_vault.address is not set")
+	}
+	key := ref.Fragment
+	if key == "" {
+		return "", fmt.Errorf("secret key is empty")
+	}
+	path := strings.Trim(ref.Host+ref.Path, "/")
+
+	req, err := http.NewRequest(http.MethodGet, p.address+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("error decoding vault response: %w", err)
+	}
+
+	data := body.Data
+	// KV version 2 nests the secret under data, next to its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+
+	value, ok := data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("secret has no key %q", key)
+	}
+	return value, nil
+}
+
+func NewVaultSecretProvider(config models.HashiCorpVaultConfig) *VaultSecretProvider {
+	return &VaultSecretProvider{
+		address:   strings.TrimRight(config.Address, "/"),
+		token:     config.Token,
+		namespace: config.Namespace,
+		client:    &http.Client{Timeout: config.TimeoutMillis.Duration()},
+	}
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	VolumeScopeRecipient = "recipient"
+	VolumeScopeSender    = "sender"
+	VolumeScopeGlobal    = "global"
+)
+
+// VolumeLimit is the uPOKT that may be signed for in a rolling window
+type VolumeLimit struct {
+	Scope  string
+	Period string
+	Window time.Duration
+	Limit  int64
+}
+
+// VolumeLimits lists the limits of a config that are enabled
+func VolumeLimits(config models.VolumeLimitConfig) []VolumeLimit {
+	all := []VolumeLimit{
+		{VolumeScopeRecipient, "hourly", time.Hour, config.RecipientHourly},
+		{VolumeScopeRecipient, "daily", 24 * time.Hour, config.RecipientDaily},
+		{VolumeScopeSender, "hourly", time.Hour, config.SenderHourly},
+		{VolumeScopeSender, "daily", 24 * time.Hour, config.SenderDaily},
+		{VolumeScopeGlobal, "hourly", time.Hour, config.GlobalHourly},
+		{VolumeScopeGlobal, "daily", 24 * time.Hour, config.GlobalDaily},
+	}
+	limits := []VolumeLimit{}
+	for _, limit := range all {
+		if limit.Limit > 0 {
+			limits = append(limits, limit)
+		}
+	}
+	return limits
+}
+
+// VolumeEntry is the part of a mint or burn that counts toward the volume limits
+type VolumeEntry struct {
+	Amount           string    `bson:"amount"`
+	SenderAddress    string    `bson:"sender_address"`
+	RecipientAddress string    `bson:"recipient_address"`
+	CountedAt        time.Time `bson:"counted_at"`
+}
+
+// VolumeTransfer is a document that is about to be signed
+type VolumeTransfer struct {
+	Id        *primitive.ObjectID
+	Sender    string
+	Recipient string
+	Amount    *big.Int
+}
+
+// ExceededVolumeLimit returns the first limit that signing the transfer would exceed, and the
+// volume it would reach. Documents count toward the limits from their first signature until
+// they fail, so that every validator sees the same volume.
+func ExceededVolumeLimit(
+	db Database,
+	collection string,
+	filter bson.M,
+	transfer VolumeTransfer,
+	config models.VolumeLimitConfig,
+	now time.Time,
+) (*VolumeLimit, *big.Int, error) {
+	limits := VolumeLimits(config)
+	if len(limits) == 0 {
+		return nil, nil, nil
+	}
+
+	longest := time.Duration(0)
+	for _, limit := range limits {
+		if limit.Window > longest {
+			longest = limit.Window
+		}
+	}
+
+	filter["_id"] = bson.M{"$ne": transfer.Id}
+	filter["status"] = bson.M{"$ne": models.StatusFailed}
+	filter["counted_at"] = bson.M{"$gte": now.Add(-longest)}
+
+	var entries []VolumeEntry
+	if err := db.FindMany(collection, filter, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	for i := range limits {
+		limit := limits[i]
+		volume := new(big.Int).Set(transfer.Amount)
+		for _, entry := range entries {
+			if entry.CountedAt.Before(now.Add(-limit.Window)) {
+				continue
+			}
+			if limit.Scope == VolumeScopeRecipient && !strings.EqualFold(entry.RecipientAddress, transfer.Recipient) {
+				continue
+			}
+			if limit.Scope == VolumeScopeSender && !strings.EqualFold(entry.SenderAddress, transfer.Sender) {
+				continue
+			}
+			amount, ok := new(big.Int).SetString(entry.Amount, 10)
+			if !ok {
+				return nil, nil, fmt.Errorf("invalid amount %s in %s", entry.Amount, collection)
+			}
+			volume.Add(volume, amount)
+		}
+		if volume.Cmp(big.NewInt(limit.Limit)) > 0 {
+			return &limit, volume, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// HoldReason checks the volume limits before a transfer is signed. It returns why the transfer
+// must be held, or an empty string if it can be signed. Going over a global limit also trips
+// the signing breaker.
+func HoldReason(db Database, collection string, filter bson.M, transfer VolumeTransfer, config models.VolumeLimitConfig) (string, error) {
+	limit, volume, err := ExceededVolumeLimit(db, collection, filter, transfer, config, time.Now())
+	if err != nil || limit == nil {
+		return "", err
+	}
+
+	reason := fmt.Sprintf("%s %s volume of %s would exceed the limit of %d", limit.Scope, limit.Period, volume.String(), limit.Limit)
+	if limit.Scope == VolumeScopeGlobal {
+		if err := TripBreaker(models.BreakerTriggerVolumeLimit, collection+" "+reason, ValidatorOperator()); err != nil {
+			log.Error("[VOLUME] Error tripping signing breaker: ", err)
+		}
+	}
+	return reason, nil
+}
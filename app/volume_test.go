@@ -0,0 +1,134 @@
+package app
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestVolumeLimits(t *testing.T) {
+	assert.Empty(t, VolumeLimits(models.VolumeLimitConfig{}))
+
+	limits := VolumeLimits(models.VolumeLimitConfig{SenderDaily: 10, GlobalHourly: 20})
+	assert.Equal(t, []VolumeLimit{
+		{VolumeScopeSender, "daily", 24 * time.Hour, 10},
+		{VolumeScopeGlobal, "hourly", time.Hour, 20},
+	}, limits)
+}
+
+func TestExceededVolumeLimit(t *testing.T) {
+	now := time.Now()
+	id := primitive.NewObjectID()
+	transfer := VolumeTransfer{Id: &id, Sender: "sender", Recipient: "Recipient", Amount: big.NewInt(100)}
+
+	entries := []VolumeEntry{
+		{SenderAddress: "sender", RecipientAddress: "recipient", Amount: "300", CountedAt: now.Add(-10 * time.Minute)},
+		{SenderAddress: "other", RecipientAddress: "recipient", Amount: "200", CountedAt: now.Add(-2 * time.Hour)},
+		{SenderAddress: "other", RecipientAddress: "other", Amount: "400", CountedAt: now.Add(-20 * time.Minute)},
+	}
+
+	expect := func(mockDB *MockDatabase, longest time.Duration) {
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, result interface{}) {
+				assert.Equal(t, bson.M{
+					"wpokt_address": "wpokt",
+					"_id":           bson.M{"$ne": &id},
+					"status":        bson.M{"$ne": models.StatusFailed},
+					"counted_at":    bson.M{"$gte": now.Add(-longest)},
+				}, filter)
+				*result.(*[]VolumeEntry) = entries
+			}).Once()
+	}
+
+	t.Run("No limits", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+
+		limit, volume, err := ExceededVolumeLimit(mockDB, models.CollectionMints, bson.M{}, transfer, models.VolumeLimitConfig{}, now)
+
+		assert.Nil(t, err)
+		assert.Nil(t, limit)
+		assert.Nil(t, volume)
+	})
+
+	t.Run("Within limits", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		expect(mockDB, 24*time.Hour)
+
+		config := models.VolumeLimitConfig{RecipientHourly: 400, RecipientDaily: 600, SenderDaily: 400, GlobalHourly: 800}
+		limit, _, err := ExceededVolumeLimit(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpokt"}, transfer, config, now)
+
+		assert.Nil(t, err)
+		assert.Nil(t, limit)
+	})
+
+	t.Run("Recipient daily limit", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		expect(mockDB, 24*time.Hour)
+
+		config := models.VolumeLimitConfig{RecipientHourly: 400, RecipientDaily: 599}
+		limit, volume, err := ExceededVolumeLimit(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpokt"}, transfer, config, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &VolumeLimit{VolumeScopeRecipient, "daily", 24 * time.Hour, 599}, limit)
+		assert.Equal(t, "600", volume.String())
+	})
+
+	t.Run("Global hourly limit", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		expect(mockDB, time.Hour)
+
+		config := models.VolumeLimitConfig{GlobalHourly: 500}
+		limit, volume, err := ExceededVolumeLimit(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpokt"}, transfer, config, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, VolumeScopeGlobal, limit.Scope)
+		assert.Equal(t, "800", volume.String())
+	})
+
+	t.Run("Error fetching volume", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		_, _, err := ExceededVolumeLimit(mockDB, models.CollectionMints, bson.M{}, transfer, models.VolumeLimitConfig{GlobalDaily: 1}, now)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHoldReason(t *testing.T) {
+	id := primitive.NewObjectID()
+	transfer := VolumeTransfer{Id: &id, Sender: "sender", Recipient: "recipient", Amount: big.NewInt(100)}
+
+	t.Run("Per address limit does not trip breaker", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		DB = mockDB
+		Config.CircuitBreaker.VolumeLimit = true
+		t.Cleanup(func() {
+			Config = models.Config{}
+			DB = nil
+		})
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+
+		reason, err := HoldReason(mockDB, models.CollectionBurns, bson.M{}, transfer, models.VolumeLimitConfig{SenderHourly: 50})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "sender hourly volume of 100 would exceed the limit of 50", reason)
+	})
+
+	t.Run("Within limits", func(t *testing.T) {
+		mockDB := NewMockDatabase(t)
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+
+		reason, err := HoldReason(mockDB, models.CollectionBurns, bson.M{}, transfer, models.VolumeLimitConfig{GlobalHourly: 100})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", reason)
+	})
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// approvalTypes are the documents that can wait for operator approvals before they are signed
+var approvalTypes = []documentType{
+	{name: "mints", collection: models.CollectionMints},
+	{name: "burns", collection: models.CollectionBurns},
+}
+
+// approvalDocument holds the fields of a mint or burn that an approval covers
+type approvalDocument struct {
+	Id               *primitive.ObjectID `bson:"_id"`
+	TransactionHash  string              `bson:"transaction_hash"`
+	RecipientAddress string              `bson:"recipient_address"`
+	Amount           string              `bson:"amount"`
+	Status           string              `bson:"status"`
+	Approvals        []models.Approval   `bson:"approvals"`
+}
+
+func approvalCommands() []Command {
+	commands := []Command{}
+	for _, t := range approvalTypes {
+		t := t
+		commands = append(commands,
+			Command{
+				Name:  t.name + " approval-message",
+				Usage: t.name + " approval-message <id>",
+				Run:   t.approvalMessage,
+			},
+			Command{
+				Name:  t.name + " approve",
+				Usage: t.name + " approve <id> (--key-file <file> | --signature <hex>) [--operator <name>]",
+				Run:   t.approve,
+			},
+		)
+	}
+	return commands
+}
+
+func (t documentType) findForApproval(id string) (approvalDocument, string, error) {
+	var doc approvalDocument
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return doc, "", fmt.Errorf("invalid id %s: %w", id, err)
+	}
+	if err := app.DB.FindOne(t.collection, bson.M{"_id": objectId}, &doc); err != nil {
+		return doc, "", err
+	}
+	message := app.ApprovalMessage(t.collection, doc.Id.Hex(), doc.TransactionHash, doc.RecipientAddress, doc.Amount)
+	return doc, message, nil
+}
+
+// approvalMessage prints the message to sign, for operators who approve with an external wallet
+func (t documentType) approvalMessage(args []string) error {
+	fs := flag.NewFlagSet(t.name+" approval-message", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+
+	requireDB()
+	_, message, err := t.findForApproval(positional[0])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, message)
+	return nil
+}
+
+func (t documentType) approve(args []string) error {
+	fs := flag.NewFlagSet(t.name+" approve", flag.ContinueOnError)
+	operator := operatorFlag(fs)
+	keyFile := fs.String("key-file", "", "file holding the hex private key of the approving operator")
+	signature := fs.String("signature", "", "approval signature made with an external wallet")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+	if (*keyFile == "") == (*signature == "") {
+		return errors.New("exactly one of key-file or signature is required")
+	}
+	if *operator == "" {
+		return errors.New("operator is required")
+	}
+
+	requireDB()
+	doc, message, err := t.findForApproval(positional[0])
+	if err != nil {
+		return err
+	}
+	if doc.Status != models.StatusAwaitingApproval {
+		return fmt.Errorf("cannot approve %s in status %s", doc.Id.Hex(), doc.Status)
+	}
+
+	if *keyFile != "" {
+		contents, err := os.ReadFile(*keyFile)
+		if err != nil {
+			return fmt.Errorf("error reading key file: %w", err)
+		}
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(contents)), "0x"))
+		if err != nil {
+			return fmt.Errorf("error loading operator key: %w", err)
+		}
+		if *signature, err = app.SignApproval(message, key); err != nil {
+			return err
+		}
+	}
+
+	address, err := app.ApprovalOperator(message, *signature)
+	if err != nil {
+		return err
+	}
+	for _, approval := range doc.Approvals {
+		if strings.EqualFold(approval.Operator, address) {
+			return fmt.Errorf("%s %s is already approved by %s", t.name, doc.Id.Hex(), address)
+		}
+	}
+
+	approval := models.Approval{
+		Operator:   address,
+		Signature:  *signature,
+		ApprovedAt: time.Now(),
+	}
+	filter := bson.M{"_id": doc.Id, "status": models.StatusAwaitingApproval}
+	update := bson.M{
+		"$push": bson.M{"approvals": approval},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+	if err := app.DB.UpdateOne(t.collection, filter, update); err != nil {
+		return err
+	}
+
+	approved := app.CountApprovals(message, append(doc.Approvals, approval))
+	fmt.Fprintf(out, "%s %s approved by %s (%d of %d)\n", t.name, doc.Id.Hex(), address, approved, app.Config.Approvals.Quorum)
+	return app.RecordAudit(models.AuditLog{
+		Action:     models.AuditActionApprove,
+		Operator:   *operator,
+		Collection: t.collection,
+		DocumentId: doc.Id.Hex(),
+		StatusFrom: doc.Status,
+		StatusTo:   doc.Status,
+		Reason:     "approved by " + address,
+	})
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestApprove(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	keyFile := filepath.Join(t.TempDir(), "operator.key")
+	assert.Nil(t, os.WriteFile(keyFile, []byte(hexutil.Encode(crypto.FromECDSA(key))+"\n"), 0600))
+
+	setup := func(t *testing.T, doc approvalDocument) (*app.MockDatabase, *bytes.Buffer) {
+		mockDB, buffer := setupTest(t)
+		app.Config.Approvals = models.ApprovalsConfig{BurnThreshold: 100, Quorum: 2, OperatorAddresses: []string{address}}
+		t.Cleanup(func() { app.Config = models.Config{} })
+
+		mockDB.EXPECT().FindOne(models.CollectionBurns, bson.M{"_id": *doc.Id}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*approvalDocument) = doc
+			}).Once()
+		return mockDB, buffer
+	}
+
+	id := primitive.NewObjectID()
+	doc := approvalDocument{
+		Id:               &id,
+		TransactionHash:  "0xhash",
+		RecipientAddress: "abcd",
+		Amount:           "500",
+		Status:           models.StatusAwaitingApproval,
+	}
+	message := app.ApprovalMessage(models.CollectionBurns, id.Hex(), "0xhash", "abcd", "500")
+
+	t.Run("Approval message", func(t *testing.T) {
+		_, buffer := setup(t, doc)
+
+		code := Run([]string{"burns", "approval-message", id.Hex()})
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, message+"\n", buffer.String())
+	})
+
+	t.Run("Approves with key file", func(t *testing.T) {
+		mockDB, buffer := setup(t, doc)
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, bson.M{"_id": &id, "status": models.StatusAwaitingApproval}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				approval := update.(bson.M)["$push"].(bson.M)["approvals"].(models.Approval)
+				assert.Equal(t, address, approval.Operator)
+				operator, err := app.ApprovalOperator(message, approval.Signature)
+				assert.Nil(t, err)
+				assert.Equal(t, address, operator)
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == models.AuditActionApprove && audit.Operator == "alice" && audit.DocumentId == id.Hex()
+		})).Return(nil).Once()
+
+		code := Run([]string{"burns", "approve", id.Hex(), "--key-file", keyFile, "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, buffer.String(), "approved by "+address+" (1 of 2)")
+	})
+
+	t.Run("Already approved", func(t *testing.T) {
+		signature, _ := app.SignApproval(message, key)
+		approved := doc
+		approved.Approvals = []models.Approval{{Operator: address, Signature: signature}}
+		setup(t, approved)
+
+		code := Run([]string{"burns", "approve", id.Hex(), "--signature", signature, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Unknown operator", func(t *testing.T) {
+		other, _ := crypto.GenerateKey()
+		signature, _ := app.SignApproval(message, other)
+		setup(t, doc)
+
+		code := Run([]string{"burns", "approve", id.Hex(), "--signature", signature, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Not awaiting approval", func(t *testing.T) {
+		signed := doc
+		signed.Status = models.StatusSigned
+		setup(t, signed)
+
+		code := Run([]string{"burns", "approve", id.Hex(), "--key-file", keyFile, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Requires one of key file or signature", func(t *testing.T) {
+		setupTest(t)
+
+		assert.Equal(t, 1, Run([]string{"burns", "approve", id.Hex(), "--operator", "alice"}))
+	})
+}
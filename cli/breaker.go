@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+func breakerCommands() []Command {
+	return []Command{
+		{
+			Name:  "breaker status",
+			Usage: "breaker status",
+			Run:   breakerStatus,
+		},
+		{
+			Name:  "breaker trip",
+			Usage: "breaker trip --reason <reason> [--operator <name>]",
+			Run:   tripBreaker,
+		},
+		{
+			Name:  "breaker reset",
+			Usage: "breaker reset --reason <reason> [--operator <name>]",
+			Run:   resetBreaker,
+		},
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func breakerStatus(args []string) error {
+	fs := flag.NewFlagSet("breaker status", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return ErrUsage
+	}
+
+	requireDB()
+	breaker, err := app.SigningBreaker(app.DB)
+	if err != nil {
+		return err
+	}
+
+	status := models.BreakerStatusReset
+	if breaker.Tripped {
+		status = models.BreakerStatusTripped
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "status\t%s\n", status)
+	fmt.Fprintf(w, "trigger\t%s\n", breaker.Trigger)
+	fmt.Fprintf(w, "reason\t%s\n", breaker.Reason)
+	fmt.Fprintf(w, "tripped_by\t%s\n", breaker.TrippedBy)
+	fmt.Fprintf(w, "tripped_at\t%s\n", formatTime(breaker.TrippedAt))
+	fmt.Fprintf(w, "reset_by\t%s\n", breaker.ResetBy)
+	fmt.Fprintf(w, "reset_reason\t%s\n", breaker.ResetReason)
+	fmt.Fprintf(w, "reset_at\t%s\n", formatTime(breaker.ResetAt))
+	return w.Flush()
+}
+
+// breakerFlags parses the flags shared by trip and reset, which both need a reason
+func breakerFlags(name string, args []string) (string, string, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	operator := operatorFlag(fs)
+	reason := fs.String("reason", "", "why the breaker is changed")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return "", "", ErrUsage
+	}
+	if *reason == "" {
+		return "", "", errors.New("reason is required")
+	}
+	if *operator == "" {
+		return "", "", errors.New("operator is required")
+	}
+	return *operator, *reason, nil
+}
+
+func tripBreaker(args []string) error {
+	operator, reason, err := breakerFlags("breaker trip", args)
+	if err != nil {
+		return err
+	}
+
+	requireDB()
+	breaker, err := app.SigningBreaker(app.DB)
+	if err != nil {
+		return err
+	}
+	if breaker.Tripped {
+		return fmt.Errorf("signing breaker is already tripped by %s: %s", breaker.TrippedBy, breaker.Reason)
+	}
+	if err := app.TripBreaker(models.BreakerTriggerManual, reason, operator); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "signing breaker tripped")
+	return nil
+}
+
+func resetBreaker(args []string) error {
+	operator, reason, err := breakerFlags("breaker reset", args)
+	if err != nil {
+		return err
+	}
+
+	requireDB()
+	if err := app.ResetBreaker(operator, reason); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "signing breaker reset")
+	return nil
+}
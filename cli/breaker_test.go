@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var breakerFilter = bson.M{"_id": models.SigningBreakerId}
+
+func TestBreakerStatus(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, breakerFilter, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*models.CircuitBreaker) = models.CircuitBreaker{
+				Id:        models.SigningBreakerId,
+				Tripped:   true,
+				Trigger:   models.BreakerTriggerSolvency,
+				Reason:    "vault balance is off by -100",
+				TrippedBy: "validator-1",
+			}
+		}).Once()
+
+	code := Run([]string{"breaker", "status"})
+
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `status\s+tripped`, buffer.String())
+	assert.Regexp(t, `trigger\s+solvency_mismatch`, buffer.String())
+	assert.Regexp(t, `tripped_by\s+validator-1`, buffer.String())
+}
+
+func TestBreakerTrip(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+
+	assert.Equal(t, 1, Run([]string{"breaker", "trip", "--operator", "alice"}))
+
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, breakerFilter, mock.Anything).Return(mongo.ErrNoDocuments).Twice()
+	mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, breakerFilter, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, update interface{}) {
+			set := update.(bson.M)["$set"].(bson.M)
+			assert.Equal(t, models.BreakerTriggerManual, set["trigger"])
+			assert.Equal(t, "investigating", set["reason"])
+			assert.Equal(t, "alice", set["tripped_by"])
+		}).Once()
+	mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+		return audit.Action == models.AuditActionTripBreaker && audit.Operator == "alice"
+	})).Return(nil).Once()
+
+	code := Run([]string{"breaker", "trip", "--reason", "investigating", "--operator", "alice"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, buffer.String(), "signing breaker tripped")
+}
+
+func TestBreakerReset(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, breakerFilter, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+	assert.Equal(t, 1, Run([]string{"breaker", "reset", "--reason", "reconciled", "--operator", "alice"}))
+
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, breakerFilter, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*models.CircuitBreaker) = models.CircuitBreaker{Id: models.SigningBreakerId, Tripped: true}
+		}).Once()
+	mockDB.EXPECT().UpdateOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId, "tripped": true}, mock.Anything).Return(nil).Once()
+	mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+		return audit.Action == models.AuditActionResetBreaker && audit.Operator == "alice" && audit.Reason == "reconciled"
+	})).Return(nil).Once()
+
+	code := Run([]string{"breaker", "reset", "--reason", "reconciled", "--operator", "alice"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, buffer.String(), "signing breaker reset")
+}
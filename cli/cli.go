@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/app"
+)
+
+// Command is an operator subcommand run instead of the validator services
+type Command struct {
+	Name  string
+	Usage string
+	Run   func(args []string) error
+}
+
+var (
+	out    io.Writer = os.Stdout
+	errOut io.Writer = os.Stderr
+
+	dbOwned = false
+
+	ErrUsage = errors.New("invalid usage")
+)
+
+// Commands returns every subcommand keyed by its name
+func Commands() map[string]Command {
+	commands := map[string]Command{}
+	for _, command := range documentCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range txCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range offlineCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range configCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range stuckCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range breakerCommands() {
+		commands[command.Name] = command
+	}
+	for _, command := range approvalCommands() {
+		commands[command.Name] = command
+	}
+	return commands
+}
+
+// Run executes the subcommand named by the first arguments and returns the process exit code
+func Run(args []string) int {
+	commands := Commands()
+
+	name, rest := commandName(commands, args)
+	command, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(errOut, "unknown command: %s\n\n", strings.Join(args, " "))
+		printUsage(commands)
+		return 2
+	}
+
+	err := command.Run(rest)
+	if app.DB != nil && dbOwned {
+		app.DB.Disconnect()
+	}
+
+	if errors.Is(err, ErrUsage) || errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintf(errOut, "usage: validator %s\n", command.Usage)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(errOut, "error:", err)
+		return 1
+	}
+	return 0
+}
+
+// commandName matches the longest command name made of the leading arguments
+func commandName(commands map[string]Command, args []string) (string, []string) {
+	for i := len(args); i > 0; i-- {
+		name := strings.Join(args[:i], " ")
+		if _, ok := commands[name]; ok {
+			return name, args[i:]
+		}
+	}
+	return strings.Join(args, " "), nil
+}
+
+func printUsage(commands map[string]Command) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(errOut, "commands:")
+	for _, name := range names {
+		fmt.Fprintf(errOut, "  validator %s\n", commands[name].Usage)
+	}
+}
+
+// parseFlags parses flags that appear before or after positional arguments
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	fs.SetOutput(io.Discard)
+	positional := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// requireDB connects to the database unless a connection already exists
+func requireDB() {
+	if app.DB != nil {
+		return
+	}
+	app.InitDB()
+	dbOwned = true
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/dan13ram/wpokt-validator/app"
+)
+
+func configCommands() []Command {
+	return []Command{
+		{
+			Name:  "config check",
+			Usage: "config check",
+			Run:   checkConfig,
+		},
+	}
+}
+
+func checkConfig(args []string) error {
+	if len(args) != 0 {
+		return ErrUsage
+	}
+
+	errs := app.ValidateConfig(app.Config)
+	for _, err := range errs {
+		fmt.Fprintln(out, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("config is invalid, found %d problems", len(errs))
+	}
+
+	fmt.Fprintln(out, "config is valid")
+	return nil
+}
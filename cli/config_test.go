@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigCheck(t *testing.T) {
+	t.Cleanup(func() { app.Config = models.Config{} })
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, buffer := setupTest(t)
+		app.Config = models.Config{}
+		app.Config.HealthCheck.IntervalMillis = 1000
+		app.Config.Ethereum.WrappedPocketAddress = "0x1234"
+
+		code := Run([]string{"config", "check"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, buffer.String(), "MongoDB.URI is required\n")
+		assert.Contains(t, buffer.String(), "Ethereum.WrappedPocketAddress is not a valid hex address\n")
+		assert.NotContains(t, buffer.String(), "HealthCheck")
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		_, buffer := setupTest(t)
+		app.Config = models.Config{}
+		app.LoadConfig("../config.sample.yml", "")
+
+		code := Run([]string{"config", "check"})
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "config is valid\n", buffer.String())
+	})
+
+	t.Run("Usage", func(t *testing.T) {
+		setupTest(t)
+
+		assert.Equal(t, 2, Run([]string{"config", "check", "extra"}))
+	})
+}
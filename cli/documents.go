@@ -0,0 +1,353 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// documentType is a kind of bridge document an operator can inspect and repair
+type documentType struct {
+	name       string
+	collection string
+}
+
+var documentTypes = []documentType{
+	{name: "mints", collection: models.CollectionMints},
+	{name: "burns", collection: models.CollectionBurns},
+	{name: "invalid-mints", collection: models.CollectionInvalidMints},
+}
+
+// document holds the fields shared by mints, burns and invalid mints
+type document struct {
+	Id               *primitive.ObjectID `bson:"_id"`
+	TransactionHash  string              `bson:"transaction_hash"`
+	RecipientAddress string              `bson:"recipient_address"`
+	Status           string              `bson:"status"`
+	PreviousStatus   string              `bson:"previous_status"`
+	Attempts         map[string]int64    `bson:"attempts"`
+	LastError        string              `bson:"last_error"`
+	UpdatedAt        time.Time           `bson:"updated_at"`
+}
+
+// maxAttempts is the highest count of failed attempts among the validators
+func maxAttempts(attempts map[string]int64) int64 {
+	max := int64(0)
+	for _, count := range attempts {
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
+
+// resourceId is the lock resource the services hold while handling the document
+func (t documentType) resourceId(doc document) string {
+	if t.collection == models.CollectionMints {
+		return fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(doc.RecipientAddress))
+	}
+	return fmt.Sprintf("%s/%s", t.collection, doc.Id.Hex())
+}
+
+func documentCommands() []Command {
+	commands := []Command{}
+	for _, t := range documentTypes {
+		t := t
+		commands = append(commands,
+			Command{
+				Name:  t.name + " list",
+				Usage: t.name + " list [--status <status>]",
+				Run:   t.list,
+			},
+			Command{
+				Name:  t.name + " show",
+				Usage: t.name + " show <transaction hash>",
+				Run:   t.show,
+			},
+			Command{
+				Name:  t.name + " retry",
+				Usage: t.name + " retry <id> [--operator <name>]",
+				Run:   t.retry,
+			},
+			Command{
+				Name:  t.name + " mark-failed",
+				Usage: t.name + " mark-failed <id> --reason <reason> [--force] [--operator <name>]",
+				Run:   t.markFailed,
+			},
+		)
+	}
+	commands = append(commands, Command{
+		Name:  "requeue",
+		Usage: "requeue [--type mints|burns|invalid-mints] [--operator <name>]",
+		Run:   requeue,
+	})
+	return commands
+}
+
+func operatorFlag(fs *flag.FlagSet) *string {
+	return fs.String("operator", os.Getenv("USER"), "name of the operator making the change")
+}
+
+func (t documentType) list(args []string) error {
+	fs := flag.NewFlagSet(t.name+" list", flag.ContinueOnError)
+	status := fs.String("status", "", "only list documents with this status")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return ErrUsage
+	}
+
+	filter := bson.M{}
+	if *status != "" {
+		filter["status"] = *status
+	}
+
+	requireDB()
+	docs := []document{}
+	if err := app.DB.FindMany(t.collection, filter, &docs); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTRANSACTION HASH\tSTATUS\tATTEMPTS\tLAST ERROR\tUPDATED AT")
+	for _, doc := range docs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", doc.Id.Hex(), doc.TransactionHash, doc.Status, maxAttempts(doc.Attempts), doc.LastError, doc.UpdatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func (t documentType) show(args []string) error {
+	fs := flag.NewFlagSet(t.name+" show", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+	hash := positional[0]
+
+	var docs interface{}
+	switch t.collection {
+	case models.CollectionMints:
+		docs = &[]models.Mint{}
+	case models.CollectionBurns:
+		docs = &[]models.Burn{}
+	default:
+		docs = &[]models.InvalidMint{}
+	}
+
+	requireDB()
+	if err := app.DB.FindMany(t.collection, bson.M{"transaction_hash": hash}, docs); err != nil {
+		return err
+	}
+
+	result, err := json.MarshalIndent(docs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if string(result) == "[]" {
+		return fmt.Errorf("no %s found with transaction hash %s", t.name, hash)
+	}
+	fmt.Fprintln(out, string(result))
+	return nil
+}
+
+func (t documentType) retry(args []string) error {
+	fs := flag.NewFlagSet(t.name+" retry", flag.ContinueOnError)
+	operator := operatorFlag(fs)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+	if *operator == "" {
+		return errors.New("operator is required")
+	}
+
+	requireDB()
+	doc, err := t.find(positional[0])
+	if err != nil {
+		return err
+	}
+	return t.requeue(doc, models.AuditActionRetry, *operator)
+}
+
+func (t documentType) markFailed(args []string) error {
+	fs := flag.NewFlagSet(t.name+" mark-failed", flag.ContinueOnError)
+	operator := operatorFlag(fs)
+	reason := fs.String("reason", "", "why the document is marked as failed")
+	force := fs.Bool("force", false, "mark a signed document as failed")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+	if *reason == "" {
+		return errors.New("reason is required")
+	}
+	if *operator == "" {
+		return errors.New("operator is required")
+	}
+
+	requireDB()
+	doc, err := t.find(positional[0])
+	if err != nil {
+		return err
+	}
+	if doc.Status == models.StatusSuccess || doc.Status == models.StatusFailed {
+		return fmt.Errorf("cannot mark %s in status %s as failed", doc.Id.Hex(), doc.Status)
+	}
+	// a signed mint can still be claimed and a signed return can still be broadcast
+	signed := doc.Status == models.StatusSigned ||
+		(doc.Status == models.StatusNeedsAttention && doc.PreviousStatus == models.StatusSigned)
+	if signed && !*force {
+		return fmt.Errorf("%s is signed and may still be executed, check it was not and use --force to mark it as failed", doc.Id.Hex())
+	}
+
+	update := bson.M{
+		"status":     models.StatusFailed,
+		"last_error": *reason,
+	}
+	if err := t.transition(doc, update); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s %s marked as failed\n", t.name, doc.Id.Hex())
+	action := models.AuditActionMarkFailed
+	if signed {
+		action = models.AuditActionForceMarkFailed
+	}
+	return app.RecordAudit(models.AuditLog{
+		Action:     action,
+		Operator:   *operator,
+		Collection: t.collection,
+		DocumentId: doc.Id.Hex(),
+		StatusFrom: doc.Status,
+		StatusTo:   models.StatusFailed,
+		Reason:     *reason,
+	})
+}
+
+func requeue(args []string) error {
+	fs := flag.NewFlagSet("requeue", flag.ContinueOnError)
+	operator := operatorFlag(fs)
+	name := fs.String("type", "", "only requeue this type of document")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return ErrUsage
+	}
+	if *operator == "" {
+		return errors.New("operator is required")
+	}
+
+	types := []documentType{}
+	for _, t := range documentTypes {
+		if *name == "" || *name == t.name {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("unknown document type %s", *name)
+	}
+
+	requireDB()
+	failed := 0
+	for _, t := range types {
+		docs := []document{}
+		if err := app.DB.FindMany(t.collection, bson.M{"status": models.StatusNeedsAttention}, &docs); err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			if err := t.requeue(doc, models.AuditActionRequeue, *operator); err != nil {
+				fmt.Fprintf(errOut, "error requeueing %s %s: %s\n", t.name, doc.Id.Hex(), err)
+				failed++
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to requeue %d documents", failed)
+	}
+	return nil
+}
+
+func (t documentType) find(id string) (document, error) {
+	var doc document
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return doc, fmt.Errorf("invalid id %s: %w", id, err)
+	}
+	err = app.DB.FindOne(t.collection, bson.M{"_id": objectId}, &doc)
+	return doc, err
+}
+
+// requeue clears the retry budget of a document and returns it to the status it failed in
+func (t documentType) requeue(doc document, action string, operator string) error {
+	if doc.Status == models.StatusSuccess || doc.Status == models.StatusFailed {
+		return fmt.Errorf("cannot retry %s in status %s", doc.Id.Hex(), doc.Status)
+	}
+
+	status := doc.Status
+	if status == models.StatusNeedsAttention {
+		status = doc.PreviousStatus
+		if status == "" {
+			status = models.StatusPending
+		}
+	}
+	if status == models.StatusQuarantined {
+		// the signers screen the document again, so only documents the lists now allow are released
+		status = models.StatusPending
+	}
+
+	update := bson.M{
+		"status":          status,
+		"previous_status": "",
+		"attempts":        bson.M{},
+		"last_error":      "",
+		"next_attempt_at": bson.M{},
+	}
+	if doc.Status == models.StatusQuarantined {
+		update["quarantine_reason"] = ""
+	}
+	if err := t.transition(doc, update); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s %s requeued as %s\n", t.name, doc.Id.Hex(), status)
+	return app.RecordAudit(models.AuditLog{
+		Action:     action,
+		Operator:   operator,
+		Collection: t.collection,
+		DocumentId: doc.Id.Hex(),
+		StatusFrom: doc.Status,
+		StatusTo:   status,
+	})
+}
+
+// transition updates a document under the same lock and fencing token the services use
+func (t documentType) transition(doc document, set bson.M) error {
+	lockId, lockToken, err := app.DB.XLock(t.resourceId(doc))
+	if err != nil {
+		return fmt.Errorf("error locking %s: %w", doc.Id.Hex(), err)
+	}
+	defer app.DB.Unlock(lockId)
+
+	filter := bson.M{
+		"_id":        doc.Id,
+		"status":     doc.Status,
+		"lock_token": app.LockTokenFilter(lockToken),
+	}
+
+	set["lock_token"] = lockToken
+	set["updated_at"] = time.Now()
+
+	err = app.DB.UpdateOne(t.collection, filter, bson.M{"$set": set})
+	if errors.Is(err, app.ErrNotMatched) {
+		return fmt.Errorf("%s %s changed since it was read or was written under a newer lock, nothing updated", t.name, doc.Id.Hex())
+	}
+	return err
+}
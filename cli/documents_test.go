@@ -0,0 +1,370 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	log.SetOutput(io.Discard)
+}
+
+func setupTest(t *testing.T) (*app.MockDatabase, *bytes.Buffer) {
+	mockDB := app.NewMockDatabase(t)
+	app.DB = mockDB
+	t.Cleanup(func() { app.DB = nil })
+
+	buffer := &bytes.Buffer{}
+	out = buffer
+	errOut = io.Discard
+	return mockDB, buffer
+}
+
+func expectFindOne(mockDB *app.MockDatabase, collection string, doc document) {
+	mockDB.EXPECT().FindOne(collection, bson.M{"_id": *doc.Id}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*document) = doc
+		}).Once()
+}
+
+func TestCommandName(t *testing.T) {
+	commands := Commands()
+
+	name, rest := commandName(commands, []string{"burns", "mark-failed", "abcd", "--reason", "stuck"})
+	assert.Equal(t, "burns mark-failed", name)
+	assert.Equal(t, []string{"abcd", "--reason", "stuck"}, rest)
+
+	name, rest = commandName(commands, []string{"requeue"})
+	assert.Equal(t, "requeue", name)
+	assert.Empty(t, rest)
+
+	name, _ = commandName(commands, []string{"unknown", "command"})
+	assert.Equal(t, "unknown command", name)
+}
+
+func TestParseFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	reason := fs.String("reason", "", "")
+
+	positional, err := parseFlags(fs, []string{"abcd", "--reason", "stuck", "efgh"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"abcd", "efgh"}, positional)
+	assert.Equal(t, "stuck", *reason)
+}
+
+func TestRunUnknownCommand(t *testing.T) {
+	setupTest(t)
+
+	assert.Equal(t, 2, Run([]string{"unknown"}))
+	assert.Equal(t, 2, Run([]string{"burns", "show"}))
+}
+
+func TestDocumentsList(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+	id := primitive.NewObjectID()
+
+	mockDB.EXPECT().FindMany(models.CollectionMints, bson.M{"status": models.StatusSigned}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]document) = []document{{Id: &id, TransactionHash: "hash", Status: models.StatusSigned}}
+		}).Once()
+
+	code := Run([]string{"mints", "list", "--status", "signed"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, buffer.String(), id.Hex())
+	assert.Contains(t, buffer.String(), "hash")
+}
+
+func TestDocumentsShow(t *testing.T) {
+
+	t.Run("Found", func(t *testing.T) {
+		mockDB, buffer := setupTest(t)
+
+		mockDB.EXPECT().FindMany(models.CollectionBurns, bson.M{"transaction_hash": "hash"}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.Burn) = []models.Burn{{TransactionHash: "hash", LogIndex: "1"}}
+			}).Once()
+
+		code := Run([]string{"burns", "show", "hash"})
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, buffer.String(), `"log_index": "1"`)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+
+		mockDB.EXPECT().FindMany(models.CollectionBurns, bson.M{"transaction_hash": "hash"}, mock.Anything).Return(nil).Once()
+
+		code := Run([]string{"burns", "show", "hash"})
+
+		assert.Equal(t, 1, code)
+	})
+}
+
+func TestDocumentsRetry(t *testing.T) {
+
+	t.Run("Needs attention", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+		doc := document{Id: &id, Status: models.StatusNeedsAttention, PreviousStatus: models.StatusSigned, Attempts: map[string]int64{"wpokt-validator-01": 10}}
+
+		expectFindOne(mockDB, models.CollectionInvalidMints, doc)
+		mockDB.EXPECT().XLock("invalidMints/"+id.Hex()).Return("lockId", int64(3), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+
+		filter := bson.M{
+			"_id":        &id,
+			"status":     models.StatusNeedsAttention,
+			"lock_token": app.LockTokenFilter(3),
+		}
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSigned, set["status"])
+				assert.Equal(t, bson.M{}, set["attempts"])
+				assert.Equal(t, "", set["last_error"])
+				assert.Equal(t, int64(3), set["lock_token"])
+			}).Once()
+
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).
+			Run(func(_ string, data interface{}) {
+				audit := data.(models.AuditLog)
+				assert.Equal(t, models.AuditActionRetry, audit.Action)
+				assert.Equal(t, "alice", audit.Operator)
+				assert.Equal(t, id.Hex(), audit.DocumentId)
+				assert.Equal(t, models.StatusNeedsAttention, audit.StatusFrom)
+				assert.Equal(t, models.StatusSigned, audit.StatusTo)
+			}).Once()
+
+		code := Run([]string{"invalid-mints", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Document changed meanwhile", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+		doc := document{Id: &id, Status: models.StatusNeedsAttention, PreviousStatus: models.StatusSigned}
+
+		expectFindOne(mockDB, models.CollectionInvalidMints, doc)
+		mockDB.EXPECT().XLock("invalidMints/"+id.Hex()).Return("lockId", int64(3), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(app.ErrNotMatched).Once()
+
+		code := Run([]string{"invalid-mints", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		mockDB.AssertNotCalled(t, "InsertOne", models.CollectionAuditLogs, mock.Anything)
+	})
+
+	t.Run("Mints lock by recipient", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+		doc := document{Id: &id, Status: models.StatusNeedsAttention, RecipientAddress: "0xABCD"}
+
+		expectFindOne(mockDB, models.CollectionMints, doc)
+		mockDB.EXPECT().XLock("mints/0xabcd").Return("lockId", int64(0), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.StatusPending, update.(bson.M)["$set"].(bson.M)["status"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+
+		code := Run([]string{"mints", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Quarantined is screened again", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+		doc := document{Id: &id, Status: models.StatusQuarantined}
+
+		expectFindOne(mockDB, models.CollectionBurns, doc)
+		mockDB.EXPECT().XLock("burns/"+id.Hex()).Return("lockId", int64(0), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusPending, set["status"])
+				assert.Equal(t, "", set["quarantine_reason"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.StatusFrom == models.StatusQuarantined && audit.StatusTo == models.StatusPending
+		})).Return(nil).Once()
+
+		code := Run([]string{"burns", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Terminal status", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusSuccess})
+
+		code := Run([]string{"burns", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		setupTest(t)
+
+		code := Run([]string{"burns", "retry", "invalid", "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Lock failed", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusSigned})
+		mockDB.EXPECT().XLock("burns/"+id.Hex()).Return("", int64(0), errors.New("error")).Once()
+
+		code := Run([]string{"burns", "retry", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+}
+
+func TestDocumentsMarkFailed(t *testing.T) {
+
+	t.Run("Success", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusConfirmed})
+		mockDB.EXPECT().XLock("burns/"+id.Hex()).Return("lockId", int64(0), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusFailed, set["status"])
+				assert.Equal(t, "stuck", set["last_error"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).
+			Run(func(_ string, data interface{}) {
+				audit := data.(models.AuditLog)
+				assert.Equal(t, models.AuditActionMarkFailed, audit.Action)
+				assert.Equal(t, "stuck", audit.Reason)
+			}).Once()
+
+		code := Run([]string{"burns", "mark-failed", id.Hex(), "--reason", "stuck", "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Missing reason", func(t *testing.T) {
+		setupTest(t)
+		id := primitive.NewObjectID()
+
+		code := Run([]string{"burns", "mark-failed", id.Hex(), "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Signed without force", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusSigned})
+		errBuffer := &bytes.Buffer{}
+		errOut = errBuffer
+
+		code := Run([]string{"burns", "mark-failed", id.Hex(), "--reason", "stuck", "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, errBuffer.String(), "use --force")
+		mockDB.AssertNotCalled(t, "XLock", mock.Anything)
+	})
+
+	t.Run("Signed needing attention without force", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionMints, document{Id: &id, Status: models.StatusNeedsAttention, PreviousStatus: models.StatusSigned})
+
+		code := Run([]string{"mints", "mark-failed", id.Hex(), "--reason", "stuck", "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		mockDB.AssertNotCalled(t, "XLock", mock.Anything)
+	})
+
+	t.Run("Signed with force", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusSigned})
+		mockDB.EXPECT().XLock("burns/"+id.Hex()).Return("lockId", int64(0), nil).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, update interface{}) {
+				assert.Equal(t, models.StatusSigned, filter.(bson.M)["status"])
+				assert.Equal(t, models.StatusFailed, update.(bson.M)["$set"].(bson.M)["status"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).
+			Run(func(_ string, data interface{}) {
+				audit := data.(models.AuditLog)
+				assert.Equal(t, models.AuditActionForceMarkFailed, audit.Action)
+				assert.Equal(t, models.StatusSigned, audit.StatusFrom)
+				assert.Equal(t, "returned manually", audit.Reason)
+			}).Once()
+
+		code := Run([]string{"burns", "mark-failed", id.Hex(), "--reason", "returned manually", "--force", "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Already successful", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		id := primitive.NewObjectID()
+
+		expectFindOne(mockDB, models.CollectionBurns, document{Id: &id, Status: models.StatusSuccess})
+
+		code := Run([]string{"burns", "mark-failed", id.Hex(), "--reason", "stuck", "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+}
+
+func TestRequeue(t *testing.T) {
+	mockDB, _ := setupTest(t)
+	id := primitive.NewObjectID()
+
+	mockDB.EXPECT().FindMany(models.CollectionBurns, bson.M{"status": models.StatusNeedsAttention}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]document) = []document{{Id: &id, Status: models.StatusNeedsAttention, PreviousStatus: models.StatusSubmitted}}
+		}).Once()
+	mockDB.EXPECT().XLock("burns/"+id.Hex()).Return("lockId", int64(0), nil).Once()
+	mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+	mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, update interface{}) {
+			assert.Equal(t, models.StatusSubmitted, update.(bson.M)["$set"].(bson.M)["status"])
+		}).Once()
+	mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).
+		Run(func(_ string, data interface{}) {
+			assert.Equal(t, models.AuditActionRequeue, data.(models.AuditLog).Action)
+		}).Once()
+
+	code := Run([]string{"requeue", "--type", "burns", "--operator", "alice"})
+
+	assert.Equal(t, 0, code)
+}
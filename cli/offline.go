@@ -0,0 +1,782 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	ethUtil "github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
+	poktUtil "github.com/dan13ram/wpokt-validator/pokt/util"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	poktCrypto "github.com/pokt-network/pocket-core/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	OfflinePayloadVersion = 1
+)
+
+// OfflinePayload is the file carried between the online validator and the air-gapped signer
+type OfflinePayload struct {
+	Version     int               `json:"version"`
+	PoktChainId string            `json:"pokt_chain_id"`
+	EthChainId  string            `json:"eth_chain_id"`
+	CreatedAt   time.Time         `json:"created_at"`
+	ReturnTxs   []OfflineReturnTx `json:"return_txs"`
+	Mints       []OfflineMint     `json:"mints"`
+}
+
+// OfflineReturnTx is a burn or invalid mint return transaction to be signed offline
+type OfflineReturnTx struct {
+	Collection      string   `json:"collection"`
+	Id              string   `json:"id"`
+	TransactionHash string   `json:"transaction_hash"`
+	ReturnTx        string   `json:"return_tx"`
+	SignBytes       string   `json:"sign_bytes"`
+	Signers         []string `json:"signers"`
+	Signer          string   `json:"signer,omitempty"`
+	Signature       string   `json:"signature,omitempty"`
+}
+
+// OfflineMint is the EIP-712 mint data to be signed offline
+type OfflineMint struct {
+	Id              string             `json:"id"`
+	TransactionHash string             `json:"transaction_hash"`
+	TypedData       apitypes.TypedData `json:"typed_data"`
+	Digest          string             `json:"digest"`
+	Signers         []string           `json:"signers"`
+	Signer          string             `json:"signer,omitempty"`
+	Signature       string             `json:"signature,omitempty"`
+}
+
+var (
+	// fetchMintDomain reads the EIP-712 domain from the mint controller
+	fetchMintDomain = func() (eth.DomainData, error) {
+		client, err := eth.NewClient(nil)
+		if err != nil {
+			return eth.DomainData{}, err
+		}
+		contract, err := autogen.NewMintController(common.HexToAddress(app.Config.Ethereum.MintControllerAddress), client.GetClient())
+		if err != nil {
+			return eth.DomainData{}, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+		defer cancel()
+		return eth.NewMintControllerContract(contract, nil).Eip712Domain(&bind.CallOpts{Context: ctx, Pending: false})
+	}
+
+	// fetchTxFee reads the pokt tx fee from the chain params
+	fetchTxFee = func() (int64, error) {
+		return pokt.GetTxFee(pokt.NewClient(nil))
+	}
+)
+
+func offlineCommands() []Command {
+	return []Command{
+		{
+			Name:  "offline export",
+			Usage: "offline export --out <file>",
+			Run:   offlineExport,
+		},
+		{
+			Name:  "offline sign",
+			Usage: "offline sign --in <file> --out <file>",
+			Run:   offlineSign,
+		},
+		{
+			Name:  "offline import",
+			Usage: "offline import --in <file> [--operator <name>]",
+			Run:   offlineImport,
+		},
+	}
+}
+
+func readPayload(path string) (OfflinePayload, error) {
+	var payload OfflinePayload
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return payload, err
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return payload, err
+	}
+	if payload.Version != OfflinePayloadVersion {
+		return payload, fmt.Errorf("unsupported payload version %d", payload.Version)
+	}
+	if payload.PoktChainId != app.Config.Pocket.ChainId || payload.EthChainId != app.Config.Ethereum.ChainId {
+		return payload, errors.New("payload chain ids do not match config")
+	}
+	return payload, nil
+}
+
+func writePayload(path string, payload OfflinePayload) error {
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func multisigPublicKey() (poktCrypto.PublicKeyMultiSignature, error) {
+	keys, err := multisigKeys()
+	return poktCrypto.PublicKeyMultiSignature{PublicKeys: keys}, err
+}
+
+func offlineExport(args []string) error {
+	fs := flag.NewFlagSet("offline export", flag.ContinueOnError)
+	outPath := fs.String("out", "", "file to write the signing payloads to")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 || *outPath == "" {
+		return ErrUsage
+	}
+
+	multisigPk, err := multisigPublicKey()
+	if err != nil {
+		return err
+	}
+
+	payload := OfflinePayload{
+		Version:     OfflinePayloadVersion,
+		PoktChainId: app.Config.Pocket.ChainId,
+		EthChainId:  app.Config.Ethereum.ChainId,
+		CreatedAt:   time.Now(),
+		ReturnTxs:   []OfflineReturnTx{},
+		Mints:       []OfflineMint{},
+	}
+
+	requireDB()
+
+	vaultAddress := strings.ToLower(app.Config.Pocket.VaultAddress)
+	wpoktAddress := strings.ToLower(app.Config.Ethereum.WrappedPocketAddress)
+
+	var burns []models.Burn
+	filter := bson.M{"wpokt_address": wpoktAddress, "status": models.StatusConfirmed}
+	if err := app.DB.FindMany(models.CollectionBurns, filter, &burns); err != nil {
+		return err
+	}
+	var invalidMints []models.InvalidMint
+	filter = bson.M{"vault_address": vaultAddress, "status": models.StatusConfirmed}
+	if err := app.DB.FindMany(models.CollectionInvalidMints, filter, &invalidMints); err != nil {
+		return err
+	}
+
+	// return transactions that nobody signed yet are built with the tx fee of the chain
+	var txFee int64
+	if len(burns) > 0 || len(invalidMints) > 0 {
+		txFee, err = fetchTxFee()
+		if err != nil {
+			return fmt.Errorf("error fetching pokt tx fee: %w", err)
+		}
+	}
+
+	for _, doc := range burns {
+		entry, err := newOfflineReturnTx(models.CollectionBurns, doc.Id, doc.TransactionHash, doc.ReturnTx, doc.Signers, doc.RecipientAddress, doc.Amount, txFee, multisigPk)
+		if err != nil {
+			return fmt.Errorf("error exporting burn %s: %w", doc.Id.Hex(), err)
+		}
+		payload.ReturnTxs = append(payload.ReturnTxs, entry)
+	}
+
+	for _, doc := range invalidMints {
+		entry, err := newOfflineReturnTx(models.CollectionInvalidMints, doc.Id, doc.TransactionHash, doc.ReturnTx, doc.Signers, doc.SenderAddress, doc.Amount, txFee, multisigPk)
+		if err != nil {
+			return fmt.Errorf("error exporting invalid mint %s: %w", doc.Id.Hex(), err)
+		}
+		payload.ReturnTxs = append(payload.ReturnTxs, entry)
+	}
+
+	var mints []models.Mint
+	// the nonce of a mint is assigned by the first online signer
+	filter = bson.M{
+		"wpokt_address": wpoktAddress,
+		"vault_address": vaultAddress,
+		"status":        models.StatusConfirmed,
+		"data":          bson.M{"$ne": nil},
+	}
+	if err := app.DB.FindMany(models.CollectionMints, filter, &mints); err != nil {
+		return err
+	}
+	if len(mints) > 0 {
+		domain, err := fetchMintDomain()
+		if err != nil {
+			return fmt.Errorf("error fetching mint controller domain: %w", err)
+		}
+		for _, doc := range mints {
+			entry, err := newOfflineMint(doc, domain)
+			if err != nil {
+				return fmt.Errorf("error exporting mint %s: %w", doc.Id.Hex(), err)
+			}
+			payload.Mints = append(payload.Mints, entry)
+		}
+	}
+
+	if err := writePayload(*outPath, payload); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "exported %d return transactions and %d mints to %s\n", len(payload.ReturnTxs), len(payload.Mints), *outPath)
+	return nil
+}
+
+func newOfflineReturnTx(
+	collection string,
+	id *primitive.ObjectID,
+	transactionHash string,
+	returnTx string,
+	signers []string,
+	toAddr string,
+	amount string,
+	txFee int64,
+	multisigPk poktCrypto.PublicKeyMultiSignature,
+) (OfflineReturnTx, error) {
+	if returnTx == "" || len(signers) == 0 {
+		var err error
+		returnTx, err = poktUtil.BuildReturnTx(toAddr, amount, transactionHash, txFee, multisigPk)
+		if err != nil {
+			return OfflineReturnTx{}, err
+		}
+		signers = []string{}
+	}
+
+	signBytes, err := poktUtil.ReturnTxSignBytes(returnTx, app.Config.Pocket.ChainId)
+	if err != nil {
+		return OfflineReturnTx{}, err
+	}
+
+	return OfflineReturnTx{
+		Collection:      collection,
+		Id:              id.Hex(),
+		TransactionHash: transactionHash,
+		ReturnTx:        returnTx,
+		SignBytes:       hex.EncodeToString(signBytes),
+		Signers:         signers,
+	}, nil
+}
+
+func mintData(doc models.Mint) (*autogen.MintControllerMintData, error) {
+	amount, ok := new(big.Int).SetString(doc.Data.Amount, 10)
+	if !ok {
+		return nil, errors.New("invalid mint amount")
+	}
+	nonce, ok := new(big.Int).SetString(doc.Data.Nonce, 10)
+	if !ok {
+		return nil, errors.New("invalid mint nonce")
+	}
+	return &autogen.MintControllerMintData{
+		Recipient: common.HexToAddress(doc.Data.Recipient),
+		Amount:    amount,
+		Nonce:     nonce,
+	}, nil
+}
+
+func newOfflineMint(doc models.Mint, domain eth.DomainData) (OfflineMint, error) {
+	data, err := mintData(doc)
+	if err != nil {
+		return OfflineMint{}, err
+	}
+
+	typedData := ethUtil.NewMintTypedData(domain, data)
+	digest, err := ethUtil.HashTypedData(typedData)
+	if err != nil {
+		return OfflineMint{}, err
+	}
+
+	signers := doc.Signers
+	if signers == nil {
+		signers = []string{}
+	}
+
+	return OfflineMint{
+		Id:              doc.Id.Hex(),
+		TransactionHash: doc.TransactionHash,
+		TypedData:       typedData,
+		Digest:          hex.EncodeToString(digest),
+		Signers:         signers,
+	}, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func offlineSign(args []string) error {
+	fs := flag.NewFlagSet("offline sign", flag.ContinueOnError)
+	inPath := fs.String("in", "", "exported payload file")
+	outPath := fs.String("out", "", "file to write the signed payload to")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 || *inPath == "" || *outPath == "" {
+		return ErrUsage
+	}
+
+	payload, err := readPayload(*inPath)
+	if err != nil {
+		return err
+	}
+
+	signed := 0
+
+	if len(payload.ReturnTxs) > 0 {
+		poktKey, err := poktCrypto.NewPrivateKey(app.Config.Pocket.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("error loading pokt private key: %w", err)
+		}
+		keys, err := multisigKeys()
+		if err != nil {
+			return err
+		}
+		publicKey := strings.ToLower(poktKey.PublicKey().RawString())
+
+		for i := range payload.ReturnTxs {
+			entry := &payload.ReturnTxs[i]
+			if containsFold(entry.Signers, publicKey) {
+				continue
+			}
+
+			// never sign bytes that do not come from the transaction itself
+			decoded, err := poktUtil.DecodeReturnTx(entry.ReturnTx, payload.PoktChainId, keys)
+			if err != nil {
+				return fmt.Errorf("error decoding %s %s: %w", entry.Collection, entry.Id, err)
+			}
+			if !decoded.MultisigMatch {
+				return fmt.Errorf("%s %s is not from the configured multisig", entry.Collection, entry.Id)
+			}
+			signBytes, err := poktUtil.ReturnTxSignBytes(entry.ReturnTx, payload.PoktChainId)
+			if err != nil {
+				return err
+			}
+			if hex.EncodeToString(signBytes) != entry.SignBytes {
+				return fmt.Errorf("sign bytes of %s %s do not match its transaction", entry.Collection, entry.Id)
+			}
+
+			signature, err := poktKey.Sign(signBytes)
+			if err != nil {
+				return err
+			}
+			entry.Signer = publicKey
+			entry.Signature = hex.EncodeToString(signature)
+			signed++
+
+			fmt.Fprintf(out, "signed %s %s: %s to %s, memo %s\n", entry.Collection, entry.Id, decoded.Amount, decoded.ToAddress, decoded.Memo)
+		}
+	}
+
+	if len(payload.Mints) > 0 {
+		ethKey, err := ethCrypto.HexToECDSA(app.Config.Ethereum.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("error loading eth private key: %w", err)
+		}
+		address := strings.ToLower(ethCrypto.PubkeyToAddress(ethKey.PublicKey).Hex())
+
+		for i := range payload.Mints {
+			entry := &payload.Mints[i]
+			if containsFold(entry.Signers, address) {
+				continue
+			}
+
+			digest, err := ethUtil.HashTypedData(entry.TypedData)
+			if err != nil {
+				return fmt.Errorf("error hashing mint %s: %w", entry.Id, err)
+			}
+			if hex.EncodeToString(digest) != entry.Digest {
+				return fmt.Errorf("digest of mint %s does not match its typed data", entry.Id)
+			}
+
+			signature, err := ethUtil.SignTypedDataHash(digest, ethKey)
+			if err != nil {
+				return err
+			}
+			entry.Signer = address
+			entry.Signature = "0x" + hex.EncodeToString(signature)
+			signed++
+
+			fmt.Fprintf(out, "signed mint %s: %v to %v, nonce %v\n", entry.Id, entry.TypedData.Message["amount"], entry.TypedData.Message["recipient"], entry.TypedData.Message["nonce"])
+		}
+	}
+
+	if err := writePayload(*outPath, payload); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "signed %d payloads to %s\n", signed, *outPath)
+	return nil
+}
+
+func offlineImport(args []string) error {
+	fs := flag.NewFlagSet("offline import", flag.ContinueOnError)
+	inPath := fs.String("in", "", "signed payload file")
+	operator := operatorFlag(fs)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 || *inPath == "" {
+		return ErrUsage
+	}
+	if *operator == "" {
+		return errors.New("operator is required")
+	}
+
+	payload, err := readPayload(*inPath)
+	if err != nil {
+		return err
+	}
+
+	multisigPk, err := multisigPublicKey()
+	if err != nil {
+		return err
+	}
+
+	requireDB()
+
+	// imports produce signatures, so they stop with the online signers
+	if app.SigningHalted(app.DB, "OFFLINE IMPORT") {
+		return errors.New("signing is halted by the signing breaker")
+	}
+
+	failed := 0
+	imported := 0
+	for _, entry := range payload.ReturnTxs {
+		if entry.Signature == "" {
+			continue
+		}
+		if err := importReturnTx(entry, multisigPk, *operator); err != nil {
+			fmt.Fprintf(errOut, "error importing %s %s: %s\n", entry.Collection, entry.Id, err)
+			failed++
+			continue
+		}
+		imported++
+	}
+	var domain eth.DomainData
+	for _, entry := range payload.Mints {
+		if entry.Signature == "" {
+			continue
+		}
+		if domain.ChainId == nil {
+			// signatures are only valid under the domain the mint controller uses now
+			if domain, err = fetchMintDomain(); err != nil {
+				return fmt.Errorf("error fetching mint controller domain: %w", err)
+			}
+		}
+		if err := importMint(entry, domain, *operator); err != nil {
+			fmt.Fprintf(errOut, "error importing mint %s: %s\n", entry.Id, err)
+			failed++
+			continue
+		}
+		imported++
+	}
+
+	fmt.Fprintf(out, "imported %d signatures\n", imported)
+	if failed > 0 {
+		return fmt.Errorf("failed to import %d signatures", failed)
+	}
+	return nil
+}
+
+// lockAndFind locks a document the same way the services do and reads it under the lock
+func lockAndFind(collection string, resourceId func(primitive.ObjectID) (string, error), id string, result interface{}) (string, int64, error) {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid id %s: %w", id, err)
+	}
+	resource, err := resourceId(objectId)
+	if err != nil {
+		return "", 0, err
+	}
+	lockId, lockToken, err := app.DB.XLock(resource)
+	if err != nil {
+		return "", 0, fmt.Errorf("error locking %s: %w", id, err)
+	}
+	if err := app.DB.FindOne(collection, bson.M{"_id": objectId}, result); err != nil {
+		app.DB.Unlock(lockId)
+		return "", 0, err
+	}
+	return lockId, lockToken, nil
+}
+
+// importGate runs the checks the online signers make before signing a confirmed transfer
+func importGate(collection string, id *primitive.ObjectID, txHash string, sender string, recipient string, amount string, approvals []models.Approval, filter bson.M, limits models.VolumeLimitConfig) error {
+	if err := app.ScreenAddresses(
+		app.ScreenedAddress{Role: "sender", Address: sender},
+		app.ScreenedAddress{Role: "recipient", Address: recipient},
+	); err != nil {
+		return err
+	}
+
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return fmt.Errorf("invalid amount %s", amount)
+	}
+	if app.RequiresApproval(collection, value) && !app.Approved(app.ApprovalMessage(collection, id.Hex(), txHash, recipient, amount), approvals) {
+		return errors.New("transfer is awaiting approval")
+	}
+
+	holdReason, err := app.HoldReason(app.DB, collection, filter,
+		app.VolumeTransfer{Id: id, Sender: sender, Recipient: recipient, Amount: value},
+		limits,
+	)
+	if err != nil {
+		return fmt.Errorf("error checking volume limits: %w", err)
+	}
+	if holdReason != "" {
+		return errors.New("transfer is held: " + holdReason)
+	}
+	return nil
+}
+
+func importReturnTx(entry OfflineReturnTx, multisigPk poktCrypto.PublicKeyMultiSignature, operator string) error {
+	if entry.Collection != models.CollectionBurns && entry.Collection != models.CollectionInvalidMints {
+		return fmt.Errorf("unknown collection %s", entry.Collection)
+	}
+
+	signerKey, err := poktCrypto.NewPublicKey(entry.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if poktUtil.MultisigKeyIndex(signerKey, multisigPk) < 0 {
+		return fmt.Errorf("%s is not a member of the vault multisig", entry.Signer)
+	}
+	signer := strings.ToLower(signerKey.RawString())
+	signature, err := hex.DecodeString(entry.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	var doc struct {
+		Id               *primitive.ObjectID `bson:"_id"`
+		TransactionHash  string              `bson:"transaction_hash"`
+		SenderAddress    string              `bson:"sender_address"`
+		RecipientAddress string              `bson:"recipient_address"`
+		Amount           string              `bson:"amount"`
+		Status           string              `bson:"status"`
+		ReturnTx         string              `bson:"return_tx"`
+		Signers          []string            `bson:"signers"`
+		CountedAt        time.Time           `bson:"counted_at"`
+		Approvals        []models.Approval   `bson:"approvals"`
+	}
+	resourceId := func(id primitive.ObjectID) (string, error) {
+		return fmt.Sprintf("%s/%s", entry.Collection, id.Hex()), nil
+	}
+	lockId, lockToken, err := lockAndFind(entry.Collection, resourceId, entry.Id, &doc)
+	if err != nil {
+		return err
+	}
+	defer app.DB.Unlock(lockId)
+
+	if doc.Status != models.StatusConfirmed {
+		return fmt.Errorf("document is %s, not %s", doc.Status, models.StatusConfirmed)
+	}
+	if containsFold(doc.Signers, signer) {
+		return errors.New("already signed by " + entry.Signer)
+	}
+	// invalid mints are returned to their sender, like the online signer only the breaker stops them
+	if entry.Collection == models.CollectionBurns {
+		if err := importGate(models.CollectionBurns, doc.Id, doc.TransactionHash, doc.SenderAddress, doc.RecipientAddress, doc.Amount, doc.Approvals,
+			bson.M{"wpokt_address": strings.ToLower(app.Config.Ethereum.WrappedPocketAddress)},
+			app.LiveConfig().VolumeLimits.Burns,
+		); err != nil {
+			return err
+		}
+	}
+
+	// other validators may have signed since the export, as long as they signed the same bytes
+	base := doc.ReturnTx
+	signers := doc.Signers
+	if base == "" || len(signers) == 0 {
+		base = entry.ReturnTx
+		signers = []string{}
+	}
+	signBytes, err := poktUtil.ReturnTxSignBytes(base, app.Config.Pocket.ChainId)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(signBytes) != entry.SignBytes {
+		return errors.New("document changed since export, export it again")
+	}
+
+	returnTx, err := poktUtil.AddReturnTxSignature(base, app.Config.Pocket.ChainId, signature, signerKey, multisigPk)
+	if err != nil {
+		return err
+	}
+	// the first signature pins the fee of the return tx to the document
+	txFee, err := poktUtil.ReturnTxFee(base, app.Config.Pocket.ChainId)
+	if err != nil {
+		return err
+	}
+
+	signers = append(signers, signer)
+	status := models.StatusConfirmed
+	if len(signers) == len(multisigPk.Keys()) {
+		status = models.StatusSigned
+	}
+
+	filter := bson.M{
+		"_id":        doc.Id,
+		"status":     models.StatusConfirmed,
+		"lock_token": app.LockTokenFilter(lockToken),
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"return_tx":  returnTx,
+			"signers":    signers,
+			"tx_fee":     strconv.FormatInt(txFee, 10),
+			"status":     status,
+			"lock_token": lockToken,
+			"updated_at": time.Now(),
+		},
+	}
+	if entry.Collection == models.CollectionBurns && doc.CountedAt.IsZero() {
+		update["$set"].(bson.M)["counted_at"] = time.Now()
+	}
+	if err := app.DB.UpdateOne(entry.Collection, filter, update); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "imported signature for %s %s\n", entry.Collection, entry.Id)
+	return app.RecordAudit(models.AuditLog{
+		Action:     models.AuditActionOfflineImport,
+		Operator:   operator,
+		Collection: entry.Collection,
+		DocumentId: entry.Id,
+		StatusFrom: doc.Status,
+		StatusTo:   status,
+		Reason:     "offline signature from " + entry.Signer,
+	})
+}
+
+func importMint(entry OfflineMint, onChainDomain eth.DomainData, operator string) error {
+	if !containsFold(app.Config.Ethereum.ValidatorAddresses, entry.Signer) {
+		return fmt.Errorf("%s is not a validator", entry.Signer)
+	}
+
+	domain := entry.TypedData.Domain
+	chainId, ok := new(big.Int).SetString(app.Config.Ethereum.ChainId, 10)
+	if !ok || domain.ChainId == nil || (*big.Int)(domain.ChainId).Cmp(chainId) != 0 {
+		return errors.New("typed data chain id does not match config")
+	}
+	if !strings.EqualFold(domain.VerifyingContract, app.Config.Ethereum.MintControllerAddress) {
+		return errors.New("typed data verifying contract does not match mint controller")
+	}
+	if domain.Name != onChainDomain.Name || domain.Version != onChainDomain.Version ||
+		onChainDomain.ChainId == nil || onChainDomain.ChainId.Cmp(chainId) != 0 ||
+		!strings.EqualFold(domain.VerifyingContract, onChainDomain.VerifyingContract.Hex()) {
+		return errors.New("typed data domain does not match the mint controller domain, export it again")
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(entry.Signature, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	var doc models.Mint
+	resourceId := func(id primitive.ObjectID) (string, error) {
+		var mint models.Mint
+		if err := app.DB.FindOne(models.CollectionMints, bson.M{"_id": id}, &mint); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(mint.RecipientAddress)), nil
+	}
+	lockId, lockToken, err := lockAndFind(models.CollectionMints, resourceId, entry.Id, &doc)
+	if err != nil {
+		return err
+	}
+	defer app.DB.Unlock(lockId)
+
+	if doc.Status != models.StatusConfirmed || doc.Data == nil {
+		return fmt.Errorf("mint is %s, not %s", doc.Status, models.StatusConfirmed)
+	}
+	domainSeparator, err := ethUtil.DomainSeparator(onChainDomain)
+	if err != nil {
+		return err
+	}
+	if doc.DomainSeparator != "" && doc.DomainSeparator != domainSeparator {
+		// signatures made under another domain cannot be submitted with this one
+		doc.Signatures = nil
+		doc.Signers = nil
+	}
+	if containsFold(doc.Signers, entry.Signer) {
+		return errors.New("already signed by " + entry.Signer)
+	}
+	if err := importGate(models.CollectionMints, doc.Id, doc.TransactionHash, doc.SenderAddress, doc.RecipientAddress, doc.Amount, doc.Approvals,
+		bson.M{"wpokt_address": strings.ToLower(app.Config.Ethereum.WrappedPocketAddress), "vault_address": strings.ToLower(app.Config.Pocket.VaultAddress)},
+		app.LiveConfig().VolumeLimits.Mints,
+	); err != nil {
+		return err
+	}
+
+	// the signature must be over the data stored on the mint, not just the data in the file
+	data, err := mintData(doc)
+	if err != nil {
+		return err
+	}
+	expected := ethUtil.NewMintTypedData(onChainDomain, data)
+	digest, err := ethUtil.HashTypedData(expected)
+	if err != nil {
+		return err
+	}
+	entryDigest, err := ethUtil.HashTypedData(entry.TypedData)
+	if err != nil || !bytes.Equal(digest, entryDigest) {
+		return errors.New("mint changed since export, export it again")
+	}
+
+	signer, err := ethUtil.RecoverTypedDataSigner(digest, signature)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(signer.Hex(), entry.Signer) {
+		return errors.New("signature does not match signer " + entry.Signer)
+	}
+
+	statusFrom := doc.Status
+	mint := ethUtil.AddMintSignature(&doc, "0x"+hex.EncodeToString(signature), strings.ToLower(signer.Hex()), len(app.Config.Ethereum.ValidatorAddresses))
+
+	filter := bson.M{
+		"_id":        doc.Id,
+		"status":     models.StatusConfirmed,
+		"lock_token": app.LockTokenFilter(lockToken),
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"signatures":       mint.Signatures,
+			"signers":          mint.Signers,
+			"domain_separator": domainSeparator,
+			"status":           mint.Status,
+			"lock_token":       lockToken,
+			"updated_at":       time.Now(),
+		},
+	}
+	if mint.CountedAt.IsZero() {
+		update["$set"].(bson.M)["counted_at"] = time.Now()
+	}
+	if err := app.DB.UpdateOne(models.CollectionMints, filter, update); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "imported signature for mint %s\n", entry.Id)
+	return app.RecordAudit(models.AuditLog{
+		Action:     models.AuditActionOfflineImport,
+		Operator:   operator,
+		Collection: models.CollectionMints,
+		DocumentId: entry.Id,
+		StatusFrom: statusFrom,
+		StatusTo:   mint.Status,
+		Reason:     "offline signature from " + entry.Signer,
+	})
+}
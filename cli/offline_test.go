@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	ethUtil "github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	poktUtil "github.com/dan13ram/wpokt-validator/pokt/util"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	poktCrypto "github.com/pokt-network/pocket-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	poktKey1, _ := poktCrypto.NewPrivateKey("8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82")
+	poktKey2, _ := poktCrypto.NewPrivateKey("f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055")
+	pubKeys := []poktCrypto.PublicKey{poktKey1.PublicKey(), poktKey2.PublicKey()}
+	multisigPk := poktCrypto.PublicKeyMultiSignature{PublicKeys: pubKeys}
+
+	ethKey1, _ := ethCrypto.GenerateKey()
+	ethKey2, _ := ethCrypto.GenerateKey()
+	ethAddress1 := strings.ToLower(ethCrypto.PubkeyToAddress(ethKey1.PublicKey).Hex())
+	ethAddress2 := strings.ToLower(ethCrypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
+
+	app.Config.Pocket.ChainId = "testnet"
+	app.Config.Pocket.MultisigPublicKeys = []string{pubKeys[0].RawString(), pubKeys[1].RawString()}
+	app.Config.Pocket.VaultAddress = strings.ToLower(multisigPk.Address().String())
+	app.Config.Pocket.PrivateKey = poktKey2.RawString()
+	app.Config.Ethereum.ChainId = "31337"
+	app.Config.Ethereum.MintControllerAddress = "0x0000000000000000000000000000000000000abc"
+	app.Config.Ethereum.WrappedPocketAddress = "0x0000000000000000000000000000000000000def"
+	app.Config.Ethereum.ValidatorAddresses = []string{ethAddress1, ethAddress2}
+	app.Config.Ethereum.PrivateKey = hex.EncodeToString(ethCrypto.FromECDSA(ethKey2))
+
+	domain := eth.DomainData{
+		Name:              "MintController",
+		Version:           "1",
+		ChainId:           big.NewInt(31337),
+		VerifyingContract: common.HexToAddress(app.Config.Ethereum.MintControllerAddress),
+	}
+	fetchMintDomain = func() (eth.DomainData, error) { return domain, nil }
+	fetchTxFee = func() (int64, error) { return 10000, nil }
+
+	burnId := primitive.NewObjectID()
+	burn, err := poktUtil.SignBurn(&models.Burn{
+		Id:               &burnId,
+		Status:           models.StatusConfirmed,
+		RecipientAddress: poktKey1.PublicKey().Address().String(),
+		Amount:           "100000",
+		TransactionHash:  "burn_hash",
+	}, poktKey1, multisigPk, 2, 10000)
+	assert.Nil(t, err)
+
+	mintId := primitive.NewObjectID()
+	recipient := common.HexToAddress("0x1234")
+	data := &autogen.MintControllerMintData{Recipient: recipient, Amount: big.NewInt(50000), Nonce: big.NewInt(3)}
+	mint, err := ethUtil.SignMint(&models.Mint{
+		Id:               &mintId,
+		Status:           models.StatusConfirmed,
+		RecipientAddress: strings.ToLower(recipient.Hex()),
+		Amount:           "50000",
+		TransactionHash:  "mint_hash",
+		Data: &models.MintData{
+			Recipient: strings.ToLower(recipient.Hex()),
+			Amount:    "50000",
+			Nonce:     "3",
+		},
+	}, data, domain, ethKey1, 2)
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	exported := filepath.Join(dir, "export.json")
+	signed := filepath.Join(dir, "signed.json")
+
+	t.Run("Export", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.Burn) = []models.Burn{*burn}
+			}).Once()
+		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.Mint) = []models.Mint{*mint}
+			}).Once()
+
+		code := Run([]string{"offline", "export", "--out", exported})
+
+		assert.Equal(t, 0, code)
+		payload, err := readPayload(exported)
+		assert.Nil(t, err)
+		assert.Len(t, payload.ReturnTxs, 1)
+		assert.Len(t, payload.Mints, 1)
+	})
+
+	t.Run("Sign", func(t *testing.T) {
+		setupTest(t)
+
+		code := Run([]string{"offline", "sign", "--in", exported, "--out", signed})
+
+		assert.Equal(t, 0, code)
+		payload, _ := readPayload(signed)
+		assert.NotEmpty(t, payload.ReturnTxs[0].Signature)
+		assert.NotEmpty(t, payload.Mints[0].Signature)
+		assert.Equal(t, ethAddress2, payload.Mints[0].Signer)
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, false)
+
+		mockDB.EXPECT().XLock("burns/"+burnId.Hex()).Return("burnLock", int64(1), nil).Once()
+		mockDB.EXPECT().FindOne(models.CollectionBurns, bson.M{"_id": burnId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				assert.Nil(t, bson.UnmarshalExtJSON(mustMarshal(t, burn), false, result))
+			}).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, update interface{}) {
+				assert.Equal(t, app.LockTokenFilter(1), filter.(bson.M)["lock_token"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSigned, set["status"])
+				decoded, err := poktUtil.DecodeReturnTx(set["return_tx"].(string), "testnet", pubKeys)
+				assert.Nil(t, err)
+				assert.True(t, decoded.Complete())
+			}).Once()
+		mockDB.EXPECT().Unlock("burnLock").Return(nil).Once()
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, bson.M{"_id": mintId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*models.Mint) = *mint
+			}).Twice()
+		mockDB.EXPECT().XLock("mints/"+strings.ToLower(recipient.Hex())).Return("mintLock", int64(2), nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSigned, set["status"])
+				assert.Len(t, set["signatures"], 2)
+				assert.Contains(t, set["signers"], ethAddress2)
+				domainSeparator, _ := ethUtil.DomainSeparator(domain)
+				assert.Equal(t, domainSeparator, set["domain_separator"])
+				assert.NotNil(t, set["counted_at"])
+			}).Once()
+		mockDB.EXPECT().Unlock("mintLock").Return(nil).Once()
+
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).
+			Run(func(_ string, data interface{}) {
+				assert.Equal(t, models.AuditActionOfflineImport, data.(models.AuditLog).Action)
+			}).Twice()
+
+		code := Run([]string{"offline", "import", "--in", signed, "--operator", "alice"})
+
+		assert.Equal(t, 0, code)
+	})
+
+	t.Run("Import rejects tampered signature", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, false)
+
+		payload, _ := readPayload(signed)
+		payload.ReturnTxs = nil
+		payload.Mints[0].Signature = payload.Mints[0].Signature[:len(payload.Mints[0].Signature)-4] + "0000"
+		tampered := filepath.Join(dir, "tampered.json")
+		assert.Nil(t, writePayload(tampered, payload))
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, bson.M{"_id": mintId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*models.Mint) = *mint
+			}).Twice()
+		mockDB.EXPECT().XLock(mock.Anything).Return("mintLock", int64(0), nil).Once()
+		mockDB.EXPECT().Unlock("mintLock").Return(nil).Once()
+
+		code := Run([]string{"offline", "import", "--in", tampered, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+	})
+
+	t.Run("Import rejects signer outside the multisig", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, false)
+
+		payload, _ := readPayload(signed)
+		payload.Mints = nil
+		payload.ReturnTxs[0].Signer = poktCrypto.GenerateEd25519PrivKey().PublicKey().RawString()
+		outsider := filepath.Join(dir, "outsider.json")
+		assert.Nil(t, writePayload(outsider, payload))
+
+		errBuffer := &bytes.Buffer{}
+		errOut = errBuffer
+
+		code := Run([]string{"offline", "import", "--in", outsider, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, errBuffer.String(), "is not a member of the vault multisig")
+	})
+
+	t.Run("Import rejects signature under another domain", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, false)
+		fetchMintDomain = func() (eth.DomainData, error) {
+			changed := domain
+			changed.Version = "2"
+			return changed, nil
+		}
+		defer func() { fetchMintDomain = func() (eth.DomainData, error) { return domain, nil } }()
+
+		payload, _ := readPayload(signed)
+		payload.ReturnTxs = nil
+		stale := filepath.Join(dir, "stale.json")
+		assert.Nil(t, writePayload(stale, payload))
+
+		errBuffer := &bytes.Buffer{}
+		errOut = errBuffer
+
+		code := Run([]string{"offline", "import", "--in", stale, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, errBuffer.String(), "typed data domain does not match the mint controller domain")
+	})
+
+	t.Run("Import refuses while signing is halted", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, true)
+
+		errBuffer := &bytes.Buffer{}
+		errOut = errBuffer
+
+		code := Run([]string{"offline", "import", "--in", signed, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, errBuffer.String(), "signing is halted by the signing breaker")
+		mockDB.AssertNotCalled(t, "XLock", mock.Anything)
+	})
+
+	t.Run("Import refuses transfers awaiting approval", func(t *testing.T) {
+		mockDB, _ := setupTest(t)
+		expectSigningBreaker(mockDB, false)
+		app.Config.Approvals.Quorum = 1
+		app.Config.Approvals.MintThreshold = 1000
+		app.Config.Approvals.BurnThreshold = 1000
+		defer func() { app.Config.Approvals = models.ApprovalsConfig{} }()
+
+		mockDB.EXPECT().XLock("burns/"+burnId.Hex()).Return("burnLock", int64(1), nil).Once()
+		mockDB.EXPECT().FindOne(models.CollectionBurns, bson.M{"_id": burnId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				assert.Nil(t, bson.UnmarshalExtJSON(mustMarshal(t, burn), false, result))
+			}).Once()
+		mockDB.EXPECT().Unlock("burnLock").Return(nil).Once()
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, bson.M{"_id": mintId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*models.Mint) = *mint
+			}).Twice()
+		mockDB.EXPECT().XLock("mints/"+strings.ToLower(recipient.Hex())).Return("mintLock", int64(2), nil).Once()
+		mockDB.EXPECT().Unlock("mintLock").Return(nil).Once()
+
+		errBuffer := &bytes.Buffer{}
+		errOut = errBuffer
+
+		code := Run([]string{"offline", "import", "--in", signed, "--operator", "alice"})
+
+		assert.Equal(t, 1, code)
+		assert.Equal(t, 2, strings.Count(errBuffer.String(), "transfer is awaiting approval"))
+		mockDB.AssertNotCalled(t, "UpdateOne", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Wrong chain", func(t *testing.T) {
+		setupTest(t)
+		app.Config.Pocket.ChainId = "mainnet"
+		defer func() { app.Config.Pocket.ChainId = "testnet" }()
+
+		code := Run([]string{"offline", "sign", "--in", exported, "--out", signed})
+
+		assert.Equal(t, 1, code)
+		_, err := os.Stat(signed)
+		assert.Nil(t, err)
+	})
+}
+
+func expectSigningBreaker(mockDB *app.MockDatabase, tripped bool) {
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			result.(*models.CircuitBreaker).Tripped = tripped
+		}).Once()
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	data, err := bson.MarshalExtJSON(v, false, false)
+	assert.Nil(t, err)
+	return data
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func stuckCommands() []Command {
+	return []Command{
+		{
+			Name:  "stuck list",
+			Usage: "stuck list [--type mints|burns|invalid-mints] [--resolved]",
+			Run:   listStuck,
+		},
+		{
+			Name:  "stuck report",
+			Usage: "stuck report",
+			Run:   reportStuck,
+		},
+	}
+}
+
+func stuckFilter(name string, resolved bool) (bson.M, error) {
+	filter := bson.M{"resolved": resolved}
+	if name == "" {
+		return filter, nil
+	}
+	for _, t := range documentTypes {
+		if t.name == name {
+			filter["doc_type"] = t.collection
+			return filter, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown document type %s", name)
+}
+
+func stuckFor(finding models.StuckTransfer, now time.Time) time.Duration {
+	return now.Sub(finding.Since).Truncate(time.Second)
+}
+
+func listStuck(args []string) error {
+	fs := flag.NewFlagSet("stuck list", flag.ContinueOnError)
+	name := fs.String("type", "", "only list this type of document")
+	resolved := fs.Bool("resolved", false, "list findings that have been resolved instead")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return ErrUsage
+	}
+	filter, err := stuckFilter(*name, *resolved)
+	if err != nil {
+		return err
+	}
+
+	requireDB()
+	findings := []models.StuckTransfer{}
+	if err := app.DB.FindMany(models.CollectionStuckTransfers, filter, &findings); err != nil {
+		return err
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Since.Before(findings[j].Since)
+	})
+
+	now := time.Now()
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tTRANSACTION HASH\tSTATUS\tSTUCK FOR\tREASON\tMISSING SIGNERS")
+	for _, finding := range findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", finding.DocType, finding.TransactionHash, finding.Status, stuckFor(finding, now), finding.Reason, strings.Join(finding.MissingSigners, ","))
+	}
+	return w.Flush()
+}
+
+// reportStuck summarizes the open findings by document type, status and reason
+func reportStuck(args []string) error {
+	fs := flag.NewFlagSet("stuck report", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 0 {
+		return ErrUsage
+	}
+
+	requireDB()
+	findings := []models.StuckTransfer{}
+	if err := app.DB.FindMany(models.CollectionStuckTransfers, bson.M{"resolved": false}, &findings); err != nil {
+		return err
+	}
+
+	type group struct {
+		docType, status, reason string
+		count                   int
+		longest                 time.Duration
+	}
+	now := time.Now()
+	groups := map[string]*group{}
+	missing := map[string]int{}
+	for _, finding := range findings {
+		key := finding.DocType + "/" + finding.Status + "/" + finding.Reason
+		g, ok := groups[key]
+		if !ok {
+			g = &group{docType: finding.DocType, status: finding.Status, reason: finding.Reason}
+			groups[key] = g
+		}
+		g.count++
+		if d := stuckFor(finding, now); d > g.longest {
+			g.longest = d
+		}
+		if finding.Status == models.StatusConfirmed {
+			for _, signer := range finding.MissingSigners {
+				missing[signer]++
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tSTATUS\tREASON\tCOUNT\tLONGEST STUCK")
+	for _, key := range keys {
+		g := groups[key]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", g.docType, g.status, g.reason, g.count, g.longest)
+	}
+	if len(missing) > 0 {
+		signers := make([]string, 0, len(missing))
+		for signer := range missing {
+			signers = append(signers, signer)
+		}
+		sort.Strings(signers)
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "MISSING SIGNER\tCONFIRMED TRANSFERS")
+		for _, signer := range signers {
+			fmt.Fprintf(w, "%s\t%d\n", signer, missing[signer])
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestStuckList(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+
+	mockDB.EXPECT().FindMany(models.CollectionStuckTransfers, bson.M{"resolved": false, "doc_type": models.CollectionMints}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]models.StuckTransfer) = []models.StuckTransfer{{
+				DocType:         models.CollectionMints,
+				TransactionHash: "hash",
+				Status:          models.StatusConfirmed,
+				Reason:          models.StuckReasonMissingOurSignature,
+				Since:           time.Now().Add(-time.Hour),
+				MissingSigners:  []string{"0xaaa", "0xbbb"},
+			}}
+		}).Once()
+
+	code := Run([]string{"stuck", "list", "--type", "mints"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, buffer.String(), "hash")
+	assert.Contains(t, buffer.String(), models.StuckReasonMissingOurSignature)
+	assert.Contains(t, buffer.String(), "0xaaa,0xbbb")
+	assert.Contains(t, buffer.String(), "1h0m")
+
+	assert.Equal(t, 1, Run([]string{"stuck", "list", "--type", "unknown"}))
+}
+
+func TestStuckReport(t *testing.T) {
+	mockDB, buffer := setupTest(t)
+	now := time.Now()
+
+	mockDB.EXPECT().FindMany(models.CollectionStuckTransfers, bson.M{"resolved": false}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]models.StuckTransfer) = []models.StuckTransfer{
+				{DocType: models.CollectionBurns, Status: models.StatusConfirmed, Reason: models.StuckReasonMissingSignatures, Since: now.Add(-2 * time.Hour), MissingSigners: []string{"pk1"}},
+				{DocType: models.CollectionBurns, Status: models.StatusConfirmed, Reason: models.StuckReasonMissingSignatures, Since: now.Add(-time.Hour), MissingSigners: []string{"pk1", "pk2"}},
+				{DocType: models.CollectionMints, Status: models.StatusSigned, Reason: models.StuckReasonNotExecuted, Since: now.Add(-3 * time.Hour)},
+			}
+		}).Once()
+
+	code := Run([]string{"stuck", "report"})
+
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `burns\s+confirmed\s+confirmed but missing signatures\s+2\s+2h0m`, buffer.String())
+	assert.Regexp(t, `mints\s+signed\s+signed but never executed\s+1\s+3h0m`, buffer.String())
+	assert.Regexp(t, `pk1\s+2\n`, buffer.String())
+	assert.Regexp(t, `pk2\s+1\n`, buffer.String())
+}
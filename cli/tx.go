@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/pokt/util"
+	"github.com/pokt-network/pocket-core/crypto"
+)
+
+func txCommands() []Command {
+	return []Command{
+		{
+			Name:  "tx decode",
+			Usage: "tx decode <return tx hex> [--chain-id <pocket chain id>]",
+			Run:   decodeTx,
+		},
+	}
+}
+
+func multisigKeys() ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for _, pk := range app.Config.Pocket.MultisigPublicKeys {
+		key, err := crypto.NewPublicKey(pk)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing multisig public key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func decodeTx(args []string) error {
+	fs := flag.NewFlagSet("tx decode", flag.ContinueOnError)
+	chainId := fs.String("chain-id", app.Config.Pocket.ChainId, "pocket chain id the transaction is signed for")
+	positional, err := parseFlags(fs, args)
+	if err != nil || len(positional) != 1 {
+		return ErrUsage
+	}
+
+	keys, err := multisigKeys()
+	if err != nil {
+		return err
+	}
+
+	decoded, err := util.DecodeReturnTx(positional[0], *chainId, keys)
+	if err != nil {
+		return fmt.Errorf("error decoding transaction: %w", err)
+	}
+
+	multisig := "matches configured multisig public keys"
+	if !decoded.MultisigMatch {
+		multisig = "does not match configured multisig public keys"
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "From:\t%s\n", decoded.FromAddress)
+	fmt.Fprintf(w, "To:\t%s\n", decoded.ToAddress)
+	fmt.Fprintf(w, "Amount:\t%s\n", decoded.Amount)
+	fmt.Fprintf(w, "Fee:\t%s\n", decoded.Fee)
+	fmt.Fprintf(w, "Memo:\t%s\n", decoded.Memo)
+	fmt.Fprintf(w, "Entropy:\t%d\n", decoded.Entropy)
+	fmt.Fprintf(w, "Signer:\t%s\n", multisig)
+	fmt.Fprintln(w, "\nSLOT\tPUBLIC KEY\tSIGNATURE")
+	for _, slot := range decoded.Slots {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", slot.Index, slot.PublicKey, slot.Status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if !decoded.Valid() {
+		return errors.New("transaction failed verification")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/dan13ram/wpokt-validator/pokt/util"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTx(t *testing.T) {
+	privateKey1, _ := crypto.NewPrivateKey("8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82")
+	privateKey2, _ := crypto.NewPrivateKey("f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055")
+
+	pubKeys := []crypto.PublicKey{privateKey1.PublicKey(), privateKey2.PublicKey()}
+	multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pubKeys}
+
+	app.Config.Pocket.ChainId = "testnet"
+	app.Config.Pocket.MultisigPublicKeys = []string{pubKeys[0].RawString(), pubKeys[1].RawString()}
+
+	doc, err := util.SignBurn(&models.Burn{
+		RecipientAddress: privateKey2.PublicKey().Address().String(),
+		Amount:           "100000",
+		TransactionHash:  "transaction_hash",
+	}, privateKey1, multisigPubKey, 2, 10000)
+	assert.Nil(t, err)
+
+	t.Run("Partially signed", func(t *testing.T) {
+		_, buffer := setupTest(t)
+
+		code := Run([]string{"tx", "decode", doc.ReturnTx})
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, buffer.String(), "transaction_hash")
+		assert.Contains(t, buffer.String(), "90000")
+		assert.Contains(t, buffer.String(), util.SlotSigned)
+		assert.Contains(t, buffer.String(), util.SlotMissing)
+	})
+
+	t.Run("Wrong chain id", func(t *testing.T) {
+		_, buffer := setupTest(t)
+
+		code := Run([]string{"tx", "decode", doc.ReturnTx, "--chain-id", "mainnet"})
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, buffer.String(), util.SlotInvalid)
+	})
+
+	t.Run("Invalid hex", func(t *testing.T) {
+		setupTest(t)
+
+		code := Run([]string{"tx", "decode", "invalid"})
+
+		assert.Equal(t, 1, code)
+	})
+}
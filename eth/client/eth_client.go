@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"time"
 
 	"math/big"
 
@@ -37,7 +36,7 @@ func (c *ethereumClient) GetClient() *ethclient.Client {
 	return c.client
 }
 func (c *ethereumClient) GetBlockNumber() (uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 
 	blockNumber, err := c.client.BlockNumber(ctx)
@@ -49,7 +48,7 @@ func (c *ethereumClient) GetBlockNumber() (uint64, error) {
 }
 
 func (c *ethereumClient) GetChainId() (*big.Int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 
 	chainId, err := c.client.ChainID(ctx)
@@ -90,7 +89,7 @@ func (c *ethereumClient) ValidateNetwork() {
 }
 
 func (c *ethereumClient) GetTransactionByHash(txHash string) (*types.Transaction, bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 
 	tx, isPending, err := c.client.TransactionByHash(ctx, common.HexToHash(txHash))
@@ -98,16 +97,18 @@ func (c *ethereumClient) GetTransactionByHash(txHash string) (*types.Transaction
 }
 
 func (c *ethereumClient) GetTransactionReceipt(txHash string) (*types.Receipt, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 
 	receipt, err := c.client.TransactionReceipt(ctx, common.HexToHash(txHash))
 	return receipt, err
 }
 
-func NewClient() (EthereumClient, error) {
+// NewClient dials the ethereum rpc. Its calls are traced under the current span of scope, if any.
+func NewClient(scope app.TraceScope) (EthereumClient, error) {
 	client, err := ethclient.Dial(app.Config.Ethereum.RPCURL)
-	return &ethereumClient{
-		client: client,
-	}, err
+	if scope == nil {
+		return &ethereumClient{client: client}, err
+	}
+	return newTracedClient(&ethereumClient{client: client}, scope), err
 }
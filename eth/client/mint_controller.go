@@ -3,6 +3,7 @@ package client
 import (
 	"math/big"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +23,91 @@ type MintControllerContract interface {
 	ValidatorCount(opts *bind.CallOpts) (*big.Int, error)
 	Eip712Domain(opts *bind.CallOpts) (DomainData, error)
 	MaxMintLimit(opts *bind.CallOpts) (*big.Int, error)
+	Validators(opts *bind.CallOpts, validator common.Address) (bool, error)
+	FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerNewValidatorIterator, error)
+	FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerRemovedValidatorIterator, error)
+	FilterEIP712DomainChanged(opts *bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error)
+}
+
+type MintControllerNewValidatorIterator interface {
+	Next() bool
+	Event() *autogen.MintControllerNewValidator
+	Close() error
+	Error() error
+}
+
+type MintControllerNewValidatorIteratorImpl struct {
+	iterator *autogen.MintControllerNewValidatorIterator
+}
+
+func (x *MintControllerNewValidatorIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *MintControllerNewValidatorIteratorImpl) Event() *autogen.MintControllerNewValidator {
+	return x.iterator.Event
+}
+
+func (x *MintControllerNewValidatorIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *MintControllerNewValidatorIteratorImpl) Error() error {
+	return x.iterator.Error()
+}
+
+type MintControllerRemovedValidatorIterator interface {
+	Next() bool
+	Event() *autogen.MintControllerRemovedValidator
+	Close() error
+	Error() error
+}
+
+type MintControllerRemovedValidatorIteratorImpl struct {
+	iterator *autogen.MintControllerRemovedValidatorIterator
+}
+
+func (x *MintControllerRemovedValidatorIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *MintControllerRemovedValidatorIteratorImpl) Event() *autogen.MintControllerRemovedValidator {
+	return x.iterator.Event
+}
+
+func (x *MintControllerRemovedValidatorIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *MintControllerRemovedValidatorIteratorImpl) Error() error {
+	return x.iterator.Error()
+}
+
+type MintControllerEIP712DomainChangedIterator interface {
+	Next() bool
+	Event() *autogen.MintControllerEIP712DomainChanged
+	Close() error
+	Error() error
+}
+
+type MintControllerEIP712DomainChangedIteratorImpl struct {
+	iterator *autogen.MintControllerEIP712DomainChangedIterator
+}
+
+func (x *MintControllerEIP712DomainChangedIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *MintControllerEIP712DomainChangedIteratorImpl) Event() *autogen.MintControllerEIP712DomainChanged {
+	return x.iterator.Event
+}
+
+func (x *MintControllerEIP712DomainChangedIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *MintControllerEIP712DomainChangedIteratorImpl) Error() error {
+	return x.iterator.Error()
 }
 
 type MintControllerContractImpl struct {
@@ -40,6 +126,38 @@ func (x *MintControllerContractImpl) MaxMintLimit(opts *bind.CallOpts) (*big.Int
 	return x.contract.MaxMintLimit(opts)
 }
 
-func NewMintControllerContract(contract *autogen.MintController) MintControllerContract {
-	return &MintControllerContractImpl{contract: contract}
+func (x *MintControllerContractImpl) Validators(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	return x.contract.Validators(opts, validator)
+}
+
+func (x *MintControllerContractImpl) FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerNewValidatorIterator, error) {
+	iterator, err := x.contract.FilterNewValidator(opts, validator)
+	if err != nil {
+		return nil, err
+	}
+	return &MintControllerNewValidatorIteratorImpl{iterator: iterator}, nil
+}
+
+func (x *MintControllerContractImpl) FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerRemovedValidatorIterator, error) {
+	iterator, err := x.contract.FilterRemovedValidator(opts, validator)
+	if err != nil {
+		return nil, err
+	}
+	return &MintControllerRemovedValidatorIteratorImpl{iterator: iterator}, nil
+}
+
+func (x *MintControllerContractImpl) FilterEIP712DomainChanged(opts *bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error) {
+	iterator, err := x.contract.FilterEIP712DomainChanged(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &MintControllerEIP712DomainChangedIteratorImpl{iterator: iterator}, nil
+}
+
+// NewMintControllerContract wraps the mint controller contract, tracing its calls under the current span of scope, if any
+func NewMintControllerContract(contract *autogen.MintController, scope app.TraceScope) MintControllerContract {
+	if scope == nil {
+		return &MintControllerContractImpl{contract: contract}
+	}
+	return newTracedMintControllerContract(&MintControllerContractImpl{contract: contract}, scope)
 }
@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -75,24 +76,24 @@ func (_c *MockMintControllerContract_Eip712Domain_Call) RunAndReturn(run func(*b
 	return _c
 }
 
-// ValidatorCount provides a mock function with given fields: opts
-func (_m *MockMintControllerContract) ValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+// FilterEIP712DomainChanged provides a mock function with given fields: opts
+func (_m *MockMintControllerContract) FilterEIP712DomainChanged(opts *bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error) {
 	ret := _m.Called(opts)
 
-	var r0 *big.Int
+	var r0 MintControllerEIP712DomainChangedIterator
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error)); ok {
 		return rf(opts)
 	}
-	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) MintControllerEIP712DomainChangedIterator); ok {
 		r0 = rf(opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
+			r0 = ret.Get(0).(MintControllerEIP712DomainChangedIterator)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts) error); ok {
 		r1 = rf(opts)
 	} else {
 		r1 = ret.Error(1)
@@ -101,30 +102,140 @@ func (_m *MockMintControllerContract) ValidatorCount(opts *bind.CallOpts) (*big.
 	return r0, r1
 }
 
-// MockMintControllerContract_ValidatorCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatorCount'
-type MockMintControllerContract_ValidatorCount_Call struct {
+// MockMintControllerContract_FilterEIP712DomainChanged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterEIP712DomainChanged'
+type MockMintControllerContract_FilterEIP712DomainChanged_Call struct {
 	*mock.Call
 }
 
-// ValidatorCount is a helper method to define mock.On call
-//   - opts *bind.CallOpts
-func (_e *MockMintControllerContract_Expecter) ValidatorCount(opts interface{}) *MockMintControllerContract_ValidatorCount_Call {
-	return &MockMintControllerContract_ValidatorCount_Call{Call: _e.mock.On("ValidatorCount", opts)}
+// FilterEIP712DomainChanged is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+func (_e *MockMintControllerContract_Expecter) FilterEIP712DomainChanged(opts interface{}) *MockMintControllerContract_FilterEIP712DomainChanged_Call {
+	return &MockMintControllerContract_FilterEIP712DomainChanged_Call{Call: _e.mock.On("FilterEIP712DomainChanged", opts)}
 }
 
-func (_c *MockMintControllerContract_ValidatorCount_Call) Run(run func(opts *bind.CallOpts)) *MockMintControllerContract_ValidatorCount_Call {
+func (_c *MockMintControllerContract_FilterEIP712DomainChanged_Call) Run(run func(opts *bind.FilterOpts)) *MockMintControllerContract_FilterEIP712DomainChanged_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*bind.CallOpts))
+		run(args[0].(*bind.FilterOpts))
 	})
 	return _c
 }
 
-func (_c *MockMintControllerContract_ValidatorCount_Call) Return(_a0 *big.Int, _a1 error) *MockMintControllerContract_ValidatorCount_Call {
+func (_c *MockMintControllerContract_FilterEIP712DomainChanged_Call) Return(_a0 MintControllerEIP712DomainChangedIterator, _a1 error) *MockMintControllerContract_FilterEIP712DomainChanged_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMintControllerContract_ValidatorCount_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockMintControllerContract_ValidatorCount_Call {
+func (_c *MockMintControllerContract_FilterEIP712DomainChanged_Call) RunAndReturn(run func(*bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error)) *MockMintControllerContract_FilterEIP712DomainChanged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterNewValidator provides a mock function with given fields: opts, validator
+func (_m *MockMintControllerContract) FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerNewValidatorIterator, error) {
+	ret := _m.Called(opts, validator)
+
+	var r0 MintControllerNewValidatorIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) (MintControllerNewValidatorIterator, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) MintControllerNewValidatorIterator); ok {
+		r0 = rf(opts, validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(MintControllerNewValidatorIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_FilterNewValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterNewValidator'
+type MockMintControllerContract_FilterNewValidator_Call struct {
+	*mock.Call
+}
+
+// FilterNewValidator is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - validator []common.Address
+func (_e *MockMintControllerContract_Expecter) FilterNewValidator(opts interface{}, validator interface{}) *MockMintControllerContract_FilterNewValidator_Call {
+	return &MockMintControllerContract_FilterNewValidator_Call{Call: _e.mock.On("FilterNewValidator", opts, validator)}
+}
+
+func (_c *MockMintControllerContract_FilterNewValidator_Call) Run(run func(opts *bind.FilterOpts, validator []common.Address)) *MockMintControllerContract_FilterNewValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_FilterNewValidator_Call) Return(_a0 MintControllerNewValidatorIterator, _a1 error) *MockMintControllerContract_FilterNewValidator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_FilterNewValidator_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address) (MintControllerNewValidatorIterator, error)) *MockMintControllerContract_FilterNewValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterRemovedValidator provides a mock function with given fields: opts, validator
+func (_m *MockMintControllerContract) FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerRemovedValidatorIterator, error) {
+	ret := _m.Called(opts, validator)
+
+	var r0 MintControllerRemovedValidatorIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) (MintControllerRemovedValidatorIterator, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) MintControllerRemovedValidatorIterator); ok {
+		r0 = rf(opts, validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(MintControllerRemovedValidatorIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_FilterRemovedValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterRemovedValidator'
+type MockMintControllerContract_FilterRemovedValidator_Call struct {
+	*mock.Call
+}
+
+// FilterRemovedValidator is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - validator []common.Address
+func (_e *MockMintControllerContract_Expecter) FilterRemovedValidator(opts interface{}, validator interface{}) *MockMintControllerContract_FilterRemovedValidator_Call {
+	return &MockMintControllerContract_FilterRemovedValidator_Call{Call: _e.mock.On("FilterRemovedValidator", opts, validator)}
+}
+
+func (_c *MockMintControllerContract_FilterRemovedValidator_Call) Run(run func(opts *bind.FilterOpts, validator []common.Address)) *MockMintControllerContract_FilterRemovedValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_FilterRemovedValidator_Call) Return(_a0 MintControllerRemovedValidatorIterator, _a1 error) *MockMintControllerContract_FilterRemovedValidator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_FilterRemovedValidator_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address) (MintControllerRemovedValidatorIterator, error)) *MockMintControllerContract_FilterRemovedValidator_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -183,6 +294,113 @@ func (_c *MockMintControllerContract_MaxMintLimit_Call) RunAndReturn(run func(*b
 	return _c
 }
 
+// ValidatorCount provides a mock function with given fields: opts
+func (_m *MockMintControllerContract) ValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_ValidatorCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatorCount'
+type MockMintControllerContract_ValidatorCount_Call struct {
+	*mock.Call
+}
+
+// ValidatorCount is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockMintControllerContract_Expecter) ValidatorCount(opts interface{}) *MockMintControllerContract_ValidatorCount_Call {
+	return &MockMintControllerContract_ValidatorCount_Call{Call: _e.mock.On("ValidatorCount", opts)}
+}
+
+func (_c *MockMintControllerContract_ValidatorCount_Call) Run(run func(opts *bind.CallOpts)) *MockMintControllerContract_ValidatorCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_ValidatorCount_Call) Return(_a0 *big.Int, _a1 error) *MockMintControllerContract_ValidatorCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_ValidatorCount_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockMintControllerContract_ValidatorCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validators provides a mock function with given fields: opts, validator
+func (_m *MockMintControllerContract) Validators(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	ret := _m.Called(opts, validator)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, common.Address) (bool, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, common.Address) bool); ok {
+		r0 = rf(opts, validator)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts, common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_Validators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validators'
+type MockMintControllerContract_Validators_Call struct {
+	*mock.Call
+}
+
+// Validators is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+//   - validator common.Address
+func (_e *MockMintControllerContract_Expecter) Validators(opts interface{}, validator interface{}) *MockMintControllerContract_Validators_Call {
+	return &MockMintControllerContract_Validators_Call{Call: _e.mock.On("Validators", opts, validator)}
+}
+
+func (_c *MockMintControllerContract_Validators_Call) Run(run func(opts *bind.CallOpts, validator common.Address)) *MockMintControllerContract_Validators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_Validators_Call) Return(_a0 bool, _a1 error) *MockMintControllerContract_Validators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_Validators_Call) RunAndReturn(run func(*bind.CallOpts, common.Address) (bool, error)) *MockMintControllerContract_Validators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockMintControllerContract creates a new instance of MockMintControllerContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMintControllerContract(t interface {
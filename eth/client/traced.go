@@ -0,0 +1,223 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func startSpan(scope app.TraceScope, name string, attrs ...attribute.KeyValue) func(error) {
+	_, span := app.StartSpan(scope.TraceContext(), name, append([]attribute.KeyValue{attribute.String("rpc.system", "ethereum")}, attrs...)...)
+	return func(err error) {
+		app.EndSpan(span, err)
+	}
+}
+
+// tracedClient traces every RPC call of an ethereum client under the span of a runner
+type tracedClient struct {
+	client EthereumClient
+	scope  app.TraceScope
+}
+
+// newTracedClient traces every RPC call of client under the current span of scope
+func newTracedClient(client EthereumClient, scope app.TraceScope) EthereumClient {
+	return &tracedClient{client: client, scope: scope}
+}
+
+func (c *tracedClient) ValidateNetwork() {
+	c.client.ValidateNetwork()
+}
+
+func (c *tracedClient) GetBlockNumber() (uint64, error) {
+	end := startSpan(c.scope, "eth.GetBlockNumber")
+	res, err := c.client.GetBlockNumber()
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetChainId() (*big.Int, error) {
+	end := startSpan(c.scope, "eth.GetChainId")
+	res, err := c.client.GetChainId()
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetClient() *ethclient.Client {
+	return c.client.GetClient()
+}
+
+func (c *tracedClient) GetTransactionByHash(txHash string) (*types.Transaction, bool, error) {
+	end := startSpan(c.scope, "eth.GetTransactionByHash", attribute.String("tx_hash", txHash))
+	tx, pending, err := c.client.GetTransactionByHash(txHash)
+	end(err)
+	return tx, pending, err
+}
+
+func (c *tracedClient) GetTransactionReceipt(txHash string) (*types.Receipt, error) {
+	end := startSpan(c.scope, "eth.GetTransactionReceipt", attribute.String("tx_hash", txHash))
+	res, err := c.client.GetTransactionReceipt(txHash)
+	end(err)
+	return res, err
+}
+
+// tracedWrappedPocketContract traces every RPC call to the wrapped pocket contract
+type tracedWrappedPocketContract struct {
+	contract WrappedPocketContract
+	scope    app.TraceScope
+}
+
+// newTracedWrappedPocketContract traces every RPC call to contract under the current span of scope
+func newTracedWrappedPocketContract(contract WrappedPocketContract, scope app.TraceScope) WrappedPocketContract {
+	return &tracedWrappedPocketContract{contract: contract, scope: scope}
+}
+
+func (c *tracedWrappedPocketContract) GetUserNonce(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	end := startSpan(c.scope, "wpokt.GetUserNonce")
+	res, err := c.contract.GetUserNonce(opts, user)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	end := startSpan(c.scope, "wpokt.TotalSupply")
+	res, err := c.contract.TotalSupply(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) Paused(opts *bind.CallOpts) (bool, error) {
+	end := startSpan(c.scope, "wpokt.Paused")
+	res, err := c.contract.Paused(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (WrappedPocketMintedIterator, error) {
+	end := startSpan(c.scope, "wpokt.FilterMinted", blockRange(opts)...)
+	res, err := c.contract.FilterMinted(opts, recipient, amount, nonce)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (WrappedPocketBurnAndBridgeIterator, error) {
+	end := startSpan(c.scope, "wpokt.FilterBurnAndBridge", blockRange(opts)...)
+	res, err := c.contract.FilterBurnAndBridge(opts, amount, poktAddress, from)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FilterPaused(opts *bind.FilterOpts) (WrappedPocketPausedIterator, error) {
+	end := startSpan(c.scope, "wpokt.FilterPaused", blockRange(opts)...)
+	res, err := c.contract.FilterPaused(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FilterUnpaused(opts *bind.FilterOpts) (WrappedPocketUnpausedIterator, error) {
+	end := startSpan(c.scope, "wpokt.FilterUnpaused", blockRange(opts)...)
+	res, err := c.contract.FilterUnpaused(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FeeFlag(opts *bind.CallOpts) (bool, error) {
+	end := startSpan(c.scope, "wpokt.FeeFlag")
+	res, err := c.contract.FeeFlag(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FeeBasis(opts *bind.CallOpts) (*big.Int, error) {
+	end := startSpan(c.scope, "wpokt.FeeBasis")
+	res, err := c.contract.FeeBasis(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) FilterFeeCollected(opts *bind.FilterOpts, feeCollector []common.Address, amount []*big.Int) (WrappedPocketFeeCollectedIterator, error) {
+	end := startSpan(c.scope, "wpokt.FilterFeeCollected", blockRange(opts)...)
+	res, err := c.contract.FilterFeeCollected(opts, feeCollector, amount)
+	end(err)
+	return res, err
+}
+
+func (c *tracedWrappedPocketContract) ParseBurnAndBridge(log types.Log) (*autogen.WrappedPocketBurnAndBridge, error) {
+	return c.contract.ParseBurnAndBridge(log)
+}
+
+func blockRange(opts *bind.FilterOpts) []attribute.KeyValue {
+	if opts == nil {
+		return nil
+	}
+	attrs := []attribute.KeyValue{attribute.Int64("block.start", int64(opts.Start))}
+	if opts.End != nil {
+		attrs = append(attrs, attribute.Int64("block.end", int64(*opts.End)))
+	}
+	return attrs
+}
+
+// tracedMintControllerContract traces every RPC call to the mint controller contract
+type tracedMintControllerContract struct {
+	contract MintControllerContract
+	scope    app.TraceScope
+}
+
+// newTracedMintControllerContract traces every RPC call to contract under the current span of scope
+func newTracedMintControllerContract(contract MintControllerContract, scope app.TraceScope) MintControllerContract {
+	return &tracedMintControllerContract{contract: contract, scope: scope}
+}
+
+func (c *tracedMintControllerContract) ValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+	end := startSpan(c.scope, "mint_controller.ValidatorCount")
+	res, err := c.contract.ValidatorCount(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) Eip712Domain(opts *bind.CallOpts) (DomainData, error) {
+	end := startSpan(c.scope, "mint_controller.Eip712Domain")
+	res, err := c.contract.Eip712Domain(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) MaxMintLimit(opts *bind.CallOpts) (*big.Int, error) {
+	end := startSpan(c.scope, "mint_controller.MaxMintLimit")
+	res, err := c.contract.MaxMintLimit(opts)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) Validators(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	end := startSpan(c.scope, "mint_controller.Validators")
+	res, err := c.contract.Validators(opts, validator)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerNewValidatorIterator, error) {
+	end := startSpan(c.scope, "mint_controller.FilterNewValidator", blockRange(opts)...)
+	res, err := c.contract.FilterNewValidator(opts, validator)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (MintControllerRemovedValidatorIterator, error) {
+	end := startSpan(c.scope, "mint_controller.FilterRemovedValidator", blockRange(opts)...)
+	res, err := c.contract.FilterRemovedValidator(opts, validator)
+	end(err)
+	return res, err
+}
+
+func (c *tracedMintControllerContract) FilterEIP712DomainChanged(opts *bind.FilterOpts) (MintControllerEIP712DomainChangedIterator, error) {
+	end := startSpan(c.scope, "mint_controller.FilterEIP712DomainChanged", blockRange(opts)...)
+	res, err := c.contract.FilterEIP712DomainChanged(opts)
+	end(err)
+	return res, err
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"math/big"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -11,9 +12,16 @@ import (
 
 type WrappedPocketContract interface {
 	GetUserNonce(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	Paused(opts *bind.CallOpts) (bool, error)
 	FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (WrappedPocketMintedIterator, error)
 	FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (WrappedPocketBurnAndBridgeIterator, error)
 	ParseBurnAndBridge(log types.Log) (*autogen.WrappedPocketBurnAndBridge, error)
+	FilterPaused(opts *bind.FilterOpts) (WrappedPocketPausedIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts) (WrappedPocketUnpausedIterator, error)
+	FeeFlag(opts *bind.CallOpts) (bool, error)
+	FeeBasis(opts *bind.CallOpts) (*big.Int, error)
+	FilterFeeCollected(opts *bind.FilterOpts, feeCollector []common.Address, amount []*big.Int) (WrappedPocketFeeCollectedIterator, error)
 }
 
 type WrappedPocketBurnAndBridgeIterator interface {
@@ -70,6 +78,87 @@ func (x *WrappedPocketMintedIteratorImpl) Error() error {
 	return x.iterator.Error()
 }
 
+type WrappedPocketPausedIterator interface {
+	Next() bool
+	Event() *autogen.WrappedPocketPaused
+	Close() error
+	Error() error
+}
+
+type WrappedPocketPausedIteratorImpl struct {
+	iterator *autogen.WrappedPocketPausedIterator
+}
+
+func (x *WrappedPocketPausedIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *WrappedPocketPausedIteratorImpl) Event() *autogen.WrappedPocketPaused {
+	return x.iterator.Event
+}
+
+func (x *WrappedPocketPausedIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *WrappedPocketPausedIteratorImpl) Error() error {
+	return x.iterator.Error()
+}
+
+type WrappedPocketUnpausedIterator interface {
+	Next() bool
+	Event() *autogen.WrappedPocketUnpaused
+	Close() error
+	Error() error
+}
+
+type WrappedPocketUnpausedIteratorImpl struct {
+	iterator *autogen.WrappedPocketUnpausedIterator
+}
+
+func (x *WrappedPocketUnpausedIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *WrappedPocketUnpausedIteratorImpl) Event() *autogen.WrappedPocketUnpaused {
+	return x.iterator.Event
+}
+
+func (x *WrappedPocketUnpausedIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *WrappedPocketUnpausedIteratorImpl) Error() error {
+	return x.iterator.Error()
+}
+
+type WrappedPocketFeeCollectedIterator interface {
+	Next() bool
+	Event() *autogen.WrappedPocketFeeCollected
+	Close() error
+	Error() error
+}
+
+type WrappedPocketFeeCollectedIteratorImpl struct {
+	iterator *autogen.WrappedPocketFeeCollectedIterator
+}
+
+func (x *WrappedPocketFeeCollectedIteratorImpl) Next() bool {
+	return x.iterator.Next()
+}
+
+func (x *WrappedPocketFeeCollectedIteratorImpl) Event() *autogen.WrappedPocketFeeCollected {
+	return x.iterator.Event
+}
+
+func (x *WrappedPocketFeeCollectedIteratorImpl) Close() error {
+	return x.iterator.Close()
+}
+
+func (x *WrappedPocketFeeCollectedIteratorImpl) Error() error {
+	return x.iterator.Error()
+}
+
 type WrappedPocketContractImpl struct {
 	contract *autogen.WrappedPocket
 }
@@ -98,6 +187,50 @@ func (x *WrappedPocketContractImpl) GetUserNonce(opts *bind.CallOpts, user commo
 	return x.contract.GetUserNonce(opts, user)
 }
 
-func NewWrappedPocketContract(contract *autogen.WrappedPocket) WrappedPocketContract {
-	return &WrappedPocketContractImpl{contract: contract}
+func (x *WrappedPocketContractImpl) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	return x.contract.TotalSupply(opts)
+}
+
+func (x *WrappedPocketContractImpl) Paused(opts *bind.CallOpts) (bool, error) {
+	return x.contract.Paused(opts)
+}
+
+func (x *WrappedPocketContractImpl) FilterPaused(opts *bind.FilterOpts) (WrappedPocketPausedIterator, error) {
+	iterator, err := x.contract.FilterPaused(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedPocketPausedIteratorImpl{iterator: iterator}, nil
+}
+
+func (x *WrappedPocketContractImpl) FilterUnpaused(opts *bind.FilterOpts) (WrappedPocketUnpausedIterator, error) {
+	iterator, err := x.contract.FilterUnpaused(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedPocketUnpausedIteratorImpl{iterator: iterator}, nil
+}
+
+func (x *WrappedPocketContractImpl) FeeFlag(opts *bind.CallOpts) (bool, error) {
+	return x.contract.FeeFlag(opts)
+}
+
+func (x *WrappedPocketContractImpl) FeeBasis(opts *bind.CallOpts) (*big.Int, error) {
+	return x.contract.FeeBasis(opts)
+}
+
+func (x *WrappedPocketContractImpl) FilterFeeCollected(opts *bind.FilterOpts, feeCollector []common.Address, amount []*big.Int) (WrappedPocketFeeCollectedIterator, error) {
+	iterator, err := x.contract.FilterFeeCollected(opts, feeCollector, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &WrappedPocketFeeCollectedIteratorImpl{iterator: iterator}, nil
+}
+
+// NewWrappedPocketContract wraps the wpokt contract, tracing its calls under the current span of scope, if any
+func NewWrappedPocketContract(contract *autogen.WrappedPocket, scope app.TraceScope) WrappedPocketContract {
+	if scope == nil {
+		return &WrappedPocketContractImpl{contract: contract}
+	}
+	return newTracedWrappedPocketContract(&WrappedPocketContractImpl{contract: contract}, scope)
 }
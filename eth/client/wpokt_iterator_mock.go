@@ -393,3 +393,582 @@ func NewMockWrappedPocketBurnAndBridgeIterator(t interface {
 
 	return mock
 }
+
+// MockWrappedPocketPausedIterator is an autogenerated mock type for the WrappedPocketPausedIterator type
+type MockWrappedPocketPausedIterator struct {
+	mock.Mock
+}
+
+type MockWrappedPocketPausedIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWrappedPocketPausedIterator) EXPECT() *MockWrappedPocketPausedIterator_Expecter {
+	return &MockWrappedPocketPausedIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWrappedPocketPausedIterator) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketPausedIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWrappedPocketPausedIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWrappedPocketPausedIterator_Expecter) Close() *MockWrappedPocketPausedIterator_Close_Call {
+	return &MockWrappedPocketPausedIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWrappedPocketPausedIterator_Close_Call) Run(run func()) *MockWrappedPocketPausedIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Close_Call) Return(_a0 error) *MockWrappedPocketPausedIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Close_Call) RunAndReturn(run func() error) *MockWrappedPocketPausedIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWrappedPocketPausedIterator) Error() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketPausedIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWrappedPocketPausedIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWrappedPocketPausedIterator_Expecter) Error() *MockWrappedPocketPausedIterator_Error_Call {
+	return &MockWrappedPocketPausedIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWrappedPocketPausedIterator_Error_Call) Run(run func()) *MockWrappedPocketPausedIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Error_Call) Return(_a0 error) *MockWrappedPocketPausedIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Error_Call) RunAndReturn(run func() error) *MockWrappedPocketPausedIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWrappedPocketPausedIterator) Event() *autogen.WrappedPocketPaused {
+	ret := _m.Called()
+
+	var r0 *autogen.WrappedPocketPaused
+	if rf, ok := ret.Get(0).(func() *autogen.WrappedPocketPaused); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WrappedPocketPaused)
+		}
+	}
+
+	return r0
+}
+
+// MockWrappedPocketPausedIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWrappedPocketPausedIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWrappedPocketPausedIterator_Expecter) Event() *MockWrappedPocketPausedIterator_Event_Call {
+	return &MockWrappedPocketPausedIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWrappedPocketPausedIterator_Event_Call) Run(run func()) *MockWrappedPocketPausedIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Event_Call) Return(_a0 *autogen.WrappedPocketPaused) *MockWrappedPocketPausedIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Event_Call) RunAndReturn(run func() *autogen.WrappedPocketPaused) *MockWrappedPocketPausedIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWrappedPocketPausedIterator) Next() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketPausedIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWrappedPocketPausedIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWrappedPocketPausedIterator_Expecter) Next() *MockWrappedPocketPausedIterator_Next_Call {
+	return &MockWrappedPocketPausedIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWrappedPocketPausedIterator_Next_Call) Run(run func()) *MockWrappedPocketPausedIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Next_Call) Return(_a0 bool) *MockWrappedPocketPausedIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketPausedIterator_Next_Call) RunAndReturn(run func() bool) *MockWrappedPocketPausedIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWrappedPocketPausedIterator creates a new instance of MockWrappedPocketPausedIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWrappedPocketPausedIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWrappedPocketPausedIterator {
+	mock := &MockWrappedPocketPausedIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWrappedPocketUnpausedIterator is an autogenerated mock type for the WrappedPocketUnpausedIterator type
+type MockWrappedPocketUnpausedIterator struct {
+	mock.Mock
+}
+
+type MockWrappedPocketUnpausedIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWrappedPocketUnpausedIterator) EXPECT() *MockWrappedPocketUnpausedIterator_Expecter {
+	return &MockWrappedPocketUnpausedIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWrappedPocketUnpausedIterator) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketUnpausedIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWrappedPocketUnpausedIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWrappedPocketUnpausedIterator_Expecter) Close() *MockWrappedPocketUnpausedIterator_Close_Call {
+	return &MockWrappedPocketUnpausedIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Close_Call) Run(run func()) *MockWrappedPocketUnpausedIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Close_Call) Return(_a0 error) *MockWrappedPocketUnpausedIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Close_Call) RunAndReturn(run func() error) *MockWrappedPocketUnpausedIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWrappedPocketUnpausedIterator) Error() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketUnpausedIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWrappedPocketUnpausedIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWrappedPocketUnpausedIterator_Expecter) Error() *MockWrappedPocketUnpausedIterator_Error_Call {
+	return &MockWrappedPocketUnpausedIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Error_Call) Run(run func()) *MockWrappedPocketUnpausedIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Error_Call) Return(_a0 error) *MockWrappedPocketUnpausedIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Error_Call) RunAndReturn(run func() error) *MockWrappedPocketUnpausedIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWrappedPocketUnpausedIterator) Event() *autogen.WrappedPocketUnpaused {
+	ret := _m.Called()
+
+	var r0 *autogen.WrappedPocketUnpaused
+	if rf, ok := ret.Get(0).(func() *autogen.WrappedPocketUnpaused); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WrappedPocketUnpaused)
+		}
+	}
+
+	return r0
+}
+
+// MockWrappedPocketUnpausedIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWrappedPocketUnpausedIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWrappedPocketUnpausedIterator_Expecter) Event() *MockWrappedPocketUnpausedIterator_Event_Call {
+	return &MockWrappedPocketUnpausedIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Event_Call) Run(run func()) *MockWrappedPocketUnpausedIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Event_Call) Return(_a0 *autogen.WrappedPocketUnpaused) *MockWrappedPocketUnpausedIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Event_Call) RunAndReturn(run func() *autogen.WrappedPocketUnpaused) *MockWrappedPocketUnpausedIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWrappedPocketUnpausedIterator) Next() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketUnpausedIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWrappedPocketUnpausedIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWrappedPocketUnpausedIterator_Expecter) Next() *MockWrappedPocketUnpausedIterator_Next_Call {
+	return &MockWrappedPocketUnpausedIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Next_Call) Run(run func()) *MockWrappedPocketUnpausedIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Next_Call) Return(_a0 bool) *MockWrappedPocketUnpausedIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketUnpausedIterator_Next_Call) RunAndReturn(run func() bool) *MockWrappedPocketUnpausedIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWrappedPocketUnpausedIterator creates a new instance of MockWrappedPocketUnpausedIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWrappedPocketUnpausedIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWrappedPocketUnpausedIterator {
+	mock := &MockWrappedPocketUnpausedIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWrappedPocketFeeCollectedIterator is an autogenerated mock type for the WrappedPocketFeeCollectedIterator type
+type MockWrappedPocketFeeCollectedIterator struct {
+	mock.Mock
+}
+
+type MockWrappedPocketFeeCollectedIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWrappedPocketFeeCollectedIterator) EXPECT() *MockWrappedPocketFeeCollectedIterator_Expecter {
+	return &MockWrappedPocketFeeCollectedIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWrappedPocketFeeCollectedIterator) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketFeeCollectedIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWrappedPocketFeeCollectedIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWrappedPocketFeeCollectedIterator_Expecter) Close() *MockWrappedPocketFeeCollectedIterator_Close_Call {
+	return &MockWrappedPocketFeeCollectedIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Close_Call) Run(run func()) *MockWrappedPocketFeeCollectedIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Close_Call) Return(_a0 error) *MockWrappedPocketFeeCollectedIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Close_Call) RunAndReturn(run func() error) *MockWrappedPocketFeeCollectedIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWrappedPocketFeeCollectedIterator) Error() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketFeeCollectedIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWrappedPocketFeeCollectedIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWrappedPocketFeeCollectedIterator_Expecter) Error() *MockWrappedPocketFeeCollectedIterator_Error_Call {
+	return &MockWrappedPocketFeeCollectedIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Error_Call) Run(run func()) *MockWrappedPocketFeeCollectedIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Error_Call) Return(_a0 error) *MockWrappedPocketFeeCollectedIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Error_Call) RunAndReturn(run func() error) *MockWrappedPocketFeeCollectedIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWrappedPocketFeeCollectedIterator) Event() *autogen.WrappedPocketFeeCollected {
+	ret := _m.Called()
+
+	var r0 *autogen.WrappedPocketFeeCollected
+	if rf, ok := ret.Get(0).(func() *autogen.WrappedPocketFeeCollected); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WrappedPocketFeeCollected)
+		}
+	}
+
+	return r0
+}
+
+// MockWrappedPocketFeeCollectedIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWrappedPocketFeeCollectedIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWrappedPocketFeeCollectedIterator_Expecter) Event() *MockWrappedPocketFeeCollectedIterator_Event_Call {
+	return &MockWrappedPocketFeeCollectedIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Event_Call) Run(run func()) *MockWrappedPocketFeeCollectedIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Event_Call) Return(_a0 *autogen.WrappedPocketFeeCollected) *MockWrappedPocketFeeCollectedIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Event_Call) RunAndReturn(run func() *autogen.WrappedPocketFeeCollected) *MockWrappedPocketFeeCollectedIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWrappedPocketFeeCollectedIterator) Next() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWrappedPocketFeeCollectedIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWrappedPocketFeeCollectedIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWrappedPocketFeeCollectedIterator_Expecter) Next() *MockWrappedPocketFeeCollectedIterator_Next_Call {
+	return &MockWrappedPocketFeeCollectedIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Next_Call) Run(run func()) *MockWrappedPocketFeeCollectedIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Next_Call) Return(_a0 bool) *MockWrappedPocketFeeCollectedIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWrappedPocketFeeCollectedIterator_Next_Call) RunAndReturn(run func() bool) *MockWrappedPocketFeeCollectedIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWrappedPocketFeeCollectedIterator creates a new instance of MockWrappedPocketFeeCollectedIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWrappedPocketFeeCollectedIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWrappedPocketFeeCollectedIterator {
+	mock := &MockWrappedPocketFeeCollectedIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -29,6 +29,112 @@ func (_m *MockWrappedPocketContract) EXPECT() *MockWrappedPocketContract_Expecte
 	return &MockWrappedPocketContract_Expecter{mock: &_m.Mock}
 }
 
+// FeeBasis provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) FeeBasis(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_FeeBasis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeBasis'
+type MockWrappedPocketContract_FeeBasis_Call struct {
+	*mock.Call
+}
+
+// FeeBasis is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockWrappedPocketContract_Expecter) FeeBasis(opts interface{}) *MockWrappedPocketContract_FeeBasis_Call {
+	return &MockWrappedPocketContract_FeeBasis_Call{Call: _e.mock.On("FeeBasis", opts)}
+}
+
+func (_c *MockWrappedPocketContract_FeeBasis_Call) Run(run func(opts *bind.CallOpts)) *MockWrappedPocketContract_FeeBasis_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FeeBasis_Call) Return(_a0 *big.Int, _a1 error) *MockWrappedPocketContract_FeeBasis_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FeeBasis_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockWrappedPocketContract_FeeBasis_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeeFlag provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) FeeFlag(opts *bind.CallOpts) (bool, error) {
+	ret := _m.Called(opts)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (bool, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) bool); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_FeeFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeFlag'
+type MockWrappedPocketContract_FeeFlag_Call struct {
+	*mock.Call
+}
+
+// FeeFlag is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockWrappedPocketContract_Expecter) FeeFlag(opts interface{}) *MockWrappedPocketContract_FeeFlag_Call {
+	return &MockWrappedPocketContract_FeeFlag_Call{Call: _e.mock.On("FeeFlag", opts)}
+}
+
+func (_c *MockWrappedPocketContract_FeeFlag_Call) Run(run func(opts *bind.CallOpts)) *MockWrappedPocketContract_FeeFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FeeFlag_Call) Return(_a0 bool, _a1 error) *MockWrappedPocketContract_FeeFlag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FeeFlag_Call) RunAndReturn(run func(*bind.CallOpts) (bool, error)) *MockWrappedPocketContract_FeeFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilterBurnAndBridge provides a mock function with given fields: opts, amount, poktAddress, from
 func (_m *MockWrappedPocketContract) FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (WrappedPocketBurnAndBridgeIterator, error) {
 	ret := _m.Called(opts, amount, poktAddress, from)
@@ -86,6 +192,62 @@ func (_c *MockWrappedPocketContract_FilterBurnAndBridge_Call) RunAndReturn(run f
 	return _c
 }
 
+// FilterFeeCollected provides a mock function with given fields: opts, feeCollector, amount
+func (_m *MockWrappedPocketContract) FilterFeeCollected(opts *bind.FilterOpts, feeCollector []common.Address, amount []*big.Int) (WrappedPocketFeeCollectedIterator, error) {
+	ret := _m.Called(opts, feeCollector, amount)
+
+	var r0 WrappedPocketFeeCollectedIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address, []*big.Int) (WrappedPocketFeeCollectedIterator, error)); ok {
+		return rf(opts, feeCollector, amount)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address, []*big.Int) WrappedPocketFeeCollectedIterator); ok {
+		r0 = rf(opts, feeCollector, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(WrappedPocketFeeCollectedIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address, []*big.Int) error); ok {
+		r1 = rf(opts, feeCollector, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_FilterFeeCollected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterFeeCollected'
+type MockWrappedPocketContract_FilterFeeCollected_Call struct {
+	*mock.Call
+}
+
+// FilterFeeCollected is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - feeCollector []common.Address
+//   - amount []*big.Int
+func (_e *MockWrappedPocketContract_Expecter) FilterFeeCollected(opts interface{}, feeCollector interface{}, amount interface{}) *MockWrappedPocketContract_FilterFeeCollected_Call {
+	return &MockWrappedPocketContract_FilterFeeCollected_Call{Call: _e.mock.On("FilterFeeCollected", opts, feeCollector, amount)}
+}
+
+func (_c *MockWrappedPocketContract_FilterFeeCollected_Call) Run(run func(opts *bind.FilterOpts, feeCollector []common.Address, amount []*big.Int)) *MockWrappedPocketContract_FilterFeeCollected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address), args[2].([]*big.Int))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterFeeCollected_Call) Return(_a0 WrappedPocketFeeCollectedIterator, _a1 error) *MockWrappedPocketContract_FilterFeeCollected_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterFeeCollected_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address, []*big.Int) (WrappedPocketFeeCollectedIterator, error)) *MockWrappedPocketContract_FilterFeeCollected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilterMinted provides a mock function with given fields: opts, recipient, amount, nonce
 func (_m *MockWrappedPocketContract) FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (WrappedPocketMintedIterator, error) {
 	ret := _m.Called(opts, recipient, amount, nonce)
@@ -143,6 +305,114 @@ func (_c *MockWrappedPocketContract_FilterMinted_Call) RunAndReturn(run func(*bi
 	return _c
 }
 
+// FilterPaused provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) FilterPaused(opts *bind.FilterOpts) (WrappedPocketPausedIterator, error) {
+	ret := _m.Called(opts)
+
+	var r0 WrappedPocketPausedIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) (WrappedPocketPausedIterator, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) WrappedPocketPausedIterator); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(WrappedPocketPausedIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_FilterPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterPaused'
+type MockWrappedPocketContract_FilterPaused_Call struct {
+	*mock.Call
+}
+
+// FilterPaused is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+func (_e *MockWrappedPocketContract_Expecter) FilterPaused(opts interface{}) *MockWrappedPocketContract_FilterPaused_Call {
+	return &MockWrappedPocketContract_FilterPaused_Call{Call: _e.mock.On("FilterPaused", opts)}
+}
+
+func (_c *MockWrappedPocketContract_FilterPaused_Call) Run(run func(opts *bind.FilterOpts)) *MockWrappedPocketContract_FilterPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterPaused_Call) Return(_a0 WrappedPocketPausedIterator, _a1 error) *MockWrappedPocketContract_FilterPaused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterPaused_Call) RunAndReturn(run func(*bind.FilterOpts) (WrappedPocketPausedIterator, error)) *MockWrappedPocketContract_FilterPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterUnpaused provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) FilterUnpaused(opts *bind.FilterOpts) (WrappedPocketUnpausedIterator, error) {
+	ret := _m.Called(opts)
+
+	var r0 WrappedPocketUnpausedIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) (WrappedPocketUnpausedIterator, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts) WrappedPocketUnpausedIterator); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(WrappedPocketUnpausedIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_FilterUnpaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterUnpaused'
+type MockWrappedPocketContract_FilterUnpaused_Call struct {
+	*mock.Call
+}
+
+// FilterUnpaused is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+func (_e *MockWrappedPocketContract_Expecter) FilterUnpaused(opts interface{}) *MockWrappedPocketContract_FilterUnpaused_Call {
+	return &MockWrappedPocketContract_FilterUnpaused_Call{Call: _e.mock.On("FilterUnpaused", opts)}
+}
+
+func (_c *MockWrappedPocketContract_FilterUnpaused_Call) Run(run func(opts *bind.FilterOpts)) *MockWrappedPocketContract_FilterUnpaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterUnpaused_Call) Return(_a0 WrappedPocketUnpausedIterator, _a1 error) *MockWrappedPocketContract_FilterUnpaused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_FilterUnpaused_Call) RunAndReturn(run func(*bind.FilterOpts) (WrappedPocketUnpausedIterator, error)) *MockWrappedPocketContract_FilterUnpaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserNonce provides a mock function with given fields: opts, user
 func (_m *MockWrappedPocketContract) GetUserNonce(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
 	ret := _m.Called(opts, user)
@@ -252,6 +522,112 @@ func (_c *MockWrappedPocketContract_ParseBurnAndBridge_Call) RunAndReturn(run fu
 	return _c
 }
 
+// Paused provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) Paused(opts *bind.CallOpts) (bool, error) {
+	ret := _m.Called(opts)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (bool, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) bool); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_Paused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Paused'
+type MockWrappedPocketContract_Paused_Call struct {
+	*mock.Call
+}
+
+// Paused is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockWrappedPocketContract_Expecter) Paused(opts interface{}) *MockWrappedPocketContract_Paused_Call {
+	return &MockWrappedPocketContract_Paused_Call{Call: _e.mock.On("Paused", opts)}
+}
+
+func (_c *MockWrappedPocketContract_Paused_Call) Run(run func(opts *bind.CallOpts)) *MockWrappedPocketContract_Paused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_Paused_Call) Return(_a0 bool, _a1 error) *MockWrappedPocketContract_Paused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_Paused_Call) RunAndReturn(run func(*bind.CallOpts) (bool, error)) *MockWrappedPocketContract_Paused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalSupply provides a mock function with given fields: opts
+func (_m *MockWrappedPocketContract) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_TotalSupply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSupply'
+type MockWrappedPocketContract_TotalSupply_Call struct {
+	*mock.Call
+}
+
+// TotalSupply is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockWrappedPocketContract_Expecter) TotalSupply(opts interface{}) *MockWrappedPocketContract_TotalSupply_Call {
+	return &MockWrappedPocketContract_TotalSupply_Call{Call: _e.mock.On("TotalSupply", opts)}
+}
+
+func (_c *MockWrappedPocketContract_TotalSupply_Call) Run(run func(opts *bind.CallOpts)) *MockWrappedPocketContract_TotalSupply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_TotalSupply_Call) Return(_a0 *big.Int, _a1 error) *MockWrappedPocketContract_TotalSupply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_TotalSupply_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockWrappedPocketContract_TotalSupply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWrappedPocketContract creates a new instance of MockWrappedPocketContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWrappedPocketContract(t interface {
@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -12,12 +13,15 @@ import (
 	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/eth/util"
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -25,24 +29,129 @@ const (
 )
 
 type MintExecutorRunner struct {
+	app.Traced
+
 	startBlockNumber   int64
 	currentBlockNumber int64
 	wpoktContract      eth.WrappedPocketContract
 	mintControllerAbi  *abi.ABI
 	client             eth.EthereumClient
+	pause              *PauseTracker
+	fee                *models.FeeSettings
 	vaultAddress       string
 	wpoktAddress       string
+	failed             bool
 }
 
 func (x *MintExecutorRunner) Run() {
 	x.UpdateCurrentBlockNumber()
-	x.SyncTxs()
+	x.pause.Update(x.currentBlockNumber)
+	x.UpdateFeeSettings()
+	x.failed = !x.SyncTxs()
+	x.CheckStuckMints()
 }
 
 func (x *MintExecutorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		EthBlockNumber: strconv.FormatInt(x.startBlockNumber, 10),
+		Failed:         x.failed,
+		Pause:          x.pause.State(),
+		Fee:            x.fee,
+	}
+}
+
+// UpdateFeeSettings reads the mint fee settings of the wpokt contract for the service health
+func (x *MintExecutorRunner) UpdateFeeSettings() {
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	enabled, err := x.wpoktContract.FeeFlag(opts)
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error fetching fee flag: ", err)
+		return
+	}
+	basis, err := x.wpoktContract.FeeBasis(opts)
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error fetching fee basis: ", err)
+		return
+	}
+
+	fee := &models.FeeSettings{Enabled: enabled, Basis: basis.String()}
+	if x.fee != nil && *x.fee != *fee {
+		log.WithFields(log.Fields{"enabled": fee.Enabled, "basis": fee.Basis}).Info("[MINT EXECUTOR] Mint fee settings changed")
+	}
+	x.fee = fee
+}
+
+// FindFeeCollected returns the stored FeeCollected event the wpokt contract emits just before a
+// Minted event when it takes a fee, nil if there is none
+func (x *MintExecutorRunner) FindFeeCollected(event *autogen.WrappedPocketMinted) (*models.FeeCollected, error) {
+	var fees []models.FeeCollected
+	err := x.DB().FindMany(models.CollectionFeesCollected, bson.M{
+		"wpokt_address":    x.wpoktAddress,
+		"transaction_hash": strings.ToLower(event.Raw.TxHash.String()),
+	}, &fees)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *models.FeeCollected
+	foundIndex := int64(-1)
+	for i := range fees {
+		index, err := strconv.ParseInt(fees[i].LogIndex, 10, 64)
+		if err != nil || index >= int64(event.Raw.Index) || index <= foundIndex {
+			continue
+		}
+		found, foundIndex = &fees[i], index
+	}
+	return found, nil
+}
+
+// SyncFeesCollected stores the FeeCollected events of a block range, so that the Minted events
+// of the range are matched with their fee without querying the contract for each of them
+func (x *MintExecutorRunner) SyncFeesCollected(startBlockNumber uint64, endBlockNumber uint64) bool {
+	filter, err := x.wpoktContract.FilterFeeCollected(&bind.FilterOpts{
+		Start:   startBlockNumber,
+		End:     &endBlockNumber,
+		Context: context.Background(),
+	}, []common.Address{}, []*big.Int{})
+
+	if filter != nil {
+		defer filter.Close()
+	}
+
+	if err != nil {
+		log.Errorln("[MINT EXECUTOR] Error while syncing fee collected events: ", err)
+		return false
+	}
+
+	var success bool = true
+	for filter.Next() {
+		event := filter.Event()
+
+		if event == nil {
+			success = false
+			continue
+		}
+
+		if event.Raw.Removed {
+			continue
+		}
+
+		err := x.DB().InsertOne(models.CollectionFeesCollected, util.CreateFeeCollected(event))
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			log.Error("[MINT EXECUTOR] Error while storing fee collected event: ", err)
+			success = false
+		}
 	}
+
+	if err = filter.Error(); err != nil {
+		log.Errorln("[MINT EXECUTOR] Error while syncing fee collected events: ", err)
+		return false
+	}
+
+	return success
 }
 
 func (x *MintExecutorRunner) UpdateCurrentBlockNumber() {
@@ -56,46 +165,159 @@ func (x *MintExecutorRunner) UpdateCurrentBlockNumber() {
 	log.Info("[MINT EXECUTOR] Current block number: ", x.currentBlockNumber)
 }
 
-func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted) bool {
+func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted, lockToken int64) bool {
 	if event == nil {
 		log.Error("[MINT EXECUTOR] Invalid mint event")
 		return false
 	}
 
+	defer x.StartSpan("MINT EXECUTOR HandleMintEvent",
+		attribute.String("doc_type", models.CollectionMints),
+		attribute.String("mint_tx_hash", strings.ToLower(event.Raw.TxHash.String())),
+		attribute.String("nonce", event.Nonce.String()),
+	)()
+
 	log.Debug("[MINT EXECUTOR] Handling mint event: ", event.Raw.TxHash, " ", event.Raw.Index)
 
+	// every mint is signed from a document, so a mint without one was not signed by the validators.
+	// The event holds the amount after the fee, so the mint is matched by recipient and nonce.
+	var mint models.Mint
+	err := x.DB().FindOne(models.CollectionMints, bson.M{
+		"wpokt_address":     x.wpoktAddress,
+		"vault_address":     x.vaultAddress,
+		"recipient_address": strings.ToLower(event.Recipient.Hex()),
+		"nonce":             event.Nonce.String(),
+	}, &mint)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Error("[MINT EXECUTOR] Minted event does not match any mint: ", event.Raw.TxHash)
+		reason := fmt.Sprintf("minted event %s of %s to %s with nonce %s does not match any mint",
+			strings.ToLower(event.Raw.TxHash.String()), event.Amount.String(), strings.ToLower(event.Recipient.Hex()), event.Nonce.String())
+		return x.HandleUnexpectedMint(event, nil, reason, lockToken)
+	}
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error while finding mint: ", err)
+		return false
+	}
+
+	logger := app.TransferLogger(models.CollectionMints, mint.Id, mint.TransactionHash)
+
+	// the last block of a run is scanned again by the next one, and another validator may have handled the event first
+	if mint.Status == models.StatusSuccess {
+		logger.Debug("[MINT EXECUTOR] Mint event already handled")
+		return true
+	}
+
+	// the signed amount is the gross amount, the contract mints it less its fee
+	gross, ok := new(big.Int).SetString(mint.Amount, 10)
+	if !ok {
+		logger.Error("[MINT EXECUTOR] Invalid mint amount: ", mint.Amount)
+		return false
+	}
+	fee := new(big.Int).Sub(gross, event.Amount)
+	feeCollector := ""
+	if fee.Sign() > 0 {
+		collected, err := x.FindFeeCollected(event)
+		if err != nil {
+			logger.Error("[MINT EXECUTOR] Error while finding fee collected: ", err)
+			return false
+		}
+		if collected != nil && collected.Amount == fee.String() {
+			feeCollector = collected.FeeCollector
+		} else {
+			fee = nil
+		}
+	}
+	if fee == nil || fee.Sign() < 0 {
+		logger.Error("[MINT EXECUTOR] Minted amount does not match the signed amount and the fee collected")
+		reason := fmt.Sprintf("minted event %s of %s to %s with nonce %s does not match the signed amount %s",
+			strings.ToLower(event.Raw.TxHash.String()), event.Amount.String(), strings.ToLower(event.Recipient.Hex()), event.Nonce.String(), mint.Amount)
+		return x.HandleUnexpectedMint(event, &mint, reason, lockToken)
+	}
+
 	filter := bson.M{
 		"wpokt_address":     x.wpoktAddress,
 		"vault_address":     x.vaultAddress,
 		"recipient_address": strings.ToLower(event.Recipient.Hex()),
-		"amount":            event.Amount.String(),
 		"nonce":             event.Nonce.String(),
 		"status": bson.M{
 			"$in": []string{models.StatusConfirmed, models.StatusSigned},
 		},
+		"lock_token": app.LockTokenFilter(lockToken),
 	}
 
 	update := bson.M{
 		"$set": bson.M{
-			"status":       models.StatusSuccess,
-			"mint_tx_hash": strings.ToLower(event.Raw.TxHash.String()),
-			"updated_at":   time.Now(),
+			"status":        models.StatusSuccess,
+			"mint_tx_hash":  strings.ToLower(event.Raw.TxHash.String()),
+			"gross_amount":  gross.String(),
+			"net_amount":    event.Amount.String(),
+			"fee":           fee.String(),
+			"fee_collector": feeCollector,
+			"lock_token":    lockToken,
+			"updated_at":    time.Now(),
 		},
 	}
 
-	err := app.DB.UpdateOne(models.CollectionMints, filter, update)
+	err = x.DB().UpdateOne(models.CollectionMints, filter, update)
 
+	if errors.Is(err, app.ErrNotMatched) {
+		logger.Info("[MINT EXECUTOR] Mint changed before the mint event was handled")
+		return true
+	}
 	if err != nil {
-		log.Error("[MINT EXECUTOR] Error while updating mint: ", err)
+		logger.Error("[MINT EXECUTOR] Error while updating mint: ", err)
 		return false
 	}
 
-	log.Info("[MINT EXECUTOR] Mint event handled successfully")
+	logger.WithFields(app.StatusFields(mint.Status, update)).Info("[MINT EXECUTOR] Mint event handled successfully")
 
 	return true
 }
 
+// HandleUnexpectedMint trips the signing breaker on a minted event that was not signed as minted.
+// The unexpected_mint trigger can be turned off, so operators are alerted either way and a matched
+// mint is moved to needs_attention instead of being left for the executor.
+func (x *MintExecutorRunner) HandleUnexpectedMint(event *autogen.WrappedPocketMinted, mint *models.Mint, reason string, lockToken int64) bool {
+	mintTxHash := strings.ToLower(event.Raw.TxHash.String())
+	if mint == nil {
+		app.AlertUnexpectedMint(MintExecutorName, nil, "", mintTxHash, reason)
+		return app.TripBreaker(models.BreakerTriggerUnexpectedMint, reason, app.ValidatorOperator()) == nil
+	}
+	app.AlertUnexpectedMint(MintExecutorName, mint.Id, mint.TransactionHash, mintTxHash, reason)
+	success := app.TripBreaker(models.BreakerTriggerUnexpectedMint, reason, app.ValidatorOperator()) == nil
+
+	logger := app.TransferLogger(models.CollectionMints, mint.Id, mint.TransactionHash)
+	filter := bson.M{
+		"_id": mint.Id,
+		"status": bson.M{
+			"$in": []string{models.StatusConfirmed, models.StatusSigned},
+		},
+		"lock_token": app.LockTokenFilter(lockToken),
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":          models.StatusNeedsAttention,
+			"previous_status": mint.Status,
+			"mint_tx_hash":    mintTxHash,
+			"last_error":      reason,
+			"lock_token":      lockToken,
+			"updated_at":      time.Now(),
+		},
+	}
+	if err := x.DB().UpdateOne(models.CollectionMints, filter, update); err != nil {
+		logger.Error("[MINT EXECUTOR] Error while updating unexpected mint: ", err)
+		return false
+	}
+	logger.WithFields(app.StatusFields(mint.Status, update)).Warn("[MINT EXECUTOR] Unexpected mint needs attention")
+	return success
+}
+
 func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber uint64) bool {
+	// the fees are stored first, since a Minted event is matched with the fee collected before it
+	if !x.SyncFeesCollected(startBlockNumber, endBlockNumber) {
+		return false
+	}
+
 	filter, err := x.wpoktContract.FilterMinted(&bind.FilterOpts{
 		Start:   startBlockNumber,
 		End:     &endBlockNumber,
@@ -130,7 +352,7 @@ func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber
 		}
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(event.Recipient.Hex()))
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[MINT EXECUTOR] Error locking mint: ", err)
 			success = false
@@ -138,9 +360,9 @@ func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber
 		}
 		log.Debug("[MINT EXECUTOR] Locked mint: ", event.Raw.TxHash)
 
-		success = x.HandleMintEvent(event) && success
+		success = x.HandleMintEvent(event, lockToken) && success
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = x.DB().Unlock(lockId); err != nil {
 			log.Error("[MINT EXECUTOR] Error unlocking mint: ", err)
 			success = false
 		} else {
@@ -190,6 +412,31 @@ func (x *MintExecutorRunner) SyncTxs() bool {
 	return success
 }
 
+// CheckStuckMints alerts on signed mints that were never executed on ethereum
+func (x *MintExecutorRunner) CheckStuckMints() {
+	stuckAfter := app.Config.Alerts.StuckAfterMillis.Duration()
+	if !app.Config.Alerts.Enabled || stuckAfter == 0 {
+		return
+	}
+
+	filter := bson.M{
+		"wpokt_address": x.wpoktAddress,
+		"vault_address": x.vaultAddress,
+		"status":        models.StatusSigned,
+		"updated_at":    bson.M{"$lt": time.Now().Add(-stuckAfter)},
+	}
+
+	var mints []models.Mint
+	if err := x.DB().FindMany(models.CollectionMints, filter, &mints); err != nil {
+		log.Error("[MINT EXECUTOR] Error fetching stuck mints: ", err)
+		return
+	}
+
+	for _, mint := range mints {
+		app.AlertIfStuck(MintExecutorName, models.CollectionMints, mint.Id, mint.TransactionHash, mint.Status, mint.UpdatedAt)
+	}
+}
+
 func (x *MintExecutorRunner) InitStartBlockNumber(lastHealth models.ServiceHealth) {
 	startBlockNumber := int64(app.Config.Ethereum.StartBlockNumber)
 
@@ -214,20 +461,6 @@ func NewMintExecutor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Se
 	}
 	log.Debug("[MINT EXECUTOR] Initializing mint executor")
 
-	client, err := eth.NewClient()
-	if err != nil {
-		log.Fatal("[MINT EXECUTOR] Error initializing ethereum client", err)
-	}
-
-	log.Debug("[MINT EXECUTOR] Connecting to mint contract at: ", app.Config.Ethereum.WrappedPocketAddress)
-
-	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), client.GetClient())
-	if err != nil {
-		log.Fatal("[MINT EXECUTOR] Error initializing Wrapped Pocket contract", err)
-	}
-
-	log.Debug("[MINT EXECUTOR] Connected to mint contract")
-
 	mintControllerAbi, err := autogen.MintControllerMetaData.GetAbi()
 	if err != nil {
 		log.Fatal("[MINT EXECUTOR] Error parsing MintController ABI", err)
@@ -238,18 +471,34 @@ func NewMintExecutor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Se
 	x := &MintExecutorRunner{
 		startBlockNumber:   0,
 		currentBlockNumber: 0,
-		wpoktContract:      eth.NewWrappedPocketContract(contract),
 		mintControllerAbi:  mintControllerAbi,
-		client:             client,
 		wpoktAddress:       strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
 		vaultAddress:       strings.ToLower(app.Config.Pocket.VaultAddress),
 	}
 
+	client, err := eth.NewClient(x)
+	if err != nil {
+		log.Fatal("[MINT EXECUTOR] Error initializing ethereum client", err)
+	}
+	x.client = client
+
+	log.Debug("[MINT EXECUTOR] Connecting to mint contract at: ", app.Config.Ethereum.WrappedPocketAddress)
+
+	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), client.GetClient())
+	if err != nil {
+		log.Fatal("[MINT EXECUTOR] Error initializing Wrapped Pocket contract", err)
+	}
+	x.wpoktContract = eth.NewWrappedPocketContract(contract, x)
+
+	log.Debug("[MINT EXECUTOR] Connected to mint contract")
+
+	x.pause = NewPauseTracker(MintExecutorName, x.wpoktContract)
+
 	x.UpdateCurrentBlockNumber()
 
 	x.InitStartBlockNumber(lastHealth)
 
 	log.Info("[MINT EXECUTOR] Initialized mint executor")
 
-	return app.NewRunnerService(MintExecutorName, x, wg, time.Duration(app.Config.MintExecutor.IntervalMillis)*time.Millisecond)
+	return app.NewRunnerService(MintExecutorName, x, wg, app.Config.MintExecutor.IntervalMillis.Duration())
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -38,10 +39,30 @@ func NewTestMintExecutor(t *testing.T, mockContract *eth.MockWrappedPocketContra
 		client:             mockClient,
 		vaultAddress:       "vaultAddress",
 		wpoktAddress:       "wpoktAddress",
+		pause:              NewPauseTracker(MintExecutorName, mockContract),
 	}
 	return x
 }
 
+// expectNoFeesCollected has every FeeCollected query of the contract find no events
+func expectNoFeesCollected(t *testing.T, mockContract *eth.MockWrappedPocketContract) {
+	mockContract.EXPECT().FilterFeeCollected(mock.Anything, []common.Address{}, []*big.Int{}).
+		RunAndReturn(func(*bind.FilterOpts, []common.Address, []*big.Int) (eth.WrappedPocketFeeCollectedIterator, error) {
+			mockFilter := eth.NewMockWrappedPocketFeeCollectedIterator(t)
+			mockFilter.EXPECT().Next().Return(false).Once()
+			mockFilter.EXPECT().Error().Return(nil)
+			mockFilter.EXPECT().Close().Return(nil)
+			return mockFilter, nil
+		})
+}
+
+// findMint fills the mint found by FindOne with the signed amount
+func findMint(amount string) func(string, interface{}, interface{}) {
+	return func(_ string, _ interface{}, result interface{}) {
+		result.(*models.Mint).Amount = amount
+	}
+}
+
 func TestMintExecutorStatus(t *testing.T) {
 	mockContract := eth.NewMockWrappedPocketContract(t)
 	mockClient := eth.NewMockEthereumClient(t)
@@ -89,7 +110,7 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		success := x.HandleMintEvent(nil)
+		success := x.HandleMintEvent(nil, 0)
 
 		assert.False(t, success)
 	})
@@ -101,34 +122,45 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		event := &autogen.WrappedPocketMinted{}
+		event := &autogen.WrappedPocketMinted{Amount: big.NewInt(100)}
 
 		filter := bson.M{
 			"wpokt_address":     x.wpoktAddress,
 			"vault_address":     x.vaultAddress,
 			"recipient_address": strings.ToLower(event.Recipient.Hex()),
-			"amount":            event.Amount.String(),
 			"nonce":             event.Nonce.String(),
 			"status": bson.M{
 				"$in": []string{models.StatusConfirmed, models.StatusSigned},
 			},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":       models.StatusSuccess,
-				"mint_tx_hash": strings.ToLower(event.Raw.TxHash.String()),
-				"updated_at":   time.Now(),
+				"status":        models.StatusSuccess,
+				"mint_tx_hash":  strings.ToLower(event.Raw.TxHash.String()),
+				"gross_amount":  "100",
+				"net_amount":    "100",
+				"fee":           "0",
+				"fee_collector": "",
+				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 			},
 		}
 
+		mockDB.EXPECT().FindOne(models.CollectionMints, bson.M{
+			"wpokt_address":     x.wpoktAddress,
+			"vault_address":     x.vaultAddress,
+			"recipient_address": strings.ToLower(event.Recipient.Hex()),
+			"nonce":             event.Nonce.String(),
+		}, mock.Anything).Return(nil).Run(findMint("100")).Once()
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{})
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
 
 		assert.True(t, success)
 	})
@@ -140,9 +172,226 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100"))
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{})
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
+
+		assert.False(t, success)
+	})
+
+	t.Run("Mint already handled", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				result.(*models.Mint).Amount = "100"
+				result.(*models.Mint).Status = models.StatusSuccess
+			}).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
+
+		assert.True(t, success)
+		mockDB.AssertNotCalled(t, "UpdateOne", models.CollectionMints, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Mint changed before update", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100"))
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(app.ErrNotMatched)
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Unexpected Mint", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.CircuitBreaker.UnexpectedMint = true
+		t.Cleanup(func() { app.Config.CircuitBreaker = models.CircuitBreakerConfig{} })
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.BreakerTriggerUnexpectedMint, update.(bson.M)["$set"].(bson.M)["trigger"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Fee collected", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		txHash := common.HexToHash("0x1234")
+		collector := strings.ToLower(common.HexToAddress("0xfee").Hex())
+		event := &autogen.WrappedPocketMinted{Amount: big.NewInt(990), Raw: types.Log{BlockNumber: 10, TxHash: txHash, Index: 5}}
+
+		mockDB.EXPECT().FindMany(models.CollectionFeesCollected, bson.M{
+			"wpokt_address":    x.wpoktAddress,
+			"transaction_hash": strings.ToLower(txHash.String()),
+		}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.FeeCollected) = []models.FeeCollected{
+					{FeeCollector: collector, Amount: "20", LogIndex: "1"},
+					{FeeCollector: collector, Amount: "10", LogIndex: "3"},
+					{FeeCollector: collector, Amount: "30", LogIndex: "7"},
+				}
+			}).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("1000")).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSuccess, set["status"])
+				assert.Equal(t, "1000", set["gross_amount"])
+				assert.Equal(t, "990", set["net_amount"])
+				assert.Equal(t, "10", set["fee"])
+				assert.Equal(t, collector, set["fee_collector"])
+			}).Once()
+
+		success := x.HandleMintEvent(event, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Error finding fee collected", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("1000")).Once()
+		mockDB.EXPECT().FindMany(models.CollectionFeesCollected, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(990)}, 0)
+
+		assert.False(t, success)
+	})
+
+	t.Run("Fee not collected", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.CircuitBreaker.UnexpectedMint = true
+		t.Cleanup(func() { app.Config.CircuitBreaker = models.CircuitBreakerConfig{} })
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("1000")).Once()
+		mockDB.EXPECT().FindMany(models.CollectionFeesCollected, mock.Anything, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.StatusNeedsAttention, update.(bson.M)["$set"].(bson.M)["status"])
+			}).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(990)}, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Minted more than signed", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.CircuitBreaker.UnexpectedMint = true
+		t.Cleanup(func() { app.Config.CircuitBreaker = models.CircuitBreakerConfig{} })
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("1000")).Once()
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Contains(t, update.(bson.M)["$set"].(bson.M)["reason"], "does not match the signed amount 1000")
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(1010)}, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Unexpected amount with the trigger off", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		event := &autogen.WrappedPocketMinted{Amount: big.NewInt(1010)}
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				result.(*models.Mint).Amount = "1000"
+				result.(*models.Mint).Status = models.StatusSigned
+			}).Once()
+		// the breaker is not tripped, so the mint is held for an operator instead
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, update interface{}) {
+				assert.Equal(t, app.LockTokenFilter(3), filter.(bson.M)["lock_token"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusNeedsAttention, set["status"])
+				assert.Equal(t, models.StatusSigned, set["previous_status"])
+				assert.Equal(t, strings.ToLower(event.Raw.TxHash.String()), set["mint_tx_hash"])
+				assert.Contains(t, set["last_error"], "does not match the signed amount 1000")
+				assert.Equal(t, int64(3), set["lock_token"])
+			}).Once()
+
+		success := x.HandleMintEvent(event, 3)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Error holding unexpected mint", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("1000")).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(app.ErrNotMatched).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(1010)}, 0)
+
+		assert.False(t, success)
+	})
+
+	t.Run("Error Finding Mint", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}, 0)
 
 		assert.False(t, success)
 	})
@@ -185,6 +434,81 @@ func TestMintExecutorInitStartBlockNumber(t *testing.T) {
 
 }
 
+func TestMintExecutorSyncFeesCollected(t *testing.T) {
+
+	newFilter := func(t *testing.T, events ...*autogen.WrappedPocketFeeCollected) *eth.MockWrappedPocketFeeCollectedIterator {
+		mockFilter := eth.NewMockWrappedPocketFeeCollectedIterator(t)
+		for _, event := range events {
+			mockFilter.EXPECT().Next().Return(true).Once()
+			mockFilter.EXPECT().Event().Return(event).Once()
+		}
+		mockFilter.EXPECT().Next().Return(false).Once()
+		mockFilter.EXPECT().Error().Return(nil)
+		mockFilter.EXPECT().Close().Return(nil)
+		return mockFilter
+	}
+
+	t.Run("Stores events", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, nil)
+
+		txHash := common.HexToHash("0x1234")
+		mockFilter := newFilter(t,
+			&autogen.WrappedPocketFeeCollected{FeeCollector: common.HexToAddress("0xfee"), Amount: big.NewInt(10), Raw: types.Log{BlockNumber: 10, TxHash: txHash, Index: 3}},
+			&autogen.WrappedPocketFeeCollected{Amount: big.NewInt(20), Raw: types.Log{Removed: true}},
+			&autogen.WrappedPocketFeeCollected{Amount: big.NewInt(30), Raw: types.Log{BlockNumber: 20, Index: 1}},
+		)
+		mockContract.EXPECT().FilterFeeCollected(mock.Anything, []common.Address{}, []*big.Int{}).Return(mockFilter, nil).
+			Run(func(opts *bind.FilterOpts, _ []common.Address, _ []*big.Int) {
+				assert.Equal(t, uint64(1), opts.Start)
+				assert.Equal(t, uint64(100), *opts.End)
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionFeesCollected, mock.Anything).Return(nil).
+			Run(func(_ string, doc interface{}) {
+				fee := doc.(models.FeeCollected)
+				assert.Equal(t, strings.ToLower(txHash.String()), fee.TransactionHash)
+				assert.Equal(t, "3", fee.LogIndex)
+				assert.Equal(t, "10", fee.Amount)
+			}).Once()
+		// an event stored by an earlier run is skipped
+		mockDB.EXPECT().InsertOne(models.CollectionFeesCollected, mock.Anything).Return(mongo.CommandError{Code: 11000}).Once()
+
+		success := x.SyncFeesCollected(1, 100)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Error storing event", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, nil)
+
+		mockFilter := newFilter(t, &autogen.WrappedPocketFeeCollected{Amount: big.NewInt(10)})
+		mockContract.EXPECT().FilterFeeCollected(mock.Anything, []common.Address{}, []*big.Int{}).Return(mockFilter, nil).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionFeesCollected, mock.Anything).Return(errors.New("error")).Once()
+
+		success := x.SyncFeesCollected(1, 100)
+
+		assert.False(t, success)
+	})
+
+	t.Run("Error in Filtering stops the sync", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		app.DB = app.NewMockDatabase(t)
+		x := NewTestMintExecutor(t, mockContract, nil)
+
+		mockContract.EXPECT().FilterFeeCollected(mock.Anything, []common.Address{}, []*big.Int{}).Return(nil, errors.New("error")).Once()
+
+		// minted events are not handled without the fees of their blocks
+		success := x.SyncBlocks(1, 100)
+
+		assert.False(t, success)
+	})
+}
+
 func TestMintExecutorSyncBlocks(t *testing.T) {
 
 	t.Run("Successful Case", func(t *testing.T) {
@@ -192,7 +516,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
@@ -200,15 +524,47 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).
 			Run(func(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
+		mockDB.EXPECT().Unlock("lockId").Return(nil)
+
+		success := x.SyncBlocks(1, 100)
+		assert.True(t, success)
+	})
+
+	t.Run("Carries fencing token", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockClient := eth.NewMockEthereumClient(t)
+		mockDB := app.NewMockDatabase(t)
+		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
+		mockFilter.EXPECT().Error().Return(nil)
+		mockFilter.EXPECT().Close().Return(nil)
+		mockFilter.EXPECT().Next().Return(true).Once()
+		mockFilter.EXPECT().Next().Return(false).Once()
+		app.DB = mockDB
+
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
+		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
+			Return(mockFilter, nil).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, gotFilter interface{}, gotUpdate interface{}) {
+				assert.Equal(t, app.LockTokenFilter(7), gotFilter.(bson.M)["lock_token"])
+				assert.Equal(t, int64(7), gotUpdate.(bson.M)["$set"].(bson.M)["lock_token"])
+			}).Once()
+
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(7), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 		success := x.SyncBlocks(1, 100)
@@ -220,7 +576,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
@@ -228,6 +584,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).
 			Run(func(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) {
@@ -235,7 +592,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 
 		success := x.SyncBlocks(1, 100)
 		assert.False(t, success)
@@ -246,7 +603,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
@@ -254,15 +611,17 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).
 			Run(func(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
 
 		success := x.SyncBlocks(1, 100)
@@ -275,6 +634,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockDB := app.NewMockDatabase(t)
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(nil, errors.New("some error")).Once()
 
@@ -295,6 +655,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
@@ -306,11 +667,11 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{}).Once()
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}).Once()
 		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{
 			Raw: types.Log{Removed: true},
 		}).Once()
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{}).Once()
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}).Once()
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Times(3)
@@ -318,12 +679,14 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Times(2)
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Times(2)
 
 		assert.True(t, x.SyncBlocks(1, 100))
@@ -335,18 +698,20 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
 		mockFilter.EXPECT().Event().Return(nil).Once()
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{}).Once()
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)}).Once()
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Times(2)
 		mockFilter.EXPECT().Next().Return(false).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 		assert.False(t, x.SyncBlocks(1, 100))
@@ -363,6 +728,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
@@ -383,6 +749,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
@@ -425,7 +792,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
@@ -433,6 +800,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		x.currentBlockNumber = 100
 		x.startBlockNumber = 1
 
@@ -442,8 +810,9 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 		success := x.SyncTxs()
@@ -459,7 +828,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
 		mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
@@ -469,13 +838,15 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
+		expectNoFeesCollected(t, mockContract)
 		x.currentBlockNumber = 200000
 		x.startBlockNumber = 1
 
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Times(2)
+		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100"))
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil)
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 		success := x.SyncTxs()
@@ -549,7 +920,7 @@ func TestMintExecutorRun(t *testing.T) {
 	mockClient := eth.NewMockEthereumClient(t)
 	mockDB := app.NewMockDatabase(t)
 	mockFilter := eth.NewMockWrappedPocketMintedIterator(t)
-	mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{})
+	mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{Amount: big.NewInt(100)})
 	mockFilter.EXPECT().Error().Return(nil)
 	mockFilter.EXPECT().Close().Return(nil)
 	mockFilter.EXPECT().Next().Return(true).Once()
@@ -557,20 +928,26 @@ func TestMintExecutorRun(t *testing.T) {
 
 	app.DB = mockDB
 	x := NewTestMintExecutor(t, mockContract, mockClient)
+	expectNoFeesCollected(t, mockContract)
 	x.currentBlockNumber = 100
 	x.startBlockNumber = 1
 
 	mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil)
+	mockContract.EXPECT().Paused(mock.Anything).Return(false, nil)
+	mockContract.EXPECT().FeeFlag(mock.Anything).Return(true, nil).Once()
+	mockContract.EXPECT().FeeBasis(mock.Anything).Return(big.NewInt(25), nil).Once()
 	mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 		Return(mockFilter, nil).
 		Run(func(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) {
 			assert.Equal(t, opts.Start, uint64(1))
 			assert.Equal(t, *opts.End, uint64(100))
 		}).Once()
+	mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Run(findMint("100")).Once()
 	mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
-	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 	mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 	x.Run()
 
+	assert.Equal(t, &models.FeeSettings{Enabled: true, Basis: "25"}, x.Status().Fee)
 }
@@ -5,13 +5,13 @@ import (
 	"math/big"
 	"strconv"
 	"sync"
-	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	"github.com/dan13ram/wpokt-validator/eth/util"
 	"github.com/dan13ram/wpokt-validator/models"
+	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
@@ -23,21 +23,34 @@ const (
 )
 
 type BurnMonitorRunner struct {
+	app.Traced
+
 	startBlockNumber   int64
 	currentBlockNumber int64
 	wpoktContract      eth.WrappedPocketContract
 	client             eth.EthereumClient
-	minimumAmount      *big.Int
+	poktClient         pokt.PocketClient
+	pause              *PauseTracker
+	txFee              *big.Int
+	failed             bool
 }
 
 func (x *BurnMonitorRunner) Run() {
 	x.UpdateCurrentBlockNumber()
-	x.SyncTxs()
+	x.pause.Update(x.currentBlockNumber)
+	x.txFee = pokt.UpdateTxFee(x.poktClient, BurnMonitorName, x.txFee)
+	if x.txFee == nil {
+		x.failed = true
+		return
+	}
+	x.failed = !x.SyncTxs()
 }
 
 func (x *BurnMonitorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		EthBlockNumber: strconv.FormatInt(x.startBlockNumber, 10),
+		Failed:         x.failed,
+		Pause:          x.pause.State(),
 	}
 }
 
@@ -59,20 +72,23 @@ func (x *BurnMonitorRunner) HandleBurnEvent(event *autogen.WrappedPocketBurnAndB
 
 	doc := util.CreateBurn(event)
 
+	logger := app.TransferLogger(models.CollectionBurns, doc.Id, doc.TransactionHash)
+	defer x.StartSpan("BURN MONITOR HandleBurnEvent", app.TransferAttributes(models.CollectionBurns, doc.Id, doc.TransactionHash)...)()
+
 	// each event is a combination of transaction hash and log index
-	log.Debug("[BURN MONITOR] Handling burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
+	logger.Debug("[BURN MONITOR] Handling burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
 
-	err := app.DB.InsertOne(models.CollectionBurns, doc)
+	err := x.DB().InsertOne(models.CollectionBurns, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			log.Info("[BURN MONITOR] Found duplicate burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
+			logger.Info("[BURN MONITOR] Found duplicate burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
 			return true
 		}
-		log.Error("[BURN MONITOR] Error while storing burn event in db: ", err)
+		logger.Error("[BURN MONITOR] Error while storing burn event in db: ", err)
 		return false
 	}
 
-	log.Info("[BURN MONITOR] Stored burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
+	logger.WithField("status_to", doc.Status).Info("[BURN MONITOR] Stored burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
 	return true
 }
 
@@ -106,7 +122,7 @@ func (x *BurnMonitorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber u
 			continue
 		}
 
-		if event.Raw.Removed || event.Amount.Cmp(x.minimumAmount) != 1 {
+		if event.Raw.Removed || event.Amount.Cmp(x.txFee) != 1 {
 			continue
 		}
 
@@ -174,25 +190,28 @@ func NewBurnMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 	}
 
 	log.Debug("[BURN MONITOR] Initializing burn monitor")
-	client, err := eth.NewClient()
+	x := &BurnMonitorRunner{
+		startBlockNumber:   0,
+		currentBlockNumber: 0,
+	}
+	x.poktClient = pokt.NewClient(x)
+
+	client, err := eth.NewClient(x)
 	if err != nil {
 		log.Fatal("[BURN MONITOR] Error initializing ethereum client: ", err)
 	}
+	x.client = client
+
 	log.Debug("[BURN MONITOR] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
 	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), client.GetClient())
 	if err != nil {
 		log.Fatal("[BURN MONITOR] Error connecting to wpokt contract: ", err)
 	}
+	x.wpoktContract = eth.NewWrappedPocketContract(contract, x)
 
 	log.Debug("[BURN MONITOR] Connected to wpokt contract")
 
-	x := &BurnMonitorRunner{
-		startBlockNumber:   0,
-		currentBlockNumber: 0,
-		wpoktContract:      eth.NewWrappedPocketContract(contract),
-		client:             client,
-		minimumAmount:      big.NewInt(app.Config.Pocket.TxFee),
-	}
+	x.pause = NewPauseTracker(BurnMonitorName, x.wpoktContract)
 
 	x.UpdateCurrentBlockNumber()
 
@@ -204,6 +223,6 @@ func NewBurnMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 		BurnMonitorName,
 		x,
 		wg,
-		time.Duration(app.Config.BurnMonitor.IntervalMillis)*time.Millisecond,
+		app.Config.BurnMonitor.IntervalMillis.Duration(),
 	)
 }
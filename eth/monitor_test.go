@@ -12,6 +12,7 @@ import (
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	"github.com/dan13ram/wpokt-validator/models"
+	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -32,11 +33,18 @@ func NewTestBurnMonitor(t *testing.T, mockContract *eth.MockWrappedPocketContrac
 		currentBlockNumber: 100,
 		wpoktContract:      mockContract,
 		client:             mockClient,
-		minimumAmount:      big.NewInt(10000),
+		txFee:              big.NewInt(10000),
+		pause:              NewPauseTracker(BurnMonitorName, mockContract),
 	}
 	return x
 }
 
+// expectTxFee makes the chain params charge the base fee of 10000 for a send
+func expectTxFee(mockPoktClient *pokt.MockPocketClient) {
+	mockPoktClient.EXPECT().GetParam(pokt.FeeMultipliersParamKey).
+		Return(&pokt.ParamResponse{Key: pokt.FeeMultipliersParamKey, Value: `{"fee_multiplier":null,"default":"1"}`}, nil)
+}
+
 func TestBurnMonitorStatus(t *testing.T) {
 	mockContract := eth.NewMockWrappedPocketContract(t)
 	mockClient := eth.NewMockEthereumClient(t)
@@ -198,7 +206,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		assert.True(t, success)
 	})
 
-	t.Run("Amount less than minimumAmount", func(t *testing.T) {
+	t.Run("Amount not above the tx fee", func(t *testing.T) {
 		mockContract := eth.NewMockWrappedPocketContract(t)
 		mockClient := eth.NewMockEthereumClient(t)
 		mockDB := app.NewMockDatabase(t)
@@ -513,12 +521,16 @@ func TestBurnMonitorRun(t *testing.T) {
 	mockFilter.EXPECT().Next().Return(true).Once()
 	mockFilter.EXPECT().Next().Return(false).Once()
 
+	mockPoktClient := pokt.NewMockPocketClient(t)
 	app.DB = mockDB
 	x := NewTestBurnMonitor(t, mockContract, mockClient)
+	x.poktClient = mockPoktClient
 	x.currentBlockNumber = 100
 	x.startBlockNumber = 1
 
 	mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil)
+	expectTxFee(mockPoktClient)
+	mockContract.EXPECT().Paused(mock.Anything).Return(false, nil)
 	mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 		Return(mockFilter, nil).
 		Run(func(opts *bind.FilterOpts, amount []*big.Int, to []common.Address, from []common.Address) {
@@ -0,0 +1,150 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// PauseTracker follows the paused state of the wpokt contract. It reads the state once and
+// then applies the Paused and Unpaused events of every new block.
+type PauseTracker struct {
+	name      string
+	contract  eth.WrappedPocketContract
+	state     *models.PauseState
+	lastBlock int64
+}
+
+func NewPauseTracker(name string, contract eth.WrappedPocketContract) *PauseTracker {
+	return &PauseTracker{name: name, contract: contract}
+}
+
+// Paused reports whether the contract was paused when last checked, false if never checked
+func (p *PauseTracker) Paused() bool {
+	return p.state != nil && p.state.Paused
+}
+
+// State is the last known state, nil if it could not be read yet
+func (p *PauseTracker) State() *models.PauseState {
+	return p.state
+}
+
+type pauseEvent struct {
+	paused  bool
+	account string
+	raw     types.Log
+}
+
+// Update brings the state up to the current block and returns whether it succeeded
+func (p *PauseTracker) Update(currentBlockNumber int64) bool {
+	if p.state == nil {
+		return p.readState(currentBlockNumber)
+	}
+	if currentBlockNumber <= p.lastBlock {
+		return true
+	}
+
+	events := []pauseEvent{}
+	for start := p.lastBlock + 1; start <= currentBlockNumber; start += eth.MAX_QUERY_BLOCKS {
+		end := start + eth.MAX_QUERY_BLOCKS - 1
+		if end > currentBlockNumber {
+			end = currentBlockNumber
+		}
+		found, err := p.filterEvents(uint64(start), uint64(end))
+		if err != nil {
+			log.Errorf("[%s] Error fetching wpokt pause events: %s", p.name, err)
+			return false
+		}
+		events = append(events, found...)
+	}
+	p.lastBlock = currentBlockNumber
+
+	if len(events) == 0 {
+		return true
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].raw.BlockNumber != events[j].raw.BlockNumber {
+			return events[i].raw.BlockNumber < events[j].raw.BlockNumber
+		}
+		return events[i].raw.Index < events[j].raw.Index
+	})
+	last := events[len(events)-1]
+	p.setState(&models.PauseState{
+		Paused:      last.paused,
+		Account:     last.account,
+		BlockNumber: strconv.FormatUint(last.raw.BlockNumber, 10),
+	})
+	return true
+}
+
+func (p *PauseTracker) readState(currentBlockNumber int64) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+	defer cancel()
+	paused, err := p.contract.Paused(&bind.CallOpts{Context: ctx, Pending: false, BlockNumber: big.NewInt(currentBlockNumber)})
+	if err != nil {
+		log.Errorf("[%s] Error fetching wpokt paused state: %s", p.name, err)
+		return false
+	}
+	p.lastBlock = currentBlockNumber
+	p.setState(&models.PauseState{Paused: paused, BlockNumber: strconv.FormatInt(currentBlockNumber, 10)})
+	return true
+}
+
+func (p *PauseTracker) setState(state *models.PauseState) {
+	logger := log.WithFields(log.Fields{"account": state.Account, "block_number": state.BlockNumber})
+	if state.Paused && !p.Paused() {
+		logger.Warnf("[%s] Wrapped Pocket contract is paused", p.name)
+	}
+	if !state.Paused && p.Paused() {
+		logger.Infof("[%s] Wrapped Pocket contract is unpaused", p.name)
+	}
+	p.state = state
+}
+
+func (p *PauseTracker) filterEvents(start uint64, end uint64) ([]pauseEvent, error) {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: context.Background()}
+	events := []pauseEvent{}
+
+	paused, err := p.contract.FilterPaused(opts)
+	if paused != nil {
+		defer paused.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for paused.Next() {
+		if event := paused.Event(); event != nil && !event.Raw.Removed {
+			events = append(events, pauseEvent{true, strings.ToLower(event.Account.Hex()), event.Raw})
+		}
+	}
+	if err := paused.Error(); err != nil {
+		return nil, err
+	}
+
+	unpaused, err := p.contract.FilterUnpaused(opts)
+	if unpaused != nil {
+		defer unpaused.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for unpaused.Next() {
+		if event := unpaused.Event(); event != nil && !event.Raw.Removed {
+			events = append(events, pauseEvent{false, strings.ToLower(event.Account.Hex()), event.Raw})
+		}
+	}
+	if err := unpaused.Error(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,132 @@
+package eth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func expectPauseEvents(t *testing.T, mockContract *eth.MockWrappedPocketContract, start uint64, end uint64, paused []*autogen.WrappedPocketPaused, unpaused []*autogen.WrappedPocketUnpaused) {
+	pausedFilter := eth.NewMockWrappedPocketPausedIterator(t)
+	for _, event := range paused {
+		pausedFilter.EXPECT().Next().Return(true).Once()
+		pausedFilter.EXPECT().Event().Return(event).Once()
+	}
+	pausedFilter.EXPECT().Next().Return(false).Once()
+	pausedFilter.EXPECT().Error().Return(nil)
+	pausedFilter.EXPECT().Close().Return(nil)
+
+	unpausedFilter := eth.NewMockWrappedPocketUnpausedIterator(t)
+	for _, event := range unpaused {
+		unpausedFilter.EXPECT().Next().Return(true).Once()
+		unpausedFilter.EXPECT().Event().Return(event).Once()
+	}
+	unpausedFilter.EXPECT().Next().Return(false).Once()
+	unpausedFilter.EXPECT().Error().Return(nil)
+	unpausedFilter.EXPECT().Close().Return(nil)
+
+	checkRange := func(opts *bind.FilterOpts) {
+		assert.Equal(t, start, opts.Start)
+		assert.Equal(t, end, *opts.End)
+	}
+	mockContract.EXPECT().FilterPaused(mock.Anything).Return(pausedFilter, nil).Run(checkRange).Once()
+	mockContract.EXPECT().FilterUnpaused(mock.Anything).Return(unpausedFilter, nil).Run(checkRange).Once()
+}
+
+func TestPauseTracker(t *testing.T) {
+	account := common.HexToAddress("0xabcd")
+
+	t.Run("Reads the state at startup", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		p := NewPauseTracker("TEST", mockContract)
+		assert.False(t, p.Paused())
+		assert.Nil(t, p.State())
+
+		mockContract.EXPECT().Paused(mock.Anything).Return(true, nil).
+			Run(func(opts *bind.CallOpts) {
+				assert.Equal(t, int64(100), opts.BlockNumber.Int64())
+			}).Once()
+
+		assert.True(t, p.Update(100))
+		assert.True(t, p.Paused())
+		assert.Equal(t, &models.PauseState{Paused: true, BlockNumber: "100"}, p.State())
+	})
+
+	t.Run("Error reading the state", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		p := NewPauseTracker("TEST", mockContract)
+		mockContract.EXPECT().Paused(mock.Anything).Return(false, errors.New("error")).Once()
+
+		assert.False(t, p.Update(100))
+		assert.Nil(t, p.State())
+	})
+
+	t.Run("Applies the last event", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		p := NewPauseTracker("TEST", mockContract)
+		p.state = &models.PauseState{Paused: false, BlockNumber: "100"}
+		p.lastBlock = 100
+
+		expectPauseEvents(t, mockContract, 101, 110,
+			[]*autogen.WrappedPocketPaused{
+				{Account: account, Raw: types.Log{BlockNumber: 102, Index: 1}},
+				{Account: account, Raw: types.Log{BlockNumber: 108, Index: 0}},
+			},
+			[]*autogen.WrappedPocketUnpaused{
+				{Account: account, Raw: types.Log{BlockNumber: 105, Index: 3}},
+			},
+		)
+
+		assert.True(t, p.Update(110))
+		assert.True(t, p.Paused())
+		assert.Equal(t, &models.PauseState{Paused: true, Account: "0x000000000000000000000000000000000000abcd", BlockNumber: "108"}, p.State())
+	})
+
+	t.Run("Resumes after unpaused", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		p := NewPauseTracker("TEST", mockContract)
+		p.state = &models.PauseState{Paused: true, BlockNumber: "100"}
+		p.lastBlock = 100
+
+		expectPauseEvents(t, mockContract, 101, 120, nil,
+			[]*autogen.WrappedPocketUnpaused{
+				{Account: account, Raw: types.Log{BlockNumber: 115}},
+				{Account: account, Raw: types.Log{BlockNumber: 116, Removed: true}},
+			},
+		)
+
+		assert.True(t, p.Update(120))
+		assert.False(t, p.Paused())
+		assert.Equal(t, "115", p.State().BlockNumber)
+	})
+
+	t.Run("No new blocks", func(t *testing.T) {
+		p := NewPauseTracker("TEST", eth.NewMockWrappedPocketContract(t))
+		p.state = &models.PauseState{Paused: true}
+		p.lastBlock = 100
+
+		assert.True(t, p.Update(100))
+		assert.True(t, p.Paused())
+	})
+
+	t.Run("Error filtering keeps the state", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		p := NewPauseTracker("TEST", mockContract)
+		p.state = &models.PauseState{Paused: true}
+		p.lastBlock = 100
+
+		mockContract.EXPECT().FilterPaused(mock.Anything).Return(nil, errors.New("error")).Once()
+
+		assert.False(t, p.Update(120))
+		assert.True(t, p.Paused())
+		assert.Equal(t, int64(100), p.lastBlock)
+	})
+}
@@ -31,8 +31,12 @@ const (
 )
 
 type MintSignerRunner struct {
+	app.Traced
+
 	address                string
+	validatorId            string
 	privateKey             *ecdsa.PrivateKey
+	keyGeneration          int64
 	vaultAddress           string
 	wpoktAddress           string
 	wpoktContract          eth.WrappedPocketContract
@@ -41,21 +45,62 @@ type MintSignerRunner struct {
 	domain                 eth.DomainData
 	poktClient             pokt.PocketClient
 	ethClient              eth.EthereumClient
+	pause                  *PauseTracker
+	ethBlockNumber         int64
+	lastDomainBlock        int64
 	poktHeight             int64
-	minimumAmount          *big.Int
+	txFee                  *big.Int
 	maximumAmount          *big.Int
+	failed                 bool
 }
 
 func (x *MintSignerRunner) Run() {
+	x.UpdatePrivateKey()
 	x.UpdateBlocks()
 	x.UpdateValidatorCount()
 	x.UpdateMaxMintLimit()
-	x.SyncTxs()
+	x.UpdateEthBlockNumber()
+	if !x.UpdatePaused() {
+		log.Error("[MINT SIGNER] Not signing until the paused state of the Wrapped Pocket contract is known")
+		x.failed = true
+		return
+	}
+	if x.pause.Paused() {
+		log.Info("[MINT SIGNER] Not signing while the Wrapped Pocket contract is paused")
+		x.failed = false
+		return
+	}
+	if inSync, err := x.ValidatorSetInSync(); err != nil {
+		log.Error("[MINT SIGNER] Not signing until the validator set is checked: ", err)
+		x.failed = true
+		return
+	} else if !inSync {
+		log.Warn("[MINT SIGNER] Not signing while the validator set does not match the config")
+		x.failed = false
+		return
+	}
+	x.txFee = pokt.UpdateTxFee(x.poktClient, MintSignerName, x.txFee)
+	if x.txFee == nil {
+		x.failed = true
+		return
+	}
+	if !x.UpdateDomain() {
+		log.Error("[MINT SIGNER] Not signing until the EIP-712 domain is up to date")
+		x.failed = true
+		return
+	}
+	if app.SigningHalted(x.DB(), MintSignerName) {
+		x.failed = false
+		return
+	}
+	x.failed = !x.SyncTxs()
 }
 
 func (x *MintSignerRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight: strconv.FormatInt(x.poktHeight, 10),
+		Failed:     x.failed,
+		Pause:      x.pause.State(),
 	}
 }
 
@@ -84,7 +129,7 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 
 	if nonce == nil || nonce.Cmp(big.NewInt(0)) == 0 {
 		log.Debug("[MINT SIGNER] Mint nonce not set, fetching from contract")
-		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 		defer cancel()
 		opts := &bind.CallOpts{Context: ctx, Pending: false}
 		currentNonce, err := x.wpoktContract.GetUserNonce(opts, common.HexToAddress(mint.RecipientAddress))
@@ -100,9 +145,9 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 			"vault_address":     x.vaultAddress,
 			"wpokt_address":     x.wpoktAddress,
 			"recipient_address": strings.ToLower(mint.RecipientAddress),
-			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
+			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval, models.StatusQuarantined, models.StatusSigned}},
 		}
-		err = app.DB.FindMany(models.CollectionMints, filter, &pendingMints)
+		err = x.DB().FindMany(models.CollectionMints, filter, &pendingMints)
 		if err != nil {
 			log.Error("[MINT SIGNER] Error fetching pending mints: ", err)
 			return nil, err
@@ -141,7 +186,9 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 }
 
 func (x *MintSignerRunner) ValidateMint(mint *models.Mint) (bool, error) {
-	log.Debug("[MINT SIGNER] Validating mint: ", mint.TransactionHash)
+	logger := app.TransferLogger(models.CollectionMints, mint.Id, mint.TransactionHash)
+
+	logger.Debug("[MINT SIGNER] Validating mint: ", mint.TransactionHash)
 
 	tx, err := x.poktClient.GetTx(mint.TransactionHash)
 	if err != nil {
@@ -149,94 +196,106 @@ func (x *MintSignerRunner) ValidateMint(mint *models.Mint) (bool, error) {
 	}
 
 	if tx.Tx == "" || tx.TxResult.Code != 0 {
-		log.Debug("[MINT SIGNER] Transaction not found or failed")
+		logger.Debug("[MINT SIGNER] Transaction not found or failed")
 		return false, nil
 	}
 
 	if tx.TxResult.MessageType != "send" || tx.StdTx.Msg.Type != "pos/Send" {
-		log.Debug("[MINT SIGNER] Transaction message type is not send")
+		logger.Debug("[MINT SIGNER] Transaction message type is not send")
 		return false, nil
 	}
 
 	if strings.EqualFold(tx.StdTx.Msg.Value.ToAddress, "0000000000000000000000000000000000000000") {
-		log.Debug("[MINT SIGNER] Transaction recipient is zero address")
+		logger.Debug("[MINT SIGNER] Transaction recipient is zero address")
 		return false, nil
 	}
 
 	if !strings.EqualFold(tx.StdTx.Msg.Value.ToAddress, x.vaultAddress) {
-		log.Debug("[MINT SIGNER] Transaction recipient is not vault address")
+		logger.Debug("[MINT SIGNER] Transaction recipient is not vault address")
 		return false, nil
 	}
 
 	if !strings.EqualFold(tx.StdTx.Msg.Value.FromAddress, mint.SenderAddress) {
-		log.Debug("[MINT SIGNER] Transaction signer is not sender address")
+		logger.Debug("[MINT SIGNER] Transaction signer is not sender address")
 		return false, nil
 	}
 
 	amount, ok := new(big.Int).SetString(tx.StdTx.Msg.Value.Amount, 10)
 
-	if !ok || amount.Cmp(x.minimumAmount) != 1 {
-		log.Debug("[MINT SIGNER] Transaction amount too low")
+	if !ok || amount.Cmp(x.txFee) != 1 {
+		logger.Debug("[MINT SIGNER] Transaction amount too low")
 		return false, nil
 	}
 
 	if !ok || amount.Cmp(x.maximumAmount) == 1 {
-		log.Debug("[MINT SIGNER] Transaction amount too high")
+		logger.Debug("[MINT SIGNER] Transaction amount too high")
 		return false, nil
 	}
 
 	if tx.StdTx.Msg.Value.Amount != mint.Amount {
-		log.Debug("[MINT SIGNER] Transaction amount does not match mint amount")
+		logger.Debug("[MINT SIGNER] Transaction amount does not match mint amount")
 		return false, nil
 	}
 
 	memo, valid := poktUtil.ValidateMemo(tx.StdTx.Memo)
 	if !valid {
-		log.Debug("[MINT SIGNER] Memo failed validation")
+		logger.Debug("[MINT SIGNER] Memo failed validation")
 		return false, nil
 	}
 
 	if !strings.EqualFold(memo.Address, mint.RecipientAddress) {
-		log.Debug("[MINT SIGNER] Memo address does not match recipient address")
+		logger.Debug("[MINT SIGNER] Memo address does not match recipient address")
 		return false, nil
 	}
 
 	if memo.ChainId != mint.RecipientChainId {
-		log.Debug("[MINT SIGNER] Memo chain id does not match recipient chain id")
+		logger.Debug("[MINT SIGNER] Memo chain id does not match recipient chain id")
 		return false, nil
 	}
 
-	log.Debug("[MINT SIGNER] Mint validated")
+	if err := app.ScreenAddresses(
+		app.ScreenedAddress{Role: "sender", Address: mint.SenderAddress},
+		app.ScreenedAddress{Role: "recipient", Address: mint.RecipientAddress},
+	); err != nil {
+		return false, err
+	}
+
+	logger.Debug("[MINT SIGNER] Mint validated")
 	return true, nil
 }
 
-func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
+func (x *MintSignerRunner) HandleMint(mint *models.Mint, lockToken int64) bool {
 	if mint == nil {
 		log.Error("[MINT EXECUTOR] Invalid mint")
 		return false
 	}
 
-	log.Debug("[MINT SIGNER] Handling mint: ", mint.TransactionHash)
+	logger := app.TransferLogger(models.CollectionMints, mint.Id, mint.TransactionHash)
+	statusFrom := mint.Status
+	defer x.StartSpan("MINT SIGNER HandleMint", app.TransferAttributes(models.CollectionMints, mint.Id, mint.TransactionHash)...)()
+
+	logger.Debug("[MINT SIGNER] Handling mint: ", mint.TransactionHash)
 
 	address := common.HexToAddress(mint.RecipientAddress)
 	amount, ok := new(big.Int).SetString(mint.Amount, 10)
 	if !ok {
-		log.Error("[MINT SIGNER] Error converting decimal to big int")
+		logger.Error("[MINT SIGNER] Error converting decimal to big int")
 		return false
 	}
 
 	nonce, err := x.FindNonce(mint)
 
 	if err != nil {
-		log.Error("[MINT SIGNER] Error fetching nonce: ", err)
+		logger.Error("[MINT SIGNER] Error fetching nonce: ", err)
+		app.RecordFailure(x.validatorId, models.CollectionMints, mint.Id, mint.Status, mint.Attempts, lockToken, err)
 		return false
 	}
 
 	if nonce == nil || nonce.Cmp(big.NewInt(0)) == 0 {
-		log.Error("[MINT SIGNER] Error fetching nonce")
+		logger.Error("[MINT SIGNER] Error fetching nonce")
 		return false
 	}
-	log.Debug("[MINT SIGNER] Found Nonce: ", nonce)
+	logger.Debug("[MINT SIGNER] Found Nonce: ", nonce)
 
 	data := &autogen.MintControllerMintData{
 		Recipient: address,
@@ -244,22 +303,38 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 		Nonce:     nonce,
 	}
 
+	if mint.Status == models.StatusHeld || mint.Status == models.StatusAwaitingApproval {
+		// held mints and mints awaiting approval were confirmed, check them again
+		mint.Status = models.StatusConfirmed
+	}
+
 	mint, err = util.UpdateStatusAndConfirmationsForMint(mint, x.poktHeight)
 	if err != nil {
-		log.Error("[MINT SIGNER] Error updating status and confirmations for mint: ", err)
+		logger.Error("[MINT SIGNER] Error updating status and confirmations for mint: ", err)
 		return false
 	}
 
 	var update bson.M
 
 	valid, err := x.ValidateMint(mint)
-	if err != nil {
-		log.Error("[MINT SIGNER] Error validating mint: ", err)
+	var quarantine *app.QuarantineError
+	if errors.As(err, &quarantine) {
+		logger.Warn("[MINT SIGNER] Quarantining mint: ", quarantine.Reason)
+		app.AlertQuarantined(MintSignerName, models.CollectionMints, mint.Id, mint.TransactionHash, quarantine.Reason)
+		update = bson.M{
+			"$set": bson.M{
+				"status":            models.StatusQuarantined,
+				"quarantine_reason": quarantine.Reason,
+				"updated_at":        time.Now(),
+			},
+		}
+	} else if err != nil {
+		logger.Error("[MINT SIGNER] Error validating mint: ", err)
+		app.RecordFailure(x.validatorId, models.CollectionMints, mint.Id, mint.Status, mint.Attempts, lockToken, err)
 		return false
-	}
-
-	if !valid {
-		log.Error("[MINT SIGNER] Mint failed validation")
+	} else if !valid {
+		logger.Error("[MINT SIGNER] Mint failed validation")
+		app.AlertValidationFailed(MintSignerName, models.CollectionMints, mint.Id, mint.TransactionHash)
 		update = bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
@@ -268,12 +343,66 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 		}
 	} else {
 
-		if mint.Status == models.StatusConfirmed {
-			log.Debug("[MINT SIGNER] Mint confirmed, signing")
+		awaitingApproval := false
+		if mint.Status == models.StatusConfirmed && app.RequiresApproval(models.CollectionMints, amount) {
+			message := app.ApprovalMessage(models.CollectionMints, mint.Id.Hex(), mint.TransactionHash, mint.RecipientAddress, mint.Amount)
+			awaitingApproval = !app.Approved(message, mint.Approvals)
+		}
+
+		var holdReason string
+		if mint.Status == models.StatusConfirmed && !awaitingApproval {
+			holdReason, err = app.HoldReason(x.DB(), models.CollectionMints,
+				bson.M{"wpokt_address": x.wpoktAddress, "vault_address": x.vaultAddress},
+				app.VolumeTransfer{Id: mint.Id, Sender: mint.SenderAddress, Recipient: mint.RecipientAddress, Amount: amount},
+				app.LiveConfig().VolumeLimits.Mints,
+			)
+			if err != nil {
+				logger.Error("[MINT SIGNER] Error checking volume limits: ", err)
+				return false
+			}
+		}
+
+		if awaitingApproval {
+			logger.Info("[MINT SIGNER] Mint awaiting approval")
+			if statusFrom != models.StatusAwaitingApproval {
+				app.AlertAwaitingApproval(MintSignerName, models.CollectionMints, mint.Id, mint.TransactionHash, mint.Amount)
+			}
+			update = bson.M{
+				"$set": bson.M{
+					"status":        models.StatusAwaitingApproval,
+					"confirmations": mint.Confirmations,
+					"updated_at":    time.Now(),
+				},
+			}
+
+		} else if holdReason != "" {
+			logger.Warn("[MINT SIGNER] Holding mint: ", holdReason)
+			update = bson.M{
+				"$set": bson.M{
+					"status":        models.StatusHeld,
+					"held_reason":   holdReason,
+					"confirmations": mint.Confirmations,
+					"updated_at":    time.Now(),
+				},
+			}
+
+		} else if mint.Status == models.StatusConfirmed {
+			logger.Debug("[MINT SIGNER] Mint confirmed, signing")
+
+			domainSeparator, err := util.DomainSeparator(x.domain)
+			if err != nil {
+				logger.Error("[MINT SIGNER] Error signing mint: ", err)
+				return false
+			}
+			if mint.DomainSeparator != "" && mint.DomainSeparator != domainSeparator {
+				logger.Info("[MINT SIGNER] Dropping signatures made under another EIP-712 domain")
+				mint.Signatures = nil
+				mint.Signers = nil
+			}
 
 			mint, err := util.SignMint(mint, data, x.domain, x.privateKey, int(x.numSigners))
 			if err != nil {
-				log.Error("[MINT SIGNER] Error signing mint: ", err)
+				logger.Error("[MINT SIGNER] Error signing mint: ", err)
 				return false
 			}
 
@@ -284,17 +413,25 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 						Amount:    data.Amount.String(),
 						Nonce:     data.Nonce.String(),
 					},
-					"nonce":         data.Nonce.String(),
-					"signatures":    mint.Signatures,
-					"signers":       mint.Signers,
-					"status":        mint.Status,
-					"confirmations": mint.Confirmations,
-					"updated_at":    time.Now(),
+					"nonce":            data.Nonce.String(),
+					"signatures":       mint.Signatures,
+					"signers":          mint.Signers,
+					"domain_separator": domainSeparator,
+					"status":           mint.Status,
+					"confirmations":    mint.Confirmations,
+					"updated_at":       time.Now(),
 				},
 			}
+			if mint.CountedAt.IsZero() {
+				update["$set"].(bson.M)["counted_at"] = time.Now()
+			}
+			if statusFrom == models.StatusHeld {
+				logger.Info("[MINT SIGNER] Releasing held mint")
+				update["$set"].(bson.M)["held_reason"] = ""
+			}
 
 		} else {
-			log.Debug("[MINT SIGNER] Mint pending confirmation, not signing")
+			logger.Debug("[MINT SIGNER] Mint pending confirmation, not signing")
 			update = bson.M{
 				"$set": bson.M{
 					"status":        mint.Status,
@@ -306,17 +443,20 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 
 	}
 
+	update["$set"].(bson.M)["lock_token"] = lockToken
+
 	filter := bson.M{
-		"_id":    mint.Id,
-		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"_id":        mint.Id,
+		"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+		"lock_token": app.LockTokenFilter(lockToken),
 	}
 
-	err = app.DB.UpdateOne(models.CollectionMints, filter, update)
+	err = x.DB().UpdateOne(models.CollectionMints, filter, update)
 	if err != nil {
-		log.Error("[MINT SIGNER] Error updating mint: ", err)
+		logger.Error("[MINT SIGNER] Error updating mint: ", err)
 		return false
 	}
-	log.Info("[MINT SIGNER] Handled mint: ", mint.TransactionHash)
+	logger.WithFields(app.StatusFields(statusFrom, update)).Info("[MINT SIGNER] Handled mint: ", mint.TransactionHash)
 
 	return true
 }
@@ -327,15 +467,16 @@ func (x *MintSignerRunner) SyncTxs() bool {
 	filter := bson.M{
 		"wpokt_address": x.wpoktAddress,
 		"vault_address": x.vaultAddress,
-		"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 		"signers": bson.M{
 			"$nin": []string{x.address},
 		},
+		"$expr": app.RetryDueFilter(x.validatorId),
 	}
 
 	var mints []models.Mint
 
-	err := app.DB.FindMany(models.CollectionMints, filter, &mints)
+	err := x.DB().FindMany(models.CollectionMints, filter, &mints)
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching pending mints: ", err)
 		return false
@@ -346,7 +487,7 @@ func (x *MintSignerRunner) SyncTxs() bool {
 		mint := mints[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(mint.RecipientAddress))
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[MINT SIGNER] Error locking mint: ", err)
 			success = false
@@ -354,9 +495,9 @@ func (x *MintSignerRunner) SyncTxs() bool {
 		}
 		log.Debug("[MINT SIGNER] Locked mint: ", mint.TransactionHash)
 
-		success = x.HandleMint(&mint) && success
+		success = x.HandleMint(&mint, lockToken) && success
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = x.DB().Unlock(lockId); err != nil {
 			log.Error("[MINT SIGNER] Error unlocking mint: ", err)
 			success = false
 		} else {
@@ -371,7 +512,7 @@ func (x *MintSignerRunner) SyncTxs() bool {
 
 func (x *MintSignerRunner) UpdateValidatorCount() {
 	log.Debug("[MINT SIGNER] Fetching mint controller validator count")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	count, err := x.mintControllerContract.ValidatorCount(opts)
@@ -384,24 +525,163 @@ func (x *MintSignerRunner) UpdateValidatorCount() {
 	x.numSigners = count.Int64()
 }
 
-func (x *MintSignerRunner) UpdateDomainData() {
+func (x *MintSignerRunner) UpdateDomainData() bool {
 	log.Debug("[MINT SIGNER] Fetching mint controller domain data")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	domain, err := x.mintControllerContract.Eip712Domain(opts)
 
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching mint controller domain data: ", err)
-		return
+		return false
 	}
 	log.Debug("[MINT SIGNER] Fetched mint controller domain data")
 	x.domain = domain
+	return true
+}
+
+// ValidateDomain checks that the domain data is of the configured chain and mint controller
+func ValidateDomain(domain eth.DomainData) error {
+	chainId, ok := new(big.Int).SetString(app.Config.Ethereum.ChainId, 10)
+	if !ok || domain.ChainId == nil || domain.ChainId.Cmp(chainId) != 0 {
+		return errors.New("invalid chain ID")
+	}
+	if !strings.EqualFold(domain.VerifyingContract.Hex(), app.Config.Ethereum.MintControllerAddress) {
+		return errors.New("invalid mint controller address in domain data")
+	}
+	return nil
+}
+
+// UpdateDomain follows the EIP712DomainChanged events of the mint controller. A changed domain
+// is fetched and validated again, and the mints signed under the old domain are reset so that
+// every validator signs them again. It returns false while the domain may be out of date.
+func (x *MintSignerRunner) UpdateDomain() bool {
+	if x.lastDomainBlock == 0 {
+		log.Error("[MINT SIGNER] Mint controller domain data was not read at a known block")
+		return false
+	}
+	if x.ethBlockNumber <= x.lastDomainBlock {
+		return true
+	}
+
+	changed := false
+	for start := x.lastDomainBlock + 1; start <= x.ethBlockNumber && !changed; start += eth.MAX_QUERY_BLOCKS {
+		end := start + eth.MAX_QUERY_BLOCKS - 1
+		if end > x.ethBlockNumber {
+			end = x.ethBlockNumber
+		}
+		found, err := x.domainChanged(uint64(start), uint64(end))
+		if err != nil {
+			log.Error("[MINT SIGNER] Error fetching mint controller domain events: ", err)
+			return false
+		}
+		changed = found
+	}
+
+	if changed {
+		log.Warn("[MINT SIGNER] Mint controller EIP-712 domain changed")
+		if !x.UpdateDomainData() {
+			return false
+		}
+		if err := ValidateDomain(x.domain); err != nil {
+			log.Error("[MINT SIGNER] Invalid mint controller domain data: ", err)
+			return false
+		}
+		domainSeparator, err := util.DomainSeparator(x.domain)
+		if err != nil {
+			log.Error("[MINT SIGNER] Error hashing mint controller domain data: ", err)
+			return false
+		}
+		if !x.ResignMints(bson.M{"$ne": domainSeparator}) {
+			return false
+		}
+	}
+
+	x.lastDomainBlock = x.ethBlockNumber
+	return true
+}
+
+func (x *MintSignerRunner) domainChanged(start uint64, end uint64) (bool, error) {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: context.Background()}
+	filter, err := x.mintControllerContract.FilterEIP712DomainChanged(opts)
+	if filter != nil {
+		defer filter.Close()
+	}
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	for filter.Next() {
+		if event := filter.Event(); event != nil && !event.Raw.Removed {
+			changed = true
+		}
+	}
+	return changed, filter.Error()
+}
+
+// ResignMints resets the signatures of the confirmed and signed mints whose domain separator
+// matches the filter. Signed mints have not been minted yet, so they go back to confirmed.
+func (x *MintSignerRunner) ResignMints(domainSeparator bson.M) bool {
+	filter := bson.M{
+		"wpokt_address":    x.wpoktAddress,
+		"vault_address":    x.vaultAddress,
+		"status":           bson.M{"$in": []string{models.StatusConfirmed, models.StatusSigned}},
+		"signers.0":        bson.M{"$exists": true},
+		"domain_separator": domainSeparator,
+	}
+
+	var mints []models.Mint
+	if err := x.DB().FindMany(models.CollectionMints, filter, &mints); err != nil {
+		log.Error("[MINT SIGNER] Error fetching mints signed under another domain: ", err)
+		return false
+	}
+
+	success := true
+	for _, mint := range mints {
+		resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(mint.RecipientAddress))
+		lockId, lockToken, err := x.DB().XLock(resourceId)
+		if err != nil {
+			log.Error("[MINT SIGNER] Error locking mint: ", err)
+			success = false
+			continue
+		}
+
+		err = x.DB().UpdateOne(models.CollectionMints, bson.M{
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusConfirmed, models.StatusSigned}},
+			"signatures": mint.Signatures,
+			"lock_token": app.LockTokenFilter(lockToken),
+		}, bson.M{
+			"$set": bson.M{
+				"status":           models.StatusConfirmed,
+				"signatures":       []string{},
+				"signers":          []string{},
+				"domain_separator": "",
+				"lock_token":       lockToken,
+				"updated_at":       time.Now(),
+			},
+		})
+		if errors.Is(err, app.ErrNotMatched) {
+			log.WithField("tx_hash", mint.TransactionHash).Debug("[MINT SIGNER] Mint changed before its signatures were reset")
+		} else if err != nil {
+			log.Error("[MINT SIGNER] Error resetting signatures of mint: ", err)
+			success = false
+		} else {
+			log.WithField("tx_hash", mint.TransactionHash).Info("[MINT SIGNER] Reset signatures of mint for signing under the new domain")
+		}
+
+		if err = x.DB().Unlock(lockId); err != nil {
+			log.Error("[MINT SIGNER] Error unlocking mint: ", err)
+			success = false
+		}
+	}
+	return success
 }
 
 func (x *MintSignerRunner) UpdateMaxMintLimit() {
 	log.Debug("[MINT SIGNER] Fetching mint controller max mint limit")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	mintLimit, err := x.mintControllerContract.MaxMintLimit(opts)
@@ -414,6 +694,77 @@ func (x *MintSignerRunner) UpdateMaxMintLimit() {
 	x.maximumAmount = mintLimit
 }
 
+// ValidatorSetInSync reads the last report of the validator set monitor for the signer. It fails
+// closed while the monitor is enabled, so a signer without a report does not sign.
+func (x *MintSignerRunner) ValidatorSetInSync() (bool, error) {
+	if !app.Config.ValidatorSetMonitor.Enabled {
+		return true, nil
+	}
+	report, err := LastValidatorSet(x.DB(), x.address)
+	if err != nil {
+		return false, err
+	}
+	if report == nil {
+		return false, errors.New("no validator set report for " + x.address)
+	}
+	if !report.InSync {
+		log.WithField("drift", report.Drift).Warn("[MINT SIGNER] Validator set does not match the config")
+	}
+	return report.InSync, nil
+}
+
+// ValidateMaxMintLimit reads the tx fee at startup and checks that the max mint limit is above it
+func (x *MintSignerRunner) ValidateMaxMintLimit() {
+	x.txFee = pokt.UpdateTxFee(x.poktClient, MintSignerName, nil)
+	if x.txFee == nil {
+		log.Fatal("[MINT SIGNER] Invalid tx fee")
+	}
+	if x.maximumAmount == nil || x.maximumAmount.Cmp(x.txFee) != 1 {
+		log.Fatal("[MINT SIGNER] Invalid max mint limit")
+	}
+}
+
+func (x *MintSignerRunner) UpdateEthBlockNumber() {
+	blockNumber, err := x.ethClient.GetBlockNumber()
+	if err != nil {
+		log.Error("[MINT SIGNER] Error fetching eth block number: ", err)
+		return
+	}
+	x.ethBlockNumber = int64(blockNumber)
+}
+
+// UpdatePaused follows the paused state of the wpokt contract. Signing resumes on its own once
+// the contract is unpaused, unless circuit_breaker.paused also tripped the signing breaker.
+// It returns false when the state could not be brought up to date, so that nothing is signed
+// for a contract that may have been paused since.
+func (x *MintSignerRunner) UpdatePaused() bool {
+	if x.ethBlockNumber == 0 || !x.pause.Update(x.ethBlockNumber) || x.pause.State() == nil {
+		return false
+	}
+	if x.pause.Paused() {
+		app.TripBreaker(models.BreakerTriggerPaused, "wrapped pocket contract "+x.wpoktAddress+" is paused", app.ValidatorOperator())
+	}
+	return true
+}
+
+// UpdatePrivateKey loads the private key again after it was rotated
+func (x *MintSignerRunner) UpdatePrivateKey() {
+	generation := app.SecretsGeneration()
+	if generation == x.keyGeneration {
+		return
+	}
+
+	privateKey, err := crypto.HexToECDSA(app.LiveConfig().Ethereum.PrivateKey)
+	if err != nil {
+		log.Error("[MINT SIGNER] Error loading rotated private key: ", err)
+		return
+	}
+	x.privateKey = privateKey
+	x.address = strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	x.keyGeneration = generation
+	log.Info("[MINT SIGNER] Loaded rotated private key for ETH signer address: ", x.address)
+}
+
 func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Service {
 	if !app.Config.MintSigner.Enabled {
 		log.Debug("[MINT SIGNER] Disabled")
@@ -429,16 +780,33 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
 	log.Info("[MINT SIGNER] ETH signer address: ", address)
 
-	ethClient, err := eth.NewClient()
+	validatorId, err := app.ValidatorId()
+	if err != nil {
+		log.Fatal("[MINT SIGNER] Error naming validator: ", err)
+	}
+
+	x := &MintSignerRunner{
+		validatorId:   validatorId,
+		privateKey:    privateKey,
+		address:       strings.ToLower(address),
+		keyGeneration: app.SecretsGeneration(),
+		wpoktAddress:  strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
+		vaultAddress:  strings.ToLower(app.Config.Pocket.VaultAddress),
+	}
+	x.poktClient = pokt.NewClient(x)
+
+	ethClient, err := eth.NewClient(x)
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing ethereum client: ", err)
 	}
+	x.ethClient = ethClient
 
 	log.Debug("[MINT SIGNER] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
 	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing Wrapped Pocket contract", err)
 	}
+	x.wpoktContract = eth.NewWrappedPocketContract(contract, x)
 	log.Debug("[MINT SIGNER] Connected to wpokt contract")
 
 	log.Debug("[MINT SIGNER] Connecting to mint controller contract at: ", app.Config.Ethereum.MintControllerAddress)
@@ -446,19 +814,10 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing Mint Controller contract", err)
 	}
+	x.mintControllerContract = eth.NewMintControllerContract(mintControllerContract, x)
 	log.Debug("[MINT SIGNER] Connected to mint controller contract")
 
-	x := &MintSignerRunner{
-		privateKey:             privateKey,
-		address:                strings.ToLower(address),
-		wpoktAddress:           strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
-		vaultAddress:           strings.ToLower(app.Config.Pocket.VaultAddress),
-		wpoktContract:          eth.NewWrappedPocketContract(contract),
-		mintControllerContract: eth.NewMintControllerContract(mintControllerContract),
-		ethClient:              ethClient,
-		poktClient:             pokt.NewClient(),
-		minimumAmount:          big.NewInt(app.Config.Pocket.TxFee),
-	}
+	x.pause = NewPauseTracker(MintSignerName, x.wpoktContract)
 
 	x.UpdateBlocks()
 
@@ -472,25 +831,31 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 		log.Fatal("[MINT SIGNER] Invalid validator count")
 	}
 
-	x.UpdateDomainData()
+	// the domain is read after the block number, so that a change in a later block is followed
+	x.UpdateEthBlockNumber()
 
-	chainId, ok := new(big.Int).SetString(app.Config.Ethereum.ChainId, 10)
+	if x.ethBlockNumber == 0 {
+		log.Fatal("[MINT SIGNER] Invalid eth block number")
+	}
+
+	x.UpdateDomainData()
+	x.lastDomainBlock = x.ethBlockNumber
 
-	if !ok || x.domain.ChainId.Cmp(chainId) != 0 {
-		log.Fatal("[MINT SIGNER] Invalid chain ID")
+	if err := ValidateDomain(x.domain); err != nil {
+		log.Fatal("[MINT SIGNER] Invalid mint controller domain data: ", err)
 	}
 
-	if !strings.EqualFold(x.domain.VerifyingContract.Hex(), app.Config.Ethereum.MintControllerAddress) {
-		log.Fatal("[MINT SIGNER] Invalid mint controller address in domain data")
+	// mints signed under a domain that changed while the signer was down are signed again
+	domainSeparator, err := util.DomainSeparator(x.domain)
+	if err != nil {
+		log.Fatal("[MINT SIGNER] Error hashing mint controller domain data: ", err)
 	}
+	x.ResignMints(bson.M{"$exists": true, "$ne": domainSeparator})
 
 	x.UpdateMaxMintLimit()
-
-	if x.maximumAmount == nil || x.maximumAmount.Cmp(x.minimumAmount) != 1 {
-		log.Fatal("[MINT SIGNER] Invalid max mint limit")
-	}
+	x.ValidateMaxMintLimit()
 
 	log.Info("[MINT SIGNER] Initialized mint signer")
 
-	return app.NewRunnerService(MintSignerName, x, wg, time.Duration(app.Config.MintSigner.IntervalMillis)*time.Millisecond)
+	return app.NewRunnerService(MintSignerName, x, wg, app.Config.MintSigner.IntervalMillis.Duration())
 }
@@ -5,20 +5,28 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/eth/util"
 	"github.com/dan13ram/wpokt-validator/models"
 	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -35,6 +43,7 @@ func NewTestMintSigner(t *testing.T, mockWrappedPocketContract *eth.MockWrappedP
 
 	x := &MintSignerRunner{
 		address:      strings.ToLower(address),
+		validatorId:  "wpokt-validator-01",
 		privateKey:   pk,
 		vaultAddress: "vaultAddress",
 		wpoktAddress: "wpoktAddress",
@@ -50,12 +59,19 @@ func NewTestMintSigner(t *testing.T, mockWrappedPocketContract *eth.MockWrappedP
 		ethClient:              mockEthClient,
 		poktClient:             mockPoktClient,
 		poktHeight:             100,
-		minimumAmount:          big.NewInt(10000),
+		txFee:                  big.NewInt(10000),
 		maximumAmount:          big.NewInt(1000000),
+		lastDomainBlock:        300,
+		pause:                  NewPauseTracker(MintSignerName, mockWrappedPocketContract),
 	}
 	return x
 }
 
+func domainSeparatorOf(domain eth.DomainData) string {
+	domainSeparator, _ := util.DomainSeparator(domain)
+	return domainSeparator
+}
+
 func TestMintSignerStatus(t *testing.T) {
 	mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
 	mockMintControllerContract := eth.NewMockMintControllerContract(t)
@@ -68,6 +84,29 @@ func TestMintSignerStatus(t *testing.T) {
 	assert.Equal(t, status.PoktHeight, "100")
 }
 
+func TestMintSignerUpdatePrivateKey(t *testing.T) {
+	t.Run("Key not rotated", func(t *testing.T) {
+		x := NewTestMintSigner(t, nil, nil, nil, nil)
+		address := x.address
+
+		x.UpdatePrivateKey()
+
+		assert.Equal(t, address, x.address)
+	})
+
+	t.Run("Key rotated", func(t *testing.T) {
+		app.Config.Ethereum.PrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+		defer func() { app.Config.Ethereum.PrivateKey = "" }()
+		x := NewTestMintSigner(t, nil, nil, nil, nil)
+		x.keyGeneration = -1
+
+		x.UpdatePrivateKey()
+
+		assert.Equal(t, "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", x.address)
+		assert.Equal(t, app.SecretsGeneration(), x.keyGeneration)
+	})
+}
+
 func TestMintSignerUpdateBlocks(t *testing.T) {
 
 	t.Run("No Error", func(t *testing.T) {
@@ -212,6 +251,53 @@ func TestMintSignerUpdateMaxMintLimit(t *testing.T) {
 
 }
 
+func TestMintSignerValidateMaxMintLimit(t *testing.T) {
+
+	t.Run("Error fetching tx fee", func(t *testing.T) {
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		x := NewTestMintSigner(t, nil, nil, nil, mockPoktClient)
+		x.txFee = nil
+
+		mockPoktClient.EXPECT().GetParam(pokt.FeeMultipliersParamKey).Return(nil, errors.New("error"))
+
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+		assert.Panics(t, func() {
+			x.ValidateMaxMintLimit()
+		})
+	})
+
+	t.Run("Max mint limit below tx fee", func(t *testing.T) {
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		x := NewTestMintSigner(t, nil, nil, nil, mockPoktClient)
+		x.txFee = nil
+		x.maximumAmount = big.NewInt(10000)
+
+		expectTxFee(mockPoktClient)
+
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+		assert.Panics(t, func() {
+			x.ValidateMaxMintLimit()
+		})
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		x := NewTestMintSigner(t, nil, nil, nil, mockPoktClient)
+		x.txFee = nil
+		x.maximumAmount = big.NewInt(1000000)
+
+		expectTxFee(mockPoktClient)
+
+		x.ValidateMaxMintLimit()
+
+		assert.Equal(t, big.NewInt(10000), x.txFee)
+	})
+}
+
 func TestMintSignerFindNonce(t *testing.T) {
 
 	t.Run("Nonce already set", func(t *testing.T) {
@@ -256,7 +342,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 			"vault_address":     x.vaultAddress,
 			"wpokt_address":     x.wpoktAddress,
 			"recipient_address": strings.ToLower(mint.RecipientAddress),
-			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
+			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval, models.StatusQuarantined, models.StatusSigned}},
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).
@@ -293,7 +379,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 			"vault_address":     x.vaultAddress,
 			"wpokt_address":     x.wpoktAddress,
 			"recipient_address": strings.ToLower(mint.RecipientAddress),
-			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
+			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval, models.StatusQuarantined, models.StatusSigned}},
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).
@@ -887,7 +973,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
 
-		success := x.HandleMint(nil)
+		success := x.HandleMint(nil, 0)
 
 		assert.False(t, success)
 	})
@@ -910,7 +996,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 			RecipientChainId: "31337",
 		}
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.False(t, success)
 	})
@@ -934,7 +1020,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 			RecipientChainId: "31337",
 		}
 
-		success := x.HandleMint(mint)
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleMint(mint, 0)
 
 		assert.False(t, success)
 	})
@@ -962,7 +1050,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 			Confirmations:    "invalid",
 		}
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.False(t, success)
 	})
@@ -992,7 +1080,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 
 		mockPoktClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
 
-		success := x.HandleMint(mint)
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
@@ -1047,13 +1137,15 @@ func TestMintSignerHandleMint(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1063,7 +1155,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Return(nil)
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
@@ -1118,14 +1210,16 @@ func TestMintSignerHandleMint(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
 				"status":        models.StatusPending,
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 			},
 		}
 
@@ -1135,7 +1229,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Return(nil)
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusPending, mint.Status)
 		assert.Equal(t, mint.Confirmations, "1")
@@ -1193,7 +1287,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
@@ -1248,8 +1342,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
@@ -1258,23 +1353,27 @@ func TestMintSignerHandleMint(t *testing.T) {
 					Amount:    mint.Amount,
 					Nonce:     mint.Nonce,
 				},
-				"nonce":         mint.Nonce,
-				"signatures":    mint.Signatures,
-				"signers":       []string{x.address},
-				"status":        models.StatusConfirmed,
-				"confirmations": "0",
-				"updated_at":    time.Now(),
+				"nonce":            mint.Nonce,
+				"signatures":       mint.Signatures,
+				"domain_separator": domainSeparatorOf(x.domain),
+				"counted_at":       time.Now(),
+				"signers":          []string{x.address},
+				"status":           models.StatusConfirmed,
+				"confirmations":    "0",
+				"updated_at":       time.Now(),
+				"lock_token":       int64(0),
 			},
 		}
 
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(errors.New("error"))
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
@@ -1329,8 +1428,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
@@ -1339,23 +1439,27 @@ func TestMintSignerHandleMint(t *testing.T) {
 					Amount:    mint.Amount,
 					Nonce:     mint.Nonce,
 				},
-				"nonce":         mint.Nonce,
-				"signatures":    mint.Signatures,
-				"signers":       []string{x.address},
-				"status":        models.StatusConfirmed,
-				"confirmations": "0",
-				"updated_at":    time.Now(),
+				"nonce":            mint.Nonce,
+				"signatures":       mint.Signatures,
+				"domain_separator": domainSeparatorOf(x.domain),
+				"counted_at":       time.Now(),
+				"signers":          []string{x.address},
+				"status":           models.StatusConfirmed,
+				"confirmations":    "0",
+				"updated_at":       time.Now(),
+				"lock_token":       int64(0),
 			},
 		}
 
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(nil)
 
-		success := x.HandleMint(mint)
+		success := x.HandleMint(mint, 0)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
@@ -1363,6 +1467,260 @@ func TestMintSignerHandleMint(t *testing.T) {
 		assert.True(t, success)
 	})
 
+	t.Run("Held over volume limit", func(t *testing.T) {
+		mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := eth.NewMockMintControllerContract(t)
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+		app.Config.VolumeLimits.Mints.RecipientHourly = 30000
+		t.Cleanup(func() { app.Config.VolumeLimits = models.VolumeLimitsConfig{} })
+
+		address := common.HexToAddress("0x1234").Hex()
+
+		app.Config.Pocket.Confirmations = 0
+		app.Config.Ethereum.ChainId = "31337"
+
+		tx := &pokt.TxResponse{
+			Tx: "abcd",
+			TxResult: pokt.TxResult{
+				Code:        0,
+				MessageType: "send",
+			},
+			StdTx: pokt.StdTx{
+				Msg: pokt.Msg{
+					Type: "pos/Send",
+					Value: pokt.Value{
+						ToAddress:   x.vaultAddress,
+						FromAddress: "abcd",
+						Amount:      "20000",
+					},
+				},
+				Memo: fmt.Sprintf(`{ "address": "%s", "chain_id": "31337" }`, address),
+			},
+		}
+
+		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+
+		mint := &models.Mint{
+			Id:               &primitive.NilObjectID,
+			SenderAddress:    "abcd",
+			RecipientAddress: address,
+			Amount:           "20000",
+			Nonce:            "1",
+			RecipientChainId: "31337",
+			Height:           "99",
+			Confirmations:    "1",
+			Status:           models.StatusConfirmed,
+		}
+
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, result interface{}) {
+				assert.Equal(t, bson.M{"$ne": mint.Id}, filter.(bson.M)["_id"])
+				assert.Equal(t, x.wpoktAddress, filter.(bson.M)["wpokt_address"])
+				*result.(*[]app.VolumeEntry) = []app.VolumeEntry{
+					{RecipientAddress: strings.ToLower(address), Amount: "15000", CountedAt: time.Now().Add(-time.Minute)},
+				}
+			}).Once()
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusHeld, set["status"])
+				assert.Equal(t, "recipient hourly volume of 35000 would exceed the limit of 30000", set["held_reason"])
+				assert.Nil(t, set["signers"])
+			}).Once()
+
+		success := x.HandleMint(mint, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Held mint released", func(t *testing.T) {
+		mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := eth.NewMockMintControllerContract(t)
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+		app.Config.VolumeLimits.Mints.RecipientHourly = 30000
+		t.Cleanup(func() { app.Config.VolumeLimits = models.VolumeLimitsConfig{} })
+
+		address := common.HexToAddress("0x1234").Hex()
+
+		app.Config.Pocket.Confirmations = 0
+		app.Config.Ethereum.ChainId = "31337"
+
+		tx := &pokt.TxResponse{
+			Tx: "abcd",
+			TxResult: pokt.TxResult{
+				Code:        0,
+				MessageType: "send",
+			},
+			StdTx: pokt.StdTx{
+				Msg: pokt.Msg{
+					Type: "pos/Send",
+					Value: pokt.Value{
+						ToAddress:   x.vaultAddress,
+						FromAddress: "abcd",
+						Amount:      "20000",
+					},
+				},
+				Memo: fmt.Sprintf(`{ "address": "%s", "chain_id": "31337" }`, address),
+			},
+		}
+
+		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+
+		mint := &models.Mint{
+			Id:               &primitive.NilObjectID,
+			SenderAddress:    "abcd",
+			RecipientAddress: address,
+			Amount:           "20000",
+			Nonce:            "1",
+			RecipientChainId: "31337",
+			Height:           "99",
+			Confirmations:    "1",
+			Status:           models.StatusHeld,
+			HeldReason:       "recipient hourly volume of 35000 would exceed the limit of 30000",
+		}
+
+		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]app.VolumeEntry) = []app.VolumeEntry{
+					{RecipientAddress: strings.ToLower(address), Amount: "15000", CountedAt: time.Now().Add(-2 * time.Hour)},
+				}
+			}).Once()
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, gotUpdate interface{}) {
+				assert.Equal(t, bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}}, filter.(bson.M)["status"])
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+				assert.Equal(t, "", set["held_reason"])
+				assert.Equal(t, []string{x.address}, set["signers"])
+				assert.NotNil(t, set["counted_at"])
+			}).Once()
+
+		success := x.HandleMint(mint, 0)
+
+		assert.True(t, success)
+	})
+
+	approvalTest := func(t *testing.T, status string, approve bool) (*MintSignerRunner, *app.MockDatabase, *models.Mint) {
+		mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := eth.NewMockMintControllerContract(t)
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+		operatorKey, _ := crypto.GenerateKey()
+		operator := strings.ToLower(crypto.PubkeyToAddress(operatorKey.PublicKey).Hex())
+		app.Config.Approvals = models.ApprovalsConfig{MintThreshold: 10000, Quorum: 1, OperatorAddresses: []string{operator}}
+		t.Cleanup(func() { app.Config.Approvals = models.ApprovalsConfig{} })
+
+		address := common.HexToAddress("0x1234").Hex()
+
+		app.Config.Pocket.Confirmations = 0
+		app.Config.Ethereum.ChainId = "31337"
+
+		tx := &pokt.TxResponse{
+			Tx: "abcd",
+			TxResult: pokt.TxResult{
+				Code:        0,
+				MessageType: "send",
+			},
+			StdTx: pokt.StdTx{
+				Msg: pokt.Msg{
+					Type: "pos/Send",
+					Value: pokt.Value{
+						ToAddress:   x.vaultAddress,
+						FromAddress: "abcd",
+						Amount:      "20000",
+					},
+				},
+				Memo: fmt.Sprintf(`{ "address": "%s", "chain_id": "31337" }`, address),
+			},
+		}
+
+		mockPoktClient.EXPECT().GetTx("0xhash").Return(tx, nil)
+
+		mint := &models.Mint{
+			Id:               &primitive.NilObjectID,
+			TransactionHash:  "0xhash",
+			SenderAddress:    "abcd",
+			RecipientAddress: address,
+			Amount:           "20000",
+			Nonce:            "1",
+			RecipientChainId: "31337",
+			Height:           "99",
+			Confirmations:    "1",
+			Status:           status,
+		}
+
+		if approve {
+			message := app.ApprovalMessage(models.CollectionMints, mint.Id.Hex(), mint.TransactionHash, mint.RecipientAddress, mint.Amount)
+			signature, _ := app.SignApproval(message, operatorKey)
+			mint.Approvals = []models.Approval{{Operator: operator, Signature: signature}}
+		}
+		return x, mockDB, mint
+	}
+
+	t.Run("Awaiting approval", func(t *testing.T) {
+		x, mockDB, mint := approvalTest(t, models.StatusConfirmed, false)
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusAwaitingApproval, set["status"])
+				assert.Nil(t, set["signers"])
+			}).Once()
+
+		success := x.HandleMint(mint, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Approved mint signed", func(t *testing.T) {
+		x, mockDB, mint := approvalTest(t, models.StatusAwaitingApproval, true)
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+				assert.Equal(t, []string{x.address}, set["signers"])
+			}).Once()
+
+		success := x.HandleMint(mint, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Quarantined sender", func(t *testing.T) {
+		x, mockDB, mint := approvalTest(t, models.StatusConfirmed, false)
+		denyFile := filepath.Join(t.TempDir(), "deny.txt")
+		assert.Nil(t, os.WriteFile(denyFile, []byte("ABCD\n"), 0600))
+		app.Config.Screening = models.ScreeningConfig{Enabled: true, DenyListFile: denyFile}
+		t.Cleanup(func() { app.Config.Screening = models.ScreeningConfig{} })
+
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusQuarantined, set["status"])
+				assert.Equal(t, "sender abcd is on the deny list", set["quarantine_reason"])
+				assert.Nil(t, set["signers"])
+			}).Once()
+
+		success := x.HandleMint(mint, 0)
+
+		assert.True(t, success)
+	})
+
 }
 
 func TestMintSignerSyncTxs(t *testing.T) {
@@ -1396,10 +1754,11 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		filter := bson.M{
 			"wpokt_address": x.wpoktAddress,
 			"vault_address": x.vaultAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers": bson.M{
 				"$nin": []string{x.address},
 			},
+			"$expr": app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).Return(nil)
@@ -1421,10 +1780,11 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
 			"vault_address": x.vaultAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers": bson.M{
 				"$nin": []string{x.address},
 			},
+			"$expr": app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionMints, filterFind, mock.Anything).Return(nil).
@@ -1435,7 +1795,7 @@ func TestMintSignerSyncTxs(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 		success := x.SyncTxs()
 
 		assert.False(t, success)
@@ -1454,10 +1814,11 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
 			"vault_address": x.vaultAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers": bson.M{
 				"$nin": []string{x.address},
 			},
+			"$expr": app.RetryDueFilter(x.validatorId),
 		}
 
 		address := common.HexToAddress("0x1234").Hex()
@@ -1498,8 +1859,9 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
@@ -1508,12 +1870,15 @@ func TestMintSignerSyncTxs(t *testing.T) {
 					Amount:    mint.Amount,
 					Nonce:     mint.Nonce,
 				},
-				"nonce":         mint.Nonce,
-				"signatures":    mint.Signatures,
-				"signers":       []string{x.address},
-				"status":        models.StatusConfirmed,
-				"confirmations": "0",
-				"updated_at":    time.Now(),
+				"nonce":            mint.Nonce,
+				"signatures":       mint.Signatures,
+				"domain_separator": domainSeparatorOf(x.domain),
+				"counted_at":       time.Now(),
+				"signers":          []string{x.address},
+				"status":           models.StatusConfirmed,
+				"confirmations":    "0",
+				"updated_at":       time.Now(),
+				"lock_token":       int64(0),
 			},
 		}
 
@@ -1528,11 +1893,12 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(nil)
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
 
 		success := x.SyncTxs()
@@ -1552,10 +1918,11 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
 			"vault_address": x.vaultAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers": bson.M{
 				"$nin": []string{x.address},
 			},
+			"$expr": app.RetryDueFilter(x.validatorId),
 		}
 
 		address := common.HexToAddress("0x1234").Hex()
@@ -1596,8 +1963,9 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    mint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        mint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 		update := bson.M{
 			"$set": bson.M{
@@ -1606,12 +1974,15 @@ func TestMintSignerSyncTxs(t *testing.T) {
 					Amount:    mint.Amount,
 					Nonce:     mint.Nonce,
 				},
-				"nonce":         mint.Nonce,
-				"signatures":    mint.Signatures,
-				"signers":       []string{x.address},
-				"status":        models.StatusConfirmed,
-				"confirmations": "0",
-				"updated_at":    time.Now(),
+				"nonce":            mint.Nonce,
+				"signatures":       mint.Signatures,
+				"domain_separator": domainSeparatorOf(x.domain),
+				"counted_at":       time.Now(),
+				"signers":          []string{x.address},
+				"status":           models.StatusConfirmed,
+				"confirmations":    "0",
+				"updated_at":       time.Now(),
+				"lock_token":       int64(0),
 			},
 		}
 
@@ -1626,11 +1997,12 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(nil)
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 		mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 		success := x.SyncTxs()
@@ -1653,10 +2025,11 @@ func TestMintSignerRun(t *testing.T) {
 	filterFind := bson.M{
 		"wpokt_address": x.wpoktAddress,
 		"vault_address": x.vaultAddress,
-		"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 		"signers": bson.M{
 			"$nin": []string{x.address},
 		},
+		"$expr": app.RetryDueFilter(x.validatorId),
 	}
 
 	address := common.HexToAddress("0x1234").Hex()
@@ -1697,8 +2070,9 @@ func TestMintSignerRun(t *testing.T) {
 	mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
 
 	filterUpdate := bson.M{
-		"_id":    mint.Id,
-		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"_id":        mint.Id,
+		"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+		"lock_token": app.LockTokenFilter(0),
 	}
 	update := bson.M{
 		"$set": bson.M{
@@ -1707,12 +2081,15 @@ func TestMintSignerRun(t *testing.T) {
 				Amount:    mint.Amount,
 				Nonce:     mint.Nonce,
 			},
-			"nonce":         mint.Nonce,
-			"signatures":    mint.Signatures,
-			"signers":       []string{x.address},
-			"status":        models.StatusConfirmed,
-			"confirmations": "0",
-			"updated_at":    time.Now(),
+			"nonce":            mint.Nonce,
+			"signatures":       mint.Signatures,
+			"domain_separator": domainSeparatorOf(x.domain),
+			"counted_at":       time.Now(),
+			"signers":          []string{x.address},
+			"status":           models.StatusConfirmed,
+			"confirmations":    "0",
+			"updated_at":       time.Now(),
+			"lock_token":       int64(0),
 		},
 	}
 
@@ -1727,11 +2104,12 @@ func TestMintSignerRun(t *testing.T) {
 	mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
 		Run(func(_ string, _ interface{}, gotUpdate interface{}) {
 			gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+			gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 			gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 			assert.Equal(t, update, gotUpdate)
 		}).Return(nil)
 
-	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 	mockDB.EXPECT().Unlock("lockId").Return(nil)
 
 	mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{
@@ -1742,8 +2120,76 @@ func TestMintSignerRun(t *testing.T) {
 
 	mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000000), nil)
 
+	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(300), nil)
+	mockWrappedPocketContract.EXPECT().Paused(mock.Anything).Return(false, nil)
+	expectTxFee(mockPoktClient)
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments)
+
+	x.Run()
+
+}
+
+func TestMintSignerRunPaused(t *testing.T) {
+	mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+	mockMintControllerContract := eth.NewMockMintControllerContract(t)
+	mockEthClient := eth.NewMockEthereumClient(t)
+	mockPoktClient := pokt.NewMockPocketClient(t)
+	mockDB := app.NewMockDatabase(t)
+	app.DB = mockDB
+	x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+	mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil)
+	mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
+	mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000000), nil)
+	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(300), nil).Once()
+	mockWrappedPocketContract.EXPECT().Paused(mock.Anything).Return(true, nil).Once()
+
+	// the paused breaker trigger is off, so no mints are fetched or signed
+	x.Run()
+
+	status := x.Status()
+	assert.False(t, status.Failed)
+	assert.Equal(t, &models.PauseState{Paused: true, BlockNumber: "300"}, status.Pause)
+
+	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(310), nil).Once()
+	expectPauseEvents(t, mockWrappedPocketContract, 301, 310, nil, []*autogen.WrappedPocketUnpaused{
+		{Account: common.HexToAddress("0xabcd"), Raw: types.Log{BlockNumber: 305}},
+	})
+	expectTxFee(mockPoktClient)
+	domainFilter := eth.NewMockMintControllerEIP712DomainChangedIterator(t)
+	domainFilter.EXPECT().Next().Return(false).Once()
+	domainFilter.EXPECT().Error().Return(nil)
+	domainFilter.EXPECT().Close().Return(nil)
+	mockMintControllerContract.EXPECT().FilterEIP712DomainChanged(mock.Anything).Return(domainFilter, nil).Once()
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+	x.Run()
+
+	assert.False(t, x.Status().Pause.Paused)
+	assert.Equal(t, int64(310), x.lastDomainBlock)
+}
+
+func TestMintSignerRunPausedUnknown(t *testing.T) {
+	mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+	mockMintControllerContract := eth.NewMockMintControllerContract(t)
+	mockEthClient := eth.NewMockEthereumClient(t)
+	mockPoktClient := pokt.NewMockPocketClient(t)
+	app.DB = app.NewMockDatabase(t)
+	x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+	mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil)
+	mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
+	mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000000), nil)
+	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(300), nil).Once()
+	mockWrappedPocketContract.EXPECT().Paused(mock.Anything).Return(false, errors.New("error")).Once()
+
+	// no mints are fetched or signed while the paused state is unknown
 	x.Run()
 
+	status := x.Status()
+	assert.True(t, status.Failed)
+	assert.Nil(t, status.Pause)
 }
 
 func TestNewMintSigner(t *testing.T) {
@@ -1805,3 +2251,242 @@ func TestNewMintSigner(t *testing.T) {
 	})
 
 }
+
+func TestMintSignerRunValidatorSetDrift(t *testing.T) {
+	mockWrappedPocketContract := eth.NewMockWrappedPocketContract(t)
+	mockMintControllerContract := eth.NewMockMintControllerContract(t)
+	mockEthClient := eth.NewMockEthereumClient(t)
+	mockPoktClient := pokt.NewMockPocketClient(t)
+	mockDB := app.NewMockDatabase(t)
+	app.DB = mockDB
+	x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+	app.Config.ValidatorSetMonitor.Enabled = true
+	t.Cleanup(func() { app.Config.ValidatorSetMonitor.Enabled = false })
+
+	mockDB.EXPECT().FindOne(models.CollectionValidatorSets, bson.M{"_id": x.address}, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*models.ValidatorSetReport) = models.ValidatorSetReport{InSync: false, Drift: []string{"signer 0xabcd is not a validator"}}
+		}).Once()
+
+	mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil)
+	mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
+	mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000000), nil)
+	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(300), nil).Once()
+	mockWrappedPocketContract.EXPECT().Paused(mock.Anything).Return(false, nil).Once()
+
+	// no mints are fetched or signed while the validator set drifted
+	x.Run()
+
+	assert.False(t, x.Status().Failed)
+}
+
+func TestMintSignerValidatorSetInSync(t *testing.T) {
+	setup := func(t *testing.T, enabled bool) (*app.MockDatabase, *MintSignerRunner) {
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.ValidatorSetMonitor.Enabled = enabled
+		t.Cleanup(func() { app.Config.ValidatorSetMonitor.Enabled = false })
+		return mockDB, NewTestMintSigner(t, nil, nil, nil, nil)
+	}
+
+	t.Run("Monitor disabled", func(t *testing.T) {
+		mockDB, x := setup(t, false)
+
+		inSync, err := x.ValidatorSetInSync()
+
+		assert.Nil(t, err)
+		assert.True(t, inSync)
+		mockDB.AssertNotCalled(t, "FindOne", models.CollectionValidatorSets, mock.Anything, mock.Anything)
+	})
+
+	t.Run("In sync", func(t *testing.T) {
+		mockDB, x := setup(t, true)
+		mockDB.EXPECT().FindOne(models.CollectionValidatorSets, bson.M{"_id": x.address}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				result.(*models.ValidatorSetReport).InSync = true
+			}).Once()
+
+		inSync, err := x.ValidatorSetInSync()
+
+		assert.Nil(t, err)
+		assert.True(t, inSync)
+	})
+
+	t.Run("No report fails closed", func(t *testing.T) {
+		mockDB, x := setup(t, true)
+		mockDB.EXPECT().FindOne(models.CollectionValidatorSets, bson.M{"_id": x.address}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		inSync, err := x.ValidatorSetInSync()
+
+		assert.EqualError(t, err, "no validator set report for "+x.address)
+		assert.False(t, inSync)
+	})
+
+	t.Run("Error reading report", func(t *testing.T) {
+		mockDB, x := setup(t, true)
+		mockDB.EXPECT().FindOne(models.CollectionValidatorSets, bson.M{"_id": x.address}, mock.Anything).Return(errors.New("error")).Once()
+
+		inSync, err := x.ValidatorSetInSync()
+
+		assert.NotNil(t, err)
+		assert.False(t, inSync)
+	})
+}
+
+func TestMintSignerUpdateDomain(t *testing.T) {
+	mintController := "0x0000000000000000000000000000000000001234"
+	newDomain := eth.DomainData{
+		Name:              "Test",
+		Version:           "2",
+		ChainId:           big.NewInt(31337),
+		VerifyingContract: common.HexToAddress(mintController),
+	}
+
+	setup := func(t *testing.T) (*eth.MockMintControllerContract, *app.MockDatabase, *MintSignerRunner) {
+		mockMintControllerContract := eth.NewMockMintControllerContract(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.ChainId = "31337"
+		app.Config.Ethereum.MintControllerAddress = mintController
+		t.Cleanup(func() { app.Config = models.Config{} })
+		x := NewTestMintSigner(t, nil, mockMintControllerContract, nil, nil)
+		x.lastDomainBlock = 100
+		x.ethBlockNumber = 110
+		return mockMintControllerContract, mockDB, x
+	}
+
+	expectDomainChanged := func(t *testing.T, mockContract *eth.MockMintControllerContract, events ...*autogen.MintControllerEIP712DomainChanged) {
+		filter := eth.NewMockMintControllerEIP712DomainChangedIterator(t)
+		for _, event := range events {
+			filter.EXPECT().Next().Return(true).Once()
+			filter.EXPECT().Event().Return(event).Once()
+		}
+		filter.EXPECT().Next().Return(false).Once()
+		filter.EXPECT().Error().Return(nil)
+		filter.EXPECT().Close().Return(nil)
+		mockContract.EXPECT().FilterEIP712DomainChanged(mock.Anything).Return(filter, nil).
+			Run(func(opts *bind.FilterOpts) {
+				assert.Equal(t, uint64(101), opts.Start)
+				assert.Equal(t, uint64(110), *opts.End)
+			}).Once()
+	}
+
+	t.Run("Domain read at an unknown block", func(t *testing.T) {
+		_, _, x := setup(t)
+		x.lastDomainBlock = 0
+
+		assert.False(t, x.UpdateDomain())
+		assert.Equal(t, int64(0), x.lastDomainBlock)
+	})
+
+	t.Run("Domain not changed", func(t *testing.T) {
+		mockContract, _, x := setup(t)
+		expectDomainChanged(t, mockContract, &autogen.MintControllerEIP712DomainChanged{Raw: types.Log{BlockNumber: 105, Removed: true}})
+
+		assert.True(t, x.UpdateDomain())
+		assert.Equal(t, int64(110), x.lastDomainBlock)
+		assert.Equal(t, "1", x.domain.Version)
+	})
+
+	t.Run("Domain changed", func(t *testing.T) {
+		mockContract, mockDB, x := setup(t)
+		expectDomainChanged(t, mockContract, &autogen.MintControllerEIP712DomainChanged{Raw: types.Log{BlockNumber: 105}})
+		mockContract.EXPECT().Eip712Domain(mock.Anything).Return(newDomain, nil).Once()
+
+		id := primitive.NewObjectID()
+		mint := models.Mint{Id: &id, RecipientAddress: "0xABCD", Status: models.StatusSigned, Signers: []string{"0x1"}, Signatures: []string{"0xsig"}}
+		mockDB.EXPECT().FindMany(models.CollectionMints, bson.M{
+			"wpokt_address":    x.wpoktAddress,
+			"vault_address":    x.vaultAddress,
+			"status":           bson.M{"$in": []string{models.StatusConfirmed, models.StatusSigned}},
+			"signers.0":        bson.M{"$exists": true},
+			"domain_separator": bson.M{"$ne": domainSeparatorOf(newDomain)},
+		}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, result interface{}) {
+				*result.(*[]models.Mint) = []models.Mint{mint}
+			}).Once()
+		mockDB.EXPECT().XLock("mints/0xabcd").Return("lockId", int64(1), nil).Once()
+		mockDB.EXPECT().UpdateOne(models.CollectionMints, bson.M{
+			"_id":        &id,
+			"status":     bson.M{"$in": []string{models.StatusConfirmed, models.StatusSigned}},
+			"signatures": []string{"0xsig"},
+			"lock_token": app.LockTokenFilter(1),
+		}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+				assert.Equal(t, []string{}, set["signatures"])
+				assert.Equal(t, []string{}, set["signers"])
+				assert.Equal(t, int64(1), set["lock_token"])
+			}).Once()
+		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+
+		assert.True(t, x.UpdateDomain())
+		assert.Equal(t, int64(110), x.lastDomainBlock)
+		assert.Equal(t, "2", x.domain.Version)
+	})
+
+	t.Run("Invalid domain", func(t *testing.T) {
+		mockContract, _, x := setup(t)
+		expectDomainChanged(t, mockContract, &autogen.MintControllerEIP712DomainChanged{Raw: types.Log{BlockNumber: 105}})
+		invalid := newDomain
+		invalid.ChainId = big.NewInt(1)
+		mockContract.EXPECT().Eip712Domain(mock.Anything).Return(invalid, nil).Once()
+
+		assert.False(t, x.UpdateDomain())
+		assert.Equal(t, int64(100), x.lastDomainBlock)
+	})
+
+	t.Run("Error fetching events", func(t *testing.T) {
+		mockContract, _, x := setup(t)
+		mockContract.EXPECT().FilterEIP712DomainChanged(mock.Anything).Return(nil, errors.New("error")).Once()
+
+		assert.False(t, x.UpdateDomain())
+		assert.Equal(t, int64(100), x.lastDomainBlock)
+	})
+}
+
+func TestMintSignerHandleMintOtherDomain(t *testing.T) {
+	mockPoktClient := pokt.NewMockPocketClient(t)
+	mockDB := app.NewMockDatabase(t)
+	app.DB = mockDB
+	x := NewTestMintSigner(t, nil, nil, nil, mockPoktClient)
+	app.Config.Pocket.Confirmations = 0
+	app.Config.Ethereum.ChainId = "31337"
+	t.Cleanup(func() { app.Config = models.Config{} })
+
+	address := common.HexToAddress("0x1234").Hex()
+	mint := &models.Mint{
+		SenderAddress:    "abcd",
+		RecipientAddress: address,
+		Amount:           "20000",
+		Nonce:            "1",
+		RecipientChainId: "31337",
+		Height:           "99",
+		Status:           models.StatusConfirmed,
+		Signers:          []string{"0x0000000000000000000000000000000000000001"},
+		Signatures:       []string{"0xold"},
+		DomainSeparator:  "0xold",
+	}
+
+	mockPoktClient.EXPECT().GetTx("").Return(&pokt.TxResponse{
+		Tx:       "abcd",
+		TxResult: pokt.TxResult{Code: 0, MessageType: "send"},
+		StdTx: pokt.StdTx{
+			Msg: pokt.Msg{
+				Type:  "pos/Send",
+				Value: pokt.Value{ToAddress: x.vaultAddress, FromAddress: "abcd", Amount: "20000"},
+			},
+			Memo: fmt.Sprintf(`{ "address": "%s", "chain_id": "31337" }`, address),
+		},
+	}, nil)
+	mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, update interface{}) {
+			set := update.(bson.M)["$set"].(bson.M)
+			// the signature made under the old domain is dropped
+			assert.Equal(t, []string{x.address}, set["signers"])
+			assert.Equal(t, domainSeparatorOf(x.domain), set["domain_separator"])
+		}).Once()
+
+	assert.True(t, x.HandleMint(mint, 0))
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+func CreateFeeCollected(event *autogen.WrappedPocketFeeCollected) models.FeeCollected {
+	return models.FeeCollected{
+		TransactionHash: strings.ToLower(event.Raw.TxHash.String()),
+		LogIndex:        strconv.FormatInt(int64(event.Raw.Index), 10),
+		BlockNumber:     strconv.FormatInt(int64(event.Raw.BlockNumber), 10),
+		WPOKTAddress:    strings.ToLower(event.Raw.Address.String()),
+		FeeCollector:    strings.ToLower(event.FeeCollector.Hex()),
+		Amount:          event.Amount.String(),
+		CreatedAt:       time.Now(),
+	}
+}
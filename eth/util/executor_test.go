@@ -0,0 +1,43 @@
+package util
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFeeCollected(t *testing.T) {
+	TX_HASH := "0x0000000000000000000000000000000000000000000000001234567890abcdef"
+	WPOKT_ADDRESS := "0x0000000000000000000000000000000000001234"
+	COLLECTOR_ADDRESS := "0x0000000000000000000000000000000000abcDeF"
+
+	event := &autogen.WrappedPocketFeeCollected{
+		Raw: types.Log{
+			BlockNumber: 10,
+			TxHash:      common.HexToHash(TX_HASH),
+			Index:       3,
+			Address:     common.HexToAddress(WPOKT_ADDRESS),
+		},
+		FeeCollector: common.HexToAddress(COLLECTOR_ADDRESS),
+		Amount:       big.NewInt(100),
+	}
+
+	fee := CreateFeeCollected(event)
+
+	assert.WithinDuration(t, time.Now(), fee.CreatedAt, time.Second)
+	fee.CreatedAt = time.Time{}
+	assert.Equal(t, models.FeeCollected{
+		TransactionHash: TX_HASH,
+		LogIndex:        "3",
+		BlockNumber:     "10",
+		WPOKTAddress:    WPOKT_ADDRESS,
+		FeeCollector:    "0x0000000000000000000000000000000000abcdef",
+		Amount:          "100",
+	}, fee)
+}
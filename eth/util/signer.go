@@ -56,32 +56,44 @@ var typesStandard = apitypes.Types{
 	},
 }
 
-func signTypedData(
-	domainData eth.DomainData,
-	mint *autogen.MintControllerMintData,
-	key *ecdsa.PrivateKey,
-) ([]byte, error) {
-
+// NewMintTypedData returns the EIP-712 typed data validators sign for a mint
+func NewMintTypedData(domainData eth.DomainData, mint *autogen.MintControllerMintData) apitypes.TypedData {
 	message := apitypes.TypedDataMessage{
 		"recipient": mint.Recipient.String(),
 		"amount":    mint.Amount.String(),
 		"nonce":     mint.Nonce.String(),
 	}
 
-	domain := apitypes.TypedDataDomain{
+	return apitypes.TypedData{
+		Types:       typesStandard,
+		PrimaryType: primaryType,
+		Domain:      newTypedDataDomain(domainData),
+		Message:     message,
+	}
+}
+
+func newTypedDataDomain(domainData eth.DomainData) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
 		Name:              domainData.Name,
 		Version:           domainData.Version,
 		ChainId:           math.NewHexOrDecimal256(domainData.ChainId.Int64()),
 		VerifyingContract: domainData.VerifyingContract.String(),
 	}
+}
 
-	typedData := apitypes.TypedData{
-		Types:       typesStandard,
-		PrimaryType: primaryType,
-		Domain:      domain,
-		Message:     message,
+// DomainSeparator returns the EIP-712 domain separator of the domain data, recorded on a mint
+// to tell which domain its signatures were made under
+func DomainSeparator(domainData eth.DomainData) (string, error) {
+	typedData := apitypes.TypedData{Types: typesStandard, Domain: newTypedDataDomain(domainData)}
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(domainSeparator), nil
+}
 
+// HashTypedData returns the EIP-712 digest of the typed data
+func HashTypedData(typedData apitypes.TypedData) ([]byte, error) {
 	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
 		return nil, err
@@ -93,14 +105,48 @@ func signTypedData(
 	}
 
 	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	sighash := crypto.Keccak256(rawData)
+	return crypto.Keccak256(rawData), nil
+}
 
+// SignTypedDataHash signs an EIP-712 digest in the format expected by the mint controller
+func SignTypedDataHash(sighash []byte, key *ecdsa.PrivateKey) ([]byte, error) {
 	signature, err := crypto.Sign(sighash, key)
+	if err != nil {
+		return nil, err
+	}
 	if signature[64] == 0 || signature[64] == 1 {
 		signature[64] += 27
 	}
+	return signature, nil
+}
+
+// RecoverTypedDataSigner returns the address that signed an EIP-712 digest
+func RecoverTypedDataSigner(sighash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != 65 {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pubKey, err := crypto.SigToPub(sighash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
 
-	return signature, err
+func signTypedData(
+	domainData eth.DomainData,
+	mint *autogen.MintControllerMintData,
+	key *ecdsa.PrivateKey,
+) ([]byte, error) {
+	sighash, err := HashTypedData(NewMintTypedData(domainData, mint))
+	if err != nil {
+		return nil, err
+	}
+	return SignTypedDataHash(sighash, key)
 }
 
 func UpdateStatusAndConfirmationsForMint(mint *models.Mint, poktHeight int64) (*models.Mint, error) {
@@ -112,7 +158,7 @@ func UpdateStatusAndConfirmationsForMint(mint *models.Mint, poktHeight int64) (*
 
 	if status == models.StatusPending || confirmations == 0 {
 		status = models.StatusPending
-		if app.Config.Pocket.Confirmations == 0 {
+		if app.LiveConfig().Pocket.Confirmations == 0 {
 			status = models.StatusConfirmed
 		} else {
 			mintHeight, err := strconv.ParseInt(mint.Height, 10, 64)
@@ -120,7 +166,7 @@ func UpdateStatusAndConfirmationsForMint(mint *models.Mint, poktHeight int64) (*
 				return mint, err
 			}
 			confirmations = poktHeight - mintHeight
-			if confirmations >= app.Config.Pocket.Confirmations {
+			if confirmations >= app.LiveConfig().Pocket.Confirmations {
 				status = models.StatusConfirmed
 			}
 		}
@@ -172,7 +218,12 @@ func SignMint(
 		return mint, err
 	}
 
-	signatureEncoded := "0x" + hex.EncodeToString(signature)
+	signer := strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	return AddMintSignature(mint, "0x"+hex.EncodeToString(signature), signer, numSigners), nil
+}
+
+// AddMintSignature adds a signature to a mint, keeping signers sorted as the mint controller expects
+func AddMintSignature(mint *models.Mint, signatureEncoded string, signer string, numSigners int) *models.Mint {
 	signatures := mint.Signatures
 	signers := mint.Signers
 	if signatures == nil || signers == nil || len(signatures) != len(signers) || len(signatures) == 0 {
@@ -180,7 +231,7 @@ func SignMint(
 		signers = []string{}
 	}
 	signatures = append(signatures, signatureEncoded)
-	signers = append(signers, strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex()))
+	signers = append(signers, signer)
 
 	sortedSigners, sortedSignatures := sortSignersAndSignatures(signers, signatures)
 
@@ -190,5 +241,5 @@ func SignMint(
 
 	mint.Signatures = sortedSignatures
 	mint.Signers = sortedSigners
-	return mint, nil
+	return mint
 }
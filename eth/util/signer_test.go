@@ -301,3 +301,27 @@ func TestSignMint(t *testing.T) {
 	}
 
 }
+
+func TestDomainSeparator(t *testing.T) {
+	domain := eth.DomainData{
+		Name:              "Test",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0x1234"),
+	}
+	data := &autogen.MintControllerMintData{Recipient: common.HexToAddress("0x1234"), Amount: big.NewInt(100), Nonce: big.NewInt(1)}
+
+	separator, err := DomainSeparator(domain)
+	assert.Nil(t, err)
+
+	// the digest that is signed commits to the separator
+	typedData := NewMintTypedData(domain, data)
+	structHash, _ := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	digest, _ := HashTypedData(typedData)
+	assert.Equal(t, crypto.Keccak256([]byte("\x19\x01"), common.FromHex(separator), structHash), digest)
+
+	domain.Version = "2"
+	changed, err := DomainSeparator(domain)
+	assert.Nil(t, err)
+	assert.NotEqual(t, separator, changed)
+}
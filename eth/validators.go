@@ -0,0 +1,311 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	ValidatorSetMonitorName = "VALIDATOR SET MONITOR"
+)
+
+// LastValidatorSet reads the last report of the validator set monitor for a signer, nil if the
+// monitor has not checked the signer yet. Reports are stored in the database, so that the mint
+// signer reads them on replicas that do not run the monitor.
+func LastValidatorSet(db app.Database, signer string) (*models.ValidatorSetReport, error) {
+	report := &models.ValidatorSetReport{}
+	err := db.FindOne(models.CollectionValidatorSets, bson.M{"_id": signer}, report)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ValidatorSetMonitorRunner follows the NewValidator and RemovedValidator events of the mint
+// controller and checks that its validators are the configured ones, including our signer
+type ValidatorSetMonitorRunner struct {
+	app.Traced
+
+	startBlockNumber       int64
+	currentBlockNumber     int64
+	client                 eth.EthereumClient
+	mintControllerContract eth.MintControllerContract
+	validators             map[string]bool
+	report                 *models.ValidatorSetReport
+	failed                 bool
+}
+
+func (x *ValidatorSetMonitorRunner) Run() {
+	x.UpdateCurrentBlockNumber()
+	x.failed = !x.SyncEvents() || !x.Check()
+}
+
+func (x *ValidatorSetMonitorRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{
+		EthBlockNumber: strconv.FormatInt(x.startBlockNumber, 10),
+		Failed:         x.failed,
+		ValidatorSet:   x.report,
+	}
+}
+
+func (x *ValidatorSetMonitorRunner) UpdateCurrentBlockNumber() {
+	res, err := x.client.GetBlockNumber()
+	if err != nil {
+		log.Error("[VALIDATOR SET MONITOR] Error fetching current block number: ", err)
+		return
+	}
+	x.currentBlockNumber = int64(res)
+	log.Info("[VALIDATOR SET MONITOR] Current block number: ", x.currentBlockNumber)
+}
+
+type validatorEvent struct {
+	added     bool
+	validator string
+	raw       types.Log
+}
+
+// SyncEvents applies the validator events from the start block up to the current block
+func (x *ValidatorSetMonitorRunner) SyncEvents() bool {
+	if x.currentBlockNumber <= x.startBlockNumber {
+		return true
+	}
+
+	events := []validatorEvent{}
+	for start := x.startBlockNumber + 1; start <= x.currentBlockNumber; start += eth.MAX_QUERY_BLOCKS {
+		end := start + eth.MAX_QUERY_BLOCKS - 1
+		if end > x.currentBlockNumber {
+			end = x.currentBlockNumber
+		}
+		found, err := x.filterEvents(uint64(start), uint64(end))
+		if err != nil {
+			log.Error("[VALIDATOR SET MONITOR] Error fetching validator events: ", err)
+			return false
+		}
+		events = append(events, found...)
+	}
+	x.startBlockNumber = x.currentBlockNumber
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].raw.BlockNumber != events[j].raw.BlockNumber {
+			return events[i].raw.BlockNumber < events[j].raw.BlockNumber
+		}
+		return events[i].raw.Index < events[j].raw.Index
+	})
+	for _, event := range events {
+		logger := log.WithFields(log.Fields{"validator": event.validator, "block_number": event.raw.BlockNumber})
+		if event.added {
+			logger.Info("[VALIDATOR SET MONITOR] Validator added")
+		} else {
+			logger.Warn("[VALIDATOR SET MONITOR] Validator removed")
+		}
+		x.validators[event.validator] = event.added
+	}
+	return true
+}
+
+func (x *ValidatorSetMonitorRunner) filterEvents(start uint64, end uint64) ([]validatorEvent, error) {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: context.Background()}
+	events := []validatorEvent{}
+
+	added, err := x.mintControllerContract.FilterNewValidator(opts, []common.Address{})
+	if added != nil {
+		defer added.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for added.Next() {
+		if event := added.Event(); event != nil && !event.Raw.Removed {
+			events = append(events, validatorEvent{true, strings.ToLower(event.Validator.Hex()), event.Raw})
+		}
+	}
+	if err := added.Error(); err != nil {
+		return nil, err
+	}
+
+	removed, err := x.mintControllerContract.FilterRemovedValidator(opts, []common.Address{})
+	if removed != nil {
+		defer removed.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for removed.Next() {
+		if event := removed.Event(); event != nil && !event.Raw.Removed {
+			events = append(events, validatorEvent{false, strings.ToLower(event.Validator.Hex()), event.Raw})
+		}
+	}
+	if err := removed.Error(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (x *ValidatorSetMonitorRunner) isValidator(address string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+	defer cancel()
+	return x.mintControllerContract.Validators(&bind.CallOpts{Context: ctx, Pending: false}, common.HexToAddress(address))
+}
+
+// signerAddress is read from the config on every check, so that a rotated key is checked
+func signerAddress() (string, error) {
+	privateKey, err := crypto.HexToECDSA(app.LiveConfig().Ethereum.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex()), nil
+}
+
+// Check compares the validators of the mint controller with the config and stores the report
+// for the mint signer
+func (x *ValidatorSetMonitorRunner) Check() bool {
+	log.Debug("[VALIDATOR SET MONITOR] Checking validator set")
+
+	signer, err := signerAddress()
+	if err != nil {
+		log.Error("[VALIDATOR SET MONITOR] Error loading private key: ", err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+	defer cancel()
+	count, err := x.mintControllerContract.ValidatorCount(&bind.CallOpts{Context: ctx, Pending: false})
+	if err != nil {
+		log.Error("[VALIDATOR SET MONITOR] Error fetching validator count: ", err)
+		return false
+	}
+
+	drift := []string{}
+
+	signerIsValidator, err := x.isValidator(signer)
+	if err != nil {
+		log.Error("[VALIDATOR SET MONITOR] Error checking signer address: ", err)
+		return false
+	}
+	if !signerIsValidator {
+		drift = append(drift, "signer "+signer+" is not a validator")
+	}
+
+	configured := map[string]bool{}
+	for _, address := range app.Config.Ethereum.ValidatorAddresses {
+		address = strings.ToLower(address)
+		configured[address] = true
+		if address == signer {
+			continue
+		}
+		isValidator, err := x.isValidator(address)
+		if err != nil {
+			log.Error("[VALIDATOR SET MONITOR] Error checking validator address: ", err)
+			return false
+		}
+		if !isValidator {
+			drift = append(drift, "configured "+address+" is not a validator")
+		}
+	}
+
+	validators := []string{}
+	for address, isValidator := range x.validators {
+		if isValidator {
+			validators = append(validators, address)
+		}
+	}
+	sort.Strings(validators)
+	for _, address := range validators {
+		if !configured[address] {
+			drift = append(drift, address+" is a validator but not configured")
+		}
+	}
+
+	if count.Int64() != int64(len(app.Config.Ethereum.ValidatorAddresses)) {
+		drift = append(drift, fmt.Sprintf("validator count %s does not match the %d configured", count, len(app.Config.Ethereum.ValidatorAddresses)))
+	}
+
+	report := &models.ValidatorSetReport{
+		SignerAddress:     signer,
+		SignerIsValidator: signerIsValidator,
+		ValidatorCount:    count.String(),
+		Validators:        validators,
+		Drift:             drift,
+		InSync:            len(drift) == 0,
+		CheckedAt:         time.Now(),
+	}
+	x.report = report
+
+	if !report.InSync {
+		log.WithField("drift", drift).Error("[VALIDATOR SET MONITOR] Validator set does not match the config")
+		app.AlertValidatorSetDrift(ValidatorSetMonitorName, *report)
+	} else {
+		log.Info("[VALIDATOR SET MONITOR] Validator set matches the config")
+	}
+
+	err = x.DB().UpsertOne(models.CollectionValidatorSets, bson.M{"_id": signer}, bson.M{"$set": report})
+	if err != nil {
+		log.Error("[VALIDATOR SET MONITOR] Error storing validator set report: ", err)
+		return false
+	}
+	return true
+}
+
+func NewValidatorSetMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Service {
+	if !app.Config.ValidatorSetMonitor.Enabled {
+		log.Debug("[VALIDATOR SET MONITOR] Disabled")
+		return app.NewEmptyService(wg)
+	}
+
+	log.Debug("[VALIDATOR SET MONITOR] Initializing")
+
+	x := &ValidatorSetMonitorRunner{
+		startBlockNumber: app.Config.Ethereum.StartBlockNumber,
+		validators:       map[string]bool{},
+	}
+
+	ethClient, err := eth.NewClient(x)
+	if err != nil {
+		log.Fatal("[VALIDATOR SET MONITOR] Error initializing ethereum client: ", err)
+	}
+	x.client = ethClient
+
+	log.Debug("[VALIDATOR SET MONITOR] Connecting to mint controller contract at: ", app.Config.Ethereum.MintControllerAddress)
+	mintControllerContract, err := autogen.NewMintController(common.HexToAddress(app.Config.Ethereum.MintControllerAddress), ethClient.GetClient())
+	if err != nil {
+		log.Fatal("[VALIDATOR SET MONITOR] Error initializing Mint Controller contract", err)
+	}
+	x.mintControllerContract = eth.NewMintControllerContract(mintControllerContract, x)
+	log.Debug("[VALIDATOR SET MONITOR] Connected to mint controller contract")
+
+	x.UpdateCurrentBlockNumber()
+
+	if x.startBlockNumber <= 0 {
+		log.Debug("[VALIDATOR SET MONITOR] Found invalid start block number, indexing validator events from the current block")
+		x.startBlockNumber = x.currentBlockNumber
+	} else {
+		// the block before the start block, so that its events are indexed
+		x.startBlockNumber--
+	}
+
+	log.Info("[VALIDATOR SET MONITOR] Initialized")
+
+	return app.NewRunnerService(ValidatorSetMonitorName, x, wg, app.Config.ValidatorSetMonitor.IntervalMillis.Duration())
+}
@@ -0,0 +1,198 @@
+package eth
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func expectValidatorEvents(t *testing.T, mockContract *eth.MockMintControllerContract, start uint64, end uint64, added []*autogen.MintControllerNewValidator, removed []*autogen.MintControllerRemovedValidator) {
+	addedFilter := eth.NewMockMintControllerNewValidatorIterator(t)
+	for _, event := range added {
+		addedFilter.EXPECT().Next().Return(true).Once()
+		addedFilter.EXPECT().Event().Return(event).Once()
+	}
+	addedFilter.EXPECT().Next().Return(false).Once()
+	addedFilter.EXPECT().Error().Return(nil)
+	addedFilter.EXPECT().Close().Return(nil)
+
+	removedFilter := eth.NewMockMintControllerRemovedValidatorIterator(t)
+	for _, event := range removed {
+		removedFilter.EXPECT().Next().Return(true).Once()
+		removedFilter.EXPECT().Event().Return(event).Once()
+	}
+	removedFilter.EXPECT().Next().Return(false).Once()
+	removedFilter.EXPECT().Error().Return(nil)
+	removedFilter.EXPECT().Close().Return(nil)
+
+	checkRange := func(opts *bind.FilterOpts, _ []common.Address) {
+		assert.Equal(t, start, opts.Start)
+		assert.Equal(t, end, *opts.End)
+	}
+	mockContract.EXPECT().FilterNewValidator(mock.Anything, mock.Anything).Return(addedFilter, nil).Run(checkRange).Once()
+	mockContract.EXPECT().FilterRemovedValidator(mock.Anything, mock.Anything).Return(removedFilter, nil).Run(checkRange).Once()
+}
+
+func expectValidators(mockContract *eth.MockMintControllerContract, validators map[string]bool) {
+	for address, isValidator := range validators {
+		mockContract.EXPECT().Validators(mock.Anything, common.HexToAddress(address)).Return(isValidator, nil).Once()
+	}
+}
+
+func TestValidatorSetMonitor(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	other := "0x000000000000000000000000000000000000abcd"
+	unknown := "0x000000000000000000000000000000000000dcba"
+
+	setup := func(t *testing.T) (*eth.MockEthereumClient, *eth.MockMintControllerContract, *app.MockDatabase, *ValidatorSetMonitorRunner) {
+		mockClient := eth.NewMockEthereumClient(t)
+		mockContract := eth.NewMockMintControllerContract(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.PrivateKey = hexutil.Encode(crypto.FromECDSA(key))[2:]
+		app.Config.Ethereum.ValidatorAddresses = []string{signer, other}
+		t.Cleanup(func() { app.Config = models.Config{} })
+		x := &ValidatorSetMonitorRunner{
+			startBlockNumber:       100,
+			client:                 mockClient,
+			mintControllerContract: mockContract,
+			validators:             map[string]bool{},
+		}
+		return mockClient, mockContract, mockDB, x
+	}
+
+	expectReport := func(mockDB *app.MockDatabase, inSync bool) {
+		mockDB.EXPECT().UpsertOne(models.CollectionValidatorSets, bson.M{"_id": signer}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, inSync, update.(bson.M)["$set"].(*models.ValidatorSetReport).InSync)
+			}).Once()
+	}
+
+	t.Run("In sync", func(t *testing.T) {
+		mockClient, mockContract, mockDB, x := setup(t)
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(110), nil).Once()
+		expectValidatorEvents(t, mockContract, 101, 110,
+			[]*autogen.MintControllerNewValidator{
+				{Validator: common.HexToAddress(signer), Raw: types.Log{BlockNumber: 102}},
+				{Validator: common.HexToAddress(other), Raw: types.Log{BlockNumber: 103}},
+			}, nil)
+		mockContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(2), nil).Once()
+		expectValidators(mockContract, map[string]bool{signer: true, other: true})
+		expectReport(mockDB, true)
+
+		x.Run()
+
+		status := x.Status()
+		assert.False(t, status.Failed)
+		assert.Equal(t, "110", status.EthBlockNumber)
+		assert.True(t, status.ValidatorSet.InSync)
+		assert.True(t, status.ValidatorSet.SignerIsValidator)
+		assert.ElementsMatch(t, []string{signer, other}, status.ValidatorSet.Validators)
+	})
+
+	t.Run("Signer removed", func(t *testing.T) {
+		mockClient, mockContract, mockDB, x := setup(t)
+		x.validators = map[string]bool{signer: true, other: true}
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(110), nil).Once()
+		expectValidatorEvents(t, mockContract, 101, 110, nil,
+			[]*autogen.MintControllerRemovedValidator{
+				{Validator: common.HexToAddress(signer), Raw: types.Log{BlockNumber: 105}},
+			})
+		mockContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(1), nil).Once()
+		expectValidators(mockContract, map[string]bool{signer: false, other: true})
+		expectReport(mockDB, false)
+
+		x.Run()
+
+		report := x.Status().ValidatorSet
+		assert.False(t, x.Status().Failed)
+		assert.False(t, report.InSync)
+		assert.False(t, report.SignerIsValidator)
+		assert.Equal(t, []string{other}, report.Validators)
+		assert.Equal(t, []string{
+			"signer " + signer + " is not a validator",
+			"validator count 1 does not match the 2 configured",
+		}, report.Drift)
+	})
+
+	t.Run("Unexpected validator", func(t *testing.T) {
+		mockClient, mockContract, mockDB, x := setup(t)
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(110), nil).Once()
+		expectValidatorEvents(t, mockContract, 101, 110,
+			[]*autogen.MintControllerNewValidator{
+				{Validator: common.HexToAddress(unknown), Raw: types.Log{BlockNumber: 104}},
+				{Validator: common.HexToAddress(other), Raw: types.Log{BlockNumber: 104, Index: 2, Removed: true}},
+			}, nil)
+		mockContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(2), nil).Once()
+		expectValidators(mockContract, map[string]bool{signer: true, other: false})
+		expectReport(mockDB, false)
+
+		x.Run()
+
+		assert.Equal(t, []string{
+			"configured " + other + " is not a validator",
+			unknown + " is a validator but not configured",
+		}, x.Status().ValidatorSet.Drift)
+	})
+
+	t.Run("Error fetching events", func(t *testing.T) {
+		mockClient, mockContract, mockDB, x := setup(t)
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(110), nil).Once()
+		mockContract.EXPECT().FilterNewValidator(mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+
+		x.Run()
+
+		assert.True(t, x.Status().Failed)
+		assert.Equal(t, "100", x.Status().EthBlockNumber)
+		mockDB.AssertNotCalled(t, "UpsertOne", models.CollectionValidatorSets, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error storing report", func(t *testing.T) {
+		mockClient, mockContract, mockDB, x := setup(t)
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil).Once()
+		mockContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(2), nil).Once()
+		expectValidators(mockContract, map[string]bool{signer: true, other: true})
+		mockDB.EXPECT().UpsertOne(models.CollectionValidatorSets, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+
+		x.Run()
+
+		assert.True(t, x.Status().Failed)
+		assert.True(t, x.Status().ValidatorSet.InSync)
+	})
+
+	t.Run("Error checking validators keeps the report", func(t *testing.T) {
+		mockClient, mockContract, _, x := setup(t)
+		x.report = &models.ValidatorSetReport{InSync: true}
+		mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil).Once()
+		mockContract.EXPECT().ValidatorCount(mock.Anything).Return(nil, errors.New("error")).Once()
+
+		x.Run()
+
+		assert.True(t, x.Status().Failed)
+		assert.True(t, x.Status().ValidatorSet.InSync)
+	})
+}
+
+func TestNewValidatorSetMonitor(t *testing.T) {
+	app.Config.ValidatorSetMonitor.Enabled = false
+
+	service := NewValidatorSetMonitor(&sync.WaitGroup{}, models.ServiceHealth{})
+
+	assert.Equal(t, app.EmptyServiceName, service.Health().Name)
+}
@@ -8,8 +8,10 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/cli"
 	"github.com/dan13ram/wpokt-validator/eth"
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/dan13ram/wpokt-validator/pokt"
@@ -19,12 +21,32 @@ import (
 type ServiceFactory = func(*sync.WaitGroup, models.ServiceHealth) app.Service
 
 var ServiceFactoryMap map[string]ServiceFactory = map[string]ServiceFactory{
-	pokt.MintMonitorName:  pokt.NewMintMonitor,
-	pokt.BurnSignerName:   pokt.NewBurnSigner,
-	pokt.BurnExecutorName: pokt.NewBurnExecutor,
-	eth.BurnMonitorName:   eth.NewBurnMonitor,
-	eth.MintSignerName:    eth.NewMintSigner,
-	eth.MintExecutorName:  eth.NewMintExecutor,
+	pokt.MintMonitorName:        pokt.NewMintMonitor,
+	pokt.BurnSignerName:         pokt.NewBurnSigner,
+	pokt.BurnExecutorName:       pokt.NewBurnExecutor,
+	eth.BurnMonitorName:         eth.NewBurnMonitor,
+	eth.MintSignerName:          eth.NewMintSigner,
+	eth.MintExecutorName:        eth.NewMintExecutor,
+	app.StuckDetectorName:       app.NewStuckDetector,
+	pokt.SolvencyCheckerName:    pokt.NewSolvencyChecker,
+	eth.ValidatorSetMonitorName: eth.NewValidatorSetMonitor,
+}
+
+// ServiceConfigMap maps each service to its section of the config
+var ServiceConfigMap map[string]string = map[string]string{
+	pokt.MintMonitorName:        "MintMonitor",
+	pokt.BurnSignerName:         "BurnSigner",
+	pokt.BurnExecutorName:       "BurnExecutor",
+	eth.BurnMonitorName:         "BurnMonitor",
+	eth.MintSignerName:          "MintSigner",
+	eth.MintExecutorName:        "MintExecutor",
+	app.StuckDetectorName:       "StuckDetector",
+	pokt.SolvencyCheckerName:    "SolvencyChecker",
+	eth.ValidatorSetMonitorName: "ValidatorSetMonitor",
+}
+
+type intervalSetter interface {
+	SetInterval(time.Duration)
 }
 
 func main() {
@@ -61,14 +83,27 @@ func main() {
 		}
 	}
 
+	if flag.NArg() > 0 {
+		// commands check the parts of the config they use, so an invalid config can still be inspected
+		app.LoadConfig(absConfigPath, absEnvPath)
+		app.InitLogger()
+		os.Exit(cli.Run(flag.Args()))
+	}
+
 	app.InitConfig(absConfigPath, absEnvPath)
 	app.InitLogger()
+	app.InitTracing()
+	app.InitAlerts()
+
 	app.InitDB()
 
 	pokt.ValidateNetwork()
 	eth.ValidateNetwork()
 
 	healthcheck := app.NewHealthCheck()
+	app.SetLogValidatorId(healthcheck.ValidatorId())
+
+	app.InitLeaderElection(healthcheck.ValidatorId())
 
 	serviceHealthMap := make(map[string]models.ServiceHealth)
 
@@ -80,7 +115,7 @@ func main() {
 		}
 	}
 
-	services := []app.Service{}
+	services := make(map[string]app.Service)
 	var wg sync.WaitGroup
 
 	for serviceName, NewService := range ServiceFactoryMap {
@@ -88,41 +123,111 @@ func main() {
 		if lastHealth, ok := serviceHealthMap[serviceName]; ok {
 			health = lastHealth
 		}
-		services = append(services, NewService(&wg, health))
+		services[serviceName] = NewService(&wg, health)
 	}
 
-	services = append(services, app.NewHealthService(healthcheck, &wg))
+	healthService := app.NewHealthService(healthcheck, &wg)
+	rotationService := app.NewSecretRotationService(&wg)
 
-	healthcheck.SetServices(services)
+	healthcheck.SetServices(serviceList(services, healthService))
 
-	wg.Add(len(services))
+	wg.Add(len(services) + 2)
 
 	for _, service := range services {
 		go service.Start()
 	}
+	go healthService.Start()
+	go rotationService.Start()
 
 	log.Info("[MAIN] Server started")
 
 	gracefulStop := make(chan os.Signal, 1)
-	done := make(chan bool, 1)
 	signal.Notify(gracefulStop, syscall.SIGINT, syscall.SIGTERM)
-	go waitForExitSignals(gracefulStop, done)
-	<-done
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	rotate := make(chan os.Signal, 1)
+	signal.Notify(rotate, syscall.SIGUSR1)
+
+	running := true
+	for running {
+		select {
+		case sig := <-gracefulStop:
+			log.Debug("[MAIN] Caught signal: ", sig)
+			running = false
+		case <-reload:
+			log.Info("[MAIN] Caught SIGHUP, reloading config")
+			previous, err := app.ReloadConfig(absConfigPath, absEnvPath)
+			if err != nil {
+				continue
+			}
+			applyConfig(previous, services, serviceHealthMap, healthService, &wg)
+			healthcheck.SetServices(serviceList(services, healthService))
+		case <-rotate:
+			log.Info("[MAIN] Caught SIGUSR1, rotating secrets")
+			app.RotateSecrets()
+		}
+	}
 
 	log.Debug("[MAIN] Stopping server gracefully")
 
-	for _, service := range services {
+	for _, service := range serviceList(services, healthService) {
 		service.Stop()
 	}
+	rotationService.Stop()
 
 	wg.Wait()
 
+	if app.Leader != nil {
+		app.Leader.Resign()
+	}
+
+	app.ShutdownTracing()
 	app.DB.Disconnect()
 	log.Info("[MAIN] Server stopped")
 }
 
-func waitForExitSignals(gracefulStop chan os.Signal, done chan bool) {
-	sig := <-gracefulStop
-	log.Debug("[MAIN] Caught signal: ", sig)
-	done <- true
+func serviceList(services map[string]app.Service, healthService app.Service) []app.Service {
+	list := []app.Service{}
+	for _, service := range services {
+		list = append(list, service)
+	}
+	return append(list, healthService)
+}
+
+// applyConfig updates running services after a config reload. Services that were enabled or
+// disabled are restarted from their last health, and the others pick up their new interval.
+func applyConfig(
+	previous models.Config,
+	services map[string]app.Service,
+	serviceHealthMap map[string]models.ServiceHealth,
+	healthService app.Service,
+	wg *sync.WaitGroup,
+) {
+	for serviceName, configPath := range ServiceConfigMap {
+		before := app.ServiceConfig(previous, configPath)
+		after := app.ServiceConfig(app.Config, configPath)
+
+		if before.Enabled != after.Enabled {
+			log.Infof("[MAIN] Restarting %s with enabled: %t", serviceName, after.Enabled)
+			if health := services[serviceName].Health(); health.Name == serviceName {
+				serviceHealthMap[serviceName] = health
+			}
+			services[serviceName].Stop()
+			// the replacement must not run alongside a run of the old service that is still in progress
+			<-services[serviceName].Done()
+
+			wg.Add(1)
+			services[serviceName] = ServiceFactoryMap[serviceName](wg, serviceHealthMap[serviceName])
+			go services[serviceName].Start()
+			continue
+		}
+
+		if service, ok := services[serviceName].(intervalSetter); ok && before.IntervalMillis != after.IntervalMillis {
+			service.SetInterval(after.IntervalMillis.Duration())
+		}
+	}
+
+	if service, ok := healthService.(intervalSetter); ok && previous.HealthCheck.IntervalMillis != app.Config.HealthCheck.IntervalMillis {
+		service.SetInterval(app.Config.HealthCheck.IntervalMillis.Duration())
+	}
 }
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	AlertConditionAwaitingApproval = "awaiting_approval"
+	AlertConditionQuarantined      = "quarantined"
+	AlertConditionValidatorSet     = "validator_set_drift"
+	AlertConditionBreakerTripped   = "breaker_tripped"
+	AlertConditionServiceUnhealthy = "service_unhealthy"
+	AlertConditionStuckDocument    = "stuck_document"
+	AlertConditionReturnTxFailed   = "return_tx_failed"
+	AlertConditionSolvency         = "solvency_discrepancy"
+	AlertConditionValidationFailed = "validation_failed"
+	AlertConditionUnexpectedMint   = "unexpected_mint"
+
+	AlertSeverityCritical = "critical"
+	AlertSeverityWarning  = "warning"
+)
+
+type Alert struct {
+	Key         string            `json:"key"`
+	Condition   string            `json:"condition"`
+	Severity    string            `json:"severity"`
+	Service     string            `json:"service"`
+	Summary     string            `json:"summary"`
+	Details     map[string]string `json:"details,omitempty"`
+	ValidatorId string            `json:"validator_id"`
+	Hostname    string            `json:"hostname"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Approval is an operator's approval of a transfer above the approval threshold, signed with
+// the operator's Ethereum key over the approval message of the transfer
+type Approval struct {
+	Operator   string    `bson:"operator" json:"operator"`
+	Signature  string    `bson:"signature" json:"signature"`
+	ApprovedAt time.Time `bson:"approved_at" json:"approved_at"`
+}
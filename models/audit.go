@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionAuditLogs = "auditLogs"
+
+	AuditActionRetry      = "retry"
+	AuditActionMarkFailed = "mark_failed"
+	AuditActionRequeue    = "requeue"
+
+	AuditActionForceMarkFailed = "force_mark_failed"
+
+	AuditActionOfflineImport = "offline_import"
+
+	AuditActionRotateSecret        = "rotate_secret"
+	AuditActionRotateSecretRefused = "rotate_secret_refused"
+
+	AuditActionTripBreaker  = "trip_breaker"
+	AuditActionResetBreaker = "reset_breaker"
+
+	AuditActionApprove = "approve"
+
+	AuditActionRepinTxFee = "repin_tx_fee"
+)
+
+type AuditLog struct {
+	Id         *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	Action     string              `bson:"action" json:"action"`
+	Operator   string              `bson:"operator" json:"operator"`
+	Hostname   string              `bson:"hostname" json:"hostname"`
+	Collection string              `bson:"collection" json:"collection"`
+	DocumentId string              `bson:"document_id" json:"document_id"`
+	Field      string              `bson:"field,omitempty" json:"field,omitempty"`
+	StatusFrom string              `bson:"status_from" json:"status_from"`
+	StatusTo   string              `bson:"status_to" json:"status_to"`
+	Reason     string              `bson:"reason" json:"reason"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+}
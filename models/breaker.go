@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+const (
+	CollectionCircuitBreakers = "circuitBreakers"
+
+	// SigningBreakerId is the breaker that stops every validator from signing mints and burns
+	SigningBreakerId = "signing"
+
+	BreakerStatusTripped = "tripped"
+	BreakerStatusReset   = "reset"
+
+	BreakerTriggerSolvency       = "solvency_mismatch"
+	BreakerTriggerUnexpectedMint = "unexpected_mint"
+	BreakerTriggerVolumeLimit    = "volume_limit"
+	BreakerTriggerPaused         = "contract_paused"
+	BreakerTriggerManual         = "manual"
+)
+
+type CircuitBreaker struct {
+	Id          string    `bson:"_id" json:"_id"`
+	Tripped     bool      `bson:"tripped" json:"tripped"`
+	Trigger     string    `bson:"trigger" json:"trigger"`
+	Reason      string    `bson:"reason" json:"reason"`
+	TrippedBy   string    `bson:"tripped_by" json:"tripped_by"`
+	TrippedAt   time.Time `bson:"tripped_at" json:"tripped_at"`
+	ResetBy     string    `bson:"reset_by" json:"reset_by"`
+	ResetReason string    `bson:"reset_reason" json:"reset_reason"`
+	ResetAt     time.Time `bson:"reset_at" json:"reset_at"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at"`
+}
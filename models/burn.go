@@ -11,21 +11,31 @@ const (
 )
 
 type Burn struct {
-	Id               *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
-	TransactionHash  string              `bson:"transaction_hash" json:"transaction_hash"`
-	LogIndex         string              `bson:"log_index" json:"log_index"`
-	BlockNumber      string              `bson:"block_number" json:"block_number"`
-	Confirmations    string              `bson:"confirmations" json:"confirmations"`
-	SenderAddress    string              `bson:"sender_address" json:"sender_address"`
-	SenderChainId    string              `bson:"sender_chain_id" json:"sender_chain_id"`
-	RecipientAddress string              `bson:"recipient_address" json:"recipient_address"`
-	RecipientChainId string              `bson:"recipient_chain_id" json:"recipient_chain_id"`
-	WPOKTAddress     string              `bson:"wpokt_address" json:"wpokt_address"`
-	Amount           string              `bson:"amount" json:"amount"`
-	CreatedAt        time.Time           `bson:"created_at" json:"created_at"`
-	UpdatedAt        time.Time           `bson:"updated_at" json:"updated_at"`
-	Status           string              `bson:"status" json:"status"`
-	ReturnTx         string              `bson:"return_tx" json:"return_tx"`
-	Signers          []string            `bson:"signers" json:"signers"`
-	ReturnTxHash     string              `bson:"return_tx_hash" json:"return_tx_hash"`
+	Id               *primitive.ObjectID  `bson:"_id,omitempty" json:"_id"`
+	TransactionHash  string               `bson:"transaction_hash" json:"transaction_hash"`
+	LogIndex         string               `bson:"log_index" json:"log_index"`
+	BlockNumber      string               `bson:"block_number" json:"block_number"`
+	Confirmations    string               `bson:"confirmations" json:"confirmations"`
+	SenderAddress    string               `bson:"sender_address" json:"sender_address"`
+	SenderChainId    string               `bson:"sender_chain_id" json:"sender_chain_id"`
+	RecipientAddress string               `bson:"recipient_address" json:"recipient_address"`
+	RecipientChainId string               `bson:"recipient_chain_id" json:"recipient_chain_id"`
+	WPOKTAddress     string               `bson:"wpokt_address" json:"wpokt_address"`
+	Amount           string               `bson:"amount" json:"amount"`
+	CreatedAt        time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time            `bson:"updated_at" json:"updated_at"`
+	Status           string               `bson:"status" json:"status"`
+	ReturnTx         string               `bson:"return_tx" json:"return_tx"`
+	Signers          []string             `bson:"signers" json:"signers"`
+	TxFee            string               `bson:"tx_fee" json:"tx_fee"`
+	ReturnTxHash     string               `bson:"return_tx_hash" json:"return_tx_hash"`
+	LockToken        int64                `bson:"lock_token" json:"lock_token"`
+	Attempts         map[string]int64     `bson:"attempts,omitempty" json:"attempts,omitempty"`
+	LastError        string               `bson:"last_error" json:"last_error"`
+	NextAttemptAt    map[string]time.Time `bson:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty"`
+	PreviousStatus   string               `bson:"previous_status" json:"previous_status"`
+	CountedAt        time.Time            `bson:"counted_at" json:"counted_at"`
+	HeldReason       string               `bson:"held_reason" json:"held_reason"`
+	QuarantineReason string               `bson:"quarantine_reason" json:"quarantine_reason"`
+	Approvals        []Approval           `bson:"approvals" json:"approvals"`
 }
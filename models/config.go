@@ -1,67 +1,193 @@
 package models
 
+// Config is read from the config file and then overridden by env variables. The env
+// variable of a field is the env tags of the field and its parents joined with "_".
 type Config struct {
-	GoogleSecretManager GoogleSecretManagerConfig `yaml:"google_secret_manager" json:"google_secret_manager"`
-	HealthCheck         HealthCheckConfig         `yaml:"health_check" json:"health_check"`
-	Logger              LoggerConfig              `yaml:"logger" json:"logger"`
-	MongoDB             MongoConfig               `yaml:"mongodb" json:"mongo_db"`
-	Ethereum            EthereumConfig            `yaml:"ethereum" json:"ethereum"`
-	Pocket              PocketConfig              `yaml:"pocket" json:"pocket"`
-	MintMonitor         ServiceConfig             `yaml:"mint_monitor" json:"mint_monitor"`
-	MintSigner          ServiceConfig             `yaml:"mint_signer" json:"mint_signer"`
-	MintExecutor        ServiceConfig             `yaml:"mint_executor" json:"mint_executor"`
-	BurnMonitor         ServiceConfig             `yaml:"burn_monitor" json:"burn_monitor"`
-	BurnSigner          ServiceConfig             `yaml:"burn_signer" json:"burn_signer"`
-	BurnExecutor        ServiceConfig             `yaml:"burn_executor" json:"burn_executor"`
+	GoogleSecretManager GoogleSecretManagerConfig `yaml:"google_secret_manager" json:"google_secret_manager" env:"GOOGLE"`
+	HashiCorpVault      HashiCorpVaultConfig      `yaml:"hashicorp_vault" json:"hashicorp_vault" env:"VAULT"`
+	HealthCheck         HealthCheckConfig         `yaml:"health_check" json:"health_check" env:"HEALTH_CHECK"`
+	LeaderElection      LeaderElectionConfig      `yaml:"leader_election" json:"leader_election" env:"LEADER_ELECTION"`
+	Logger              LoggerConfig              `yaml:"logger" json:"logger" env:"LOG"`
+	Retry               RetryConfig               `yaml:"retry" json:"retry" env:"RETRY"`
+	Secrets             SecretsConfig             `yaml:"secrets" json:"secrets" env:"SECRETS"`
+	Tracing             TracingConfig             `yaml:"tracing" json:"tracing" env:"TRACING"`
+	Alerts              AlertsConfig              `yaml:"alerts" json:"alerts" env:"ALERTS"`
+	MongoDB             MongoConfig               `yaml:"mongodb" json:"mongo_db" env:"MONGODB"`
+	Ethereum            EthereumConfig            `yaml:"ethereum" json:"ethereum" env:"ETH"`
+	Pocket              PocketConfig              `yaml:"pocket" json:"pocket" env:"POKT"`
+	MintMonitor         ServiceConfig             `yaml:"mint_monitor" json:"mint_monitor" env:"MINT_MONITOR"`
+	MintSigner          ServiceConfig             `yaml:"mint_signer" json:"mint_signer" env:"MINT_SIGNER"`
+	MintExecutor        ServiceConfig             `yaml:"mint_executor" json:"mint_executor" env:"MINT_EXECUTOR"`
+	BurnMonitor         ServiceConfig             `yaml:"burn_monitor" json:"burn_monitor" env:"BURN_MONITOR"`
+	BurnSigner          ServiceConfig             `yaml:"burn_signer" json:"burn_signer" env:"BURN_SIGNER"`
+	BurnExecutor        ServiceConfig             `yaml:"burn_executor" json:"burn_executor" env:"BURN_EXECUTOR"`
+	StuckDetector       ServiceConfig             `yaml:"stuck_detector" json:"stuck_detector" env:"STUCK_DETECTOR"`
+	StuckTransfers      StuckTransfersConfig      `yaml:"stuck_transfers" json:"stuck_transfers" env:"STUCK_TRANSFERS"`
+	SolvencyChecker     ServiceConfig             `yaml:"solvency_checker" json:"solvency_checker" env:"SOLVENCY_CHECKER"`
+	ValidatorSetMonitor ServiceConfig             `yaml:"validator_set_monitor" json:"validator_set_monitor" env:"VALIDATOR_SET_MONITOR"`
+	Solvency            SolvencyConfig            `yaml:"solvency" json:"solvency" env:"SOLVENCY"`
+	CircuitBreaker      CircuitBreakerConfig      `yaml:"circuit_breaker" json:"circuit_breaker" env:"CIRCUIT_BREAKER"`
+	VolumeLimits        VolumeLimitsConfig        `yaml:"volume_limits" json:"volume_limits" env:"VOLUME_LIMITS"`
+	Approvals           ApprovalsConfig           `yaml:"approvals" json:"approvals" env:"APPROVALS"`
+	Screening           ScreeningConfig           `yaml:"screening" json:"screening" env:"SCREENING"`
 }
 
 type GoogleSecretManagerConfig struct {
-	Enabled         bool   `yaml:"enabled" json:"enabled"`
-	MongoSecretName string `yaml:"mongo_secret_name" json:"mongo_secret_name"`
-	PoktSecretName  string `yaml:"pokt_secret_name" json:"pokt_secret_name"`
-	EthSecretName   string `yaml:"eth_secret_name" json:"eth_secret_name"`
+	Enabled         bool   `yaml:"enabled" json:"enabled" env:"SECRET_MANAGER_ENABLED"`
+	MongoSecretName string `yaml:"mongo_secret_name" json:"mongo_secret_name" env:"MONGO_SECRET_NAME"`
+	PoktSecretName  string `yaml:"pokt_secret_name" json:"pokt_secret_name" env:"POKT_SECRET_NAME"`
+	EthSecretName   string `yaml:"eth_secret_name" json:"eth_secret_name" env:"ETH_SECRET_NAME"`
+}
+
+type HashiCorpVaultConfig struct {
+	Address       string `yaml:"address" json:"address" env:"ADDR"`
+	Token         string `yaml:"token" json:"token" env:"TOKEN"`
+	Namespace     string `yaml:"namespace" json:"namespace" env:"NAMESPACE"`
+	TimeoutMillis Millis `yaml:"timeout_ms" json:"timeout_ms" env:"TIMEOUT_MS"`
 }
 
 type HealthCheckConfig struct {
-	IntervalMillis int64 `yaml:"interval_ms" json:"interval_ms"`
-	ReadLastHealth bool  `yaml:"read_last_health" json:"read_last_health"`
+	IntervalMillis Millis `yaml:"interval_ms" json:"interval_ms" env:"INTERVAL_MS"`
+	ReadLastHealth bool   `yaml:"read_last_health" json:"read_last_health" env:"READ_LAST_HEALTH"`
+}
+
+type LeaderElectionConfig struct {
+	Enabled        bool   `yaml:"enabled" json:"enabled" env:"ENABLED"`
+	Scope          string `yaml:"scope" json:"scope" env:"SCOPE"`
+	LeaseTTLMillis Millis `yaml:"lease_ttl_ms" json:"lease_ttl_ms" env:"LEASE_TTL_MS"`
 }
 
 type LoggerConfig struct {
-	Level string `yaml:"level" json:"level"`
+	Level      string `yaml:"level" json:"level" env:"LEVEL"`
+	Format     string `yaml:"format" json:"format" env:"FORMAT"`
+	File       string `yaml:"file" json:"file" env:"FILE"`
+	MaxSizeMB  int64  `yaml:"max_size_mb" json:"max_size_mb" env:"MAX_SIZE_MB"`
+	MaxBackups int64  `yaml:"max_backups" json:"max_backups" env:"MAX_BACKUPS"`
+}
+
+type RetryConfig struct {
+	MaxAttempts       int64  `yaml:"max_attempts" json:"max_attempts" env:"MAX_ATTEMPTS"`
+	BackoffBaseMillis Millis `yaml:"backoff_base_ms" json:"backoff_base_ms" env:"BACKOFF_BASE_MS"`
+	BackoffMaxMillis  Millis `yaml:"backoff_max_ms" json:"backoff_max_ms" env:"BACKOFF_MAX_MS"`
+}
+
+type SecretsConfig struct {
+	RotationIntervalMillis Millis `yaml:"rotation_interval_ms" json:"rotation_interval_ms" env:"ROTATION_INTERVAL_MS"`
+	// EthereumAddress is the address a rotated ethereum key may move this validator to
+	EthereumAddress string `yaml:"ethereum_address" json:"ethereum_address" env:"ETHEREUM_ADDRESS"`
+}
+
+type TracingConfig struct {
+	Enabled       bool   `yaml:"enabled" json:"enabled" env:"ENABLED"`
+	Endpoint      string `yaml:"endpoint" json:"endpoint" env:"ENDPOINT"`
+	TimeoutMillis Millis `yaml:"timeout_ms" json:"timeout_ms" env:"TIMEOUT_MS"`
+}
+
+// StuckTransfersConfig is how long a document may stay in each status before it is reported
+// as stuck, zero disables the check for that status
+type StuckTransfersConfig struct {
+	PendingMillis   Millis `yaml:"pending_ms" json:"pending_ms" env:"PENDING_MS"`
+	ConfirmedMillis Millis `yaml:"confirmed_ms" json:"confirmed_ms" env:"CONFIRMED_MS"`
+	SignedMillis    Millis `yaml:"signed_ms" json:"signed_ms" env:"SIGNED_MS"`
+	SubmittedMillis Millis `yaml:"submitted_ms" json:"submitted_ms" env:"SUBMITTED_MS"`
+}
+
+// SolvencyConfig is how far, in uPOKT, the vault balance may drift from the wPOKT it backs
+type SolvencyConfig struct {
+	Tolerance int64 `yaml:"tolerance" json:"tolerance" env:"TOLERANCE"`
+}
+
+// CircuitBreakerConfig selects the anomalies that stop every validator from signing
+type CircuitBreakerConfig struct {
+	SolvencyMismatch bool `yaml:"solvency_mismatch" json:"solvency_mismatch" env:"SOLVENCY_MISMATCH"`
+	UnexpectedMint   bool `yaml:"unexpected_mint" json:"unexpected_mint" env:"UNEXPECTED_MINT"`
+	VolumeLimit      bool `yaml:"volume_limit" json:"volume_limit" env:"VOLUME_LIMIT"`
+	Paused           bool `yaml:"paused" json:"paused" env:"PAUSED"`
+}
+
+// VolumeLimitsConfig caps the uPOKT signed for in rolling windows, separately for mints and
+// for burn returns
+type VolumeLimitsConfig struct {
+	Mints VolumeLimitConfig `yaml:"mints" json:"mints" env:"MINTS"`
+	Burns VolumeLimitConfig `yaml:"burns" json:"burns" env:"BURNS"`
+}
+
+// VolumeLimitConfig is the uPOKT that may be signed for per recipient, per sender and in
+// total over the last hour and day, zero disables a limit
+type VolumeLimitConfig struct {
+	RecipientHourly int64 `yaml:"recipient_hourly" json:"recipient_hourly" env:"RECIPIENT_HOURLY"`
+	RecipientDaily  int64 `yaml:"recipient_daily" json:"recipient_daily" env:"RECIPIENT_DAILY"`
+	SenderHourly    int64 `yaml:"sender_hourly" json:"sender_hourly" env:"SENDER_HOURLY"`
+	SenderDaily     int64 `yaml:"sender_daily" json:"sender_daily" env:"SENDER_DAILY"`
+	GlobalHourly    int64 `yaml:"global_hourly" json:"global_hourly" env:"GLOBAL_HOURLY"`
+	GlobalDaily     int64 `yaml:"global_daily" json:"global_daily" env:"GLOBAL_DAILY"`
+}
+
+// ApprovalsConfig makes mints and burns above a threshold, in uPOKT, wait for a quorum of
+// operator approvals before they are signed. A threshold of zero disables approvals.
+type ApprovalsConfig struct {
+	MintThreshold     int64    `yaml:"mint_threshold" json:"mint_threshold" env:"MINT_THRESHOLD"`
+	BurnThreshold     int64    `yaml:"burn_threshold" json:"burn_threshold" env:"BURN_THRESHOLD"`
+	Quorum            int64    `yaml:"quorum" json:"quorum" env:"QUORUM"`
+	OperatorAddresses []string `yaml:"operator_addresses" json:"operator_addresses" env:"OPERATOR_ADDRESSES"`
+}
+
+// ScreeningConfig screens the senders and recipients of mints and burns against list files
+// with one address per line. Addresses on the allow list are never quarantined.
+type ScreeningConfig struct {
+	Enabled       bool   `yaml:"enabled" json:"enabled" env:"ENABLED"`
+	DenyListFile  string `yaml:"deny_list_file" json:"deny_list_file" env:"DENY_LIST_FILE"`
+	AllowListFile string `yaml:"allow_list_file" json:"allow_list_file" env:"ALLOW_LIST_FILE"`
+}
+
+type AlertsConfig struct {
+	Enabled               bool   `yaml:"enabled" json:"enabled" env:"ENABLED"`
+	WebhookURL            string `yaml:"webhook_url" json:"webhook_url" env:"WEBHOOK_URL"`
+	SlackWebhookURL       string `yaml:"slack_webhook_url" json:"slack_webhook_url" env:"SLACK_WEBHOOK_URL"`
+	PagerDutyRoutingKey   string `yaml:"pagerduty_routing_key" json:"pagerduty_routing_key" env:"PAGERDUTY_ROUTING_KEY"`
+	PagerDutyURL          string `yaml:"pagerduty_url" json:"pagerduty_url" env:"PAGERDUTY_URL"`
+	TimeoutMillis         Millis `yaml:"timeout_ms" json:"timeout_ms" env:"TIMEOUT_MS"`
+	DedupWindowMillis     Millis `yaml:"dedup_window_ms" json:"dedup_window_ms" env:"DEDUP_WINDOW_MS"`
+	RateLimit             int64  `yaml:"rate_limit" json:"rate_limit" env:"RATE_LIMIT"`
+	RateLimitWindowMillis Millis `yaml:"rate_limit_window_ms" json:"rate_limit_window_ms" env:"RATE_LIMIT_WINDOW_MS"`
+	UnhealthyRuns         int64  `yaml:"unhealthy_runs" json:"unhealthy_runs" env:"UNHEALTHY_RUNS"`
+	StuckAfterMillis      Millis `yaml:"stuck_after_ms" json:"stuck_after_ms" env:"STUCK_AFTER_MS"`
+	ReturnTxFailed        bool   `yaml:"return_tx_failed" json:"return_tx_failed" env:"RETURN_TX_FAILED"`
+	ValidationFailed      bool   `yaml:"validation_failed" json:"validation_failed" env:"VALIDATION_FAILED"`
 }
 
 type MongoConfig struct {
-	URI           string `yaml:"uri" json:"uri"`
-	Database      string `yaml:"database" json:"database"`
-	TimeoutMillis int64  `yaml:"timeout_ms" json:"timeout_ms"`
+	URI                     string `yaml:"uri" json:"uri" env:"URI"`
+	Database                string `yaml:"database" json:"database" env:"DATABASE"`
+	TimeoutMillis           Millis `yaml:"timeout_ms" json:"timeout_ms" env:"TIMEOUT_MS"`
+	LockTTLMillis           Millis `yaml:"lock_ttl_ms" json:"lock_ttl_ms" env:"LOCK_TTL_MS"`
+	LockRenewIntervalMillis Millis `yaml:"lock_renew_interval_ms" json:"lock_renew_interval_ms" env:"LOCK_RENEW_INTERVAL_MS"`
 }
 
 type EthereumConfig struct {
-	StartBlockNumber      int64    `yaml:"start_block_number" json:"start_block_number"`
-	Confirmations         int64    `yaml:"confirmations" json:"confirmations"`
-	PrivateKey            string   `yaml:"private_key" json:"private_key"`
-	RPCURL                string   `yaml:"rpc_url" json:"rpcurl"`
-	RPCTimeoutMillis      int64    `yaml:"rpc_timeout_ms" json:"rpc_time_out_ms"`
-	ChainId               string   `yaml:"chain_id" json:"chain_id"`
-	WrappedPocketAddress  string   `yaml:"wrapped_pocket_address" json:"wrapped_pocket_address"`
-	MintControllerAddress string   `yaml:"mint_controller_address" json:"mint_controller_address"`
-	ValidatorAddresses    []string `yaml:"validator_addresses" json:"validator_addresses"`
+	StartBlockNumber      int64    `yaml:"start_block_number" json:"start_block_number" env:"START_BLOCK_NUMBER"`
+	Confirmations         int64    `yaml:"confirmations" json:"confirmations" env:"CONFIRMATIONS"`
+	PrivateKey            string   `yaml:"private_key" json:"private_key" env:"PRIVATE_KEY"`
+	RPCURL                string   `yaml:"rpc_url" json:"rpcurl" env:"RPC_URL"`
+	RPCTimeoutMillis      Millis   `yaml:"rpc_timeout_ms" json:"rpc_time_out_ms" env:"RPC_TIMEOUT_MS"`
+	ChainId               string   `yaml:"chain_id" json:"chain_id" env:"CHAIN_ID"`
+	WrappedPocketAddress  string   `yaml:"wrapped_pocket_address" json:"wrapped_pocket_address" env:"WRAPPED_POCKET_ADDRESS"`
+	MintControllerAddress string   `yaml:"mint_controller_address" json:"mint_controller_address" env:"MINT_CONTROLLER_ADDRESS"`
+	ValidatorAddresses    []string `yaml:"validator_addresses" json:"validator_addresses" env:"VALIDATOR_ADDRESSES"`
 }
 
 type PocketConfig struct {
-	StartHeight        int64    `yaml:"start_height" json:"start_height"`
-	Confirmations      int64    `yaml:"confirmations" json:"confirmations"`
-	RPCURL             string   `yaml:"rpc_url" json:"rpcurl"`
-	PrivateKey         string   `yaml:"private_key" json:"private_key"`
-	RPCTimeoutMillis   int64    `yaml:"rpc_timeout_ms" json:"rpc_time_out_ms"`
-	ChainId            string   `yaml:"chain_id" json:"chain_id"`
-	TxFee              int64    `yaml:"tx_fee" json:"tx_fee"`
-	VaultAddress       string   `yaml:"vault_address" json:"vault_address"`
-	MultisigPublicKeys []string `yaml:"multisig_public_keys" json:"multisig_public_keys"`
+	StartHeight        int64    `yaml:"start_height" json:"start_height" env:"START_HEIGHT"`
+	Confirmations      int64    `yaml:"confirmations" json:"confirmations" env:"CONFIRMATIONS"`
+	RPCURL             string   `yaml:"rpc_url" json:"rpcurl" env:"RPC_URL"`
+	PrivateKey         string   `yaml:"private_key" json:"private_key" env:"PRIVATE_KEY"`
+	RPCTimeoutMillis   Millis   `yaml:"rpc_timeout_ms" json:"rpc_time_out_ms" env:"RPC_TIMEOUT_MS"`
+	ChainId            string   `yaml:"chain_id" json:"chain_id" env:"CHAIN_ID"`
+	VaultAddress       string   `yaml:"vault_address" json:"vault_address" env:"VAULT_ADDRESS"`
+	MultisigPublicKeys []string `yaml:"multisig_public_keys" json:"multisig_public_keys" env:"MULTISIG_PUBLIC_KEYS"`
 }
 
 type ServiceConfig struct {
-	Enabled        bool  `yaml:"enabled" json:"enabled"`
-	IntervalMillis int64 `yaml:"interval_ms" json:"interval_ms"`
+	Enabled        bool   `yaml:"enabled" json:"enabled" env:"ENABLED"`
+	IntervalMillis Millis `yaml:"interval_ms" json:"interval_ms" env:"INTERVAL_MS"`
 }
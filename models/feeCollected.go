@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionFeesCollected = "feesCollected"
+)
+
+// FeeCollected is a FeeCollected event of the wpokt contract, emitted in the transaction of a
+// mint that the contract took a fee from
+type FeeCollected struct {
+	Id              *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	TransactionHash string              `bson:"transaction_hash" json:"transaction_hash"`
+	LogIndex        string              `bson:"log_index" json:"log_index"`
+	BlockNumber     string              `bson:"block_number" json:"block_number"`
+	WPOKTAddress    string              `bson:"wpokt_address" json:"wpokt_address"`
+	FeeCollector    string              `bson:"fee_collector" json:"fee_collector"`
+	Amount          string              `bson:"amount" json:"amount"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+}
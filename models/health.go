@@ -8,6 +8,9 @@ import (
 
 const (
 	CollectionHealthChecks = "healthchecks"
+
+	// CollectionValidatorSets holds the last validator set report of every signer, by signer address
+	CollectionValidatorSets = "validatorSets"
 )
 
 type Health struct {
@@ -28,15 +31,54 @@ type Health struct {
 }
 
 type ServiceHealth struct {
-	Name           string    `bson:"name" json:"name"`
-	Healthy        bool      `bson:"healthy" json:"healthy"`
-	EthBlockNumber string    `bson:"eth_block_number" json:"eth_block_number"` // not used for all services
-	PoktHeight     string    `bson:"pokt_height" json:"pokt_height"`           // not used for all services
-	LastSyncTime   time.Time `bson:"last_sync_time" json:"last_sync_time"`
-	NextSyncTime   time.Time `bson:"next_sync_time" json:"next_sync_time"`
+	Name           string              `bson:"name" json:"name"`
+	Healthy        bool                `bson:"healthy" json:"healthy"`
+	Leader         bool                `bson:"leader" json:"leader"`
+	EthBlockNumber string              `bson:"eth_block_number" json:"eth_block_number"` // not used for all services
+	PoktHeight     string              `bson:"pokt_height" json:"pokt_height"`           // not used for all services
+	LastSyncTime   time.Time           `bson:"last_sync_time" json:"last_sync_time"`
+	NextSyncTime   time.Time           `bson:"next_sync_time" json:"next_sync_time"`
+	FailedRuns     int64               `bson:"failed_runs" json:"failed_runs"`
+	Solvency       *SolvencyReport     `bson:"solvency,omitempty" json:"solvency,omitempty"`           // only used by the solvency checker
+	Pause          *PauseState         `bson:"pause,omitempty" json:"pause,omitempty"`                 // only used by the ethereum services
+	ValidatorSet   *ValidatorSetReport `bson:"validator_set,omitempty" json:"validator_set,omitempty"` // only used by the validator set monitor
+	Fee            *FeeSettings        `bson:"fee,omitempty" json:"fee,omitempty"`                     // only used by the mint executor
 }
 
 type RunnerStatus struct {
-	EthBlockNumber string `bson:"eth_block_number" json:"eth_block_number"`
-	PoktHeight     string `bson:"pokt_height" json:"pokt_height"`
+	EthBlockNumber string              `bson:"eth_block_number" json:"eth_block_number"`
+	PoktHeight     string              `bson:"pokt_height" json:"pokt_height"`
+	Failed         bool                `bson:"-" json:"-"` // the last run did not complete
+	Solvency       *SolvencyReport     `bson:"-" json:"-"`
+	Pause          *PauseState         `bson:"-" json:"-"`
+	ValidatorSet   *ValidatorSetReport `bson:"-" json:"-"`
+	Fee            *FeeSettings        `bson:"-" json:"-"`
+}
+
+// PauseState is whether the wpokt contract is paused, as of the last Paused or Unpaused event
+// a service saw. Account is empty when the state was read from the contract at startup.
+type PauseState struct {
+	Paused      bool   `bson:"paused" json:"paused"`
+	Account     string `bson:"account" json:"account"`
+	BlockNumber string `bson:"block_number" json:"block_number"`
+}
+
+// ValidatorSetReport compares the validators of the mint controller with the configured
+// validator addresses. Validators are the ones added and not removed by the events seen
+// since the start block.
+type ValidatorSetReport struct {
+	SignerAddress     string    `bson:"signer_address" json:"signer_address"`
+	SignerIsValidator bool      `bson:"signer_is_validator" json:"signer_is_validator"`
+	ValidatorCount    string    `bson:"validator_count" json:"validator_count"`
+	Validators        []string  `bson:"validators" json:"validators"`
+	Drift             []string  `bson:"drift" json:"drift"`
+	InSync            bool      `bson:"in_sync" json:"in_sync"`
+	CheckedAt         time.Time `bson:"checked_at" json:"checked_at"`
+}
+
+// FeeSettings are the mint fee settings of the wpokt contract when last read. Basis is in
+// basis points of the minted amount.
+type FeeSettings struct {
+	Enabled bool   `bson:"enabled" json:"enabled"`
+	Basis   string `bson:"basis" json:"basis"`
 }
@@ -11,19 +11,25 @@ const (
 )
 
 type InvalidMint struct {
-	Id              *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
-	TransactionHash string              `bson:"transaction_hash" json:"transaction_hash"`
-	Height          string              `bson:"height" json:"height"`
-	Confirmations   string              `bson:"confirmations" json:"confirmations"`
-	SenderAddress   string              `bson:"sender_address" json:"sender_address"`
-	SenderChainId   string              `bson:"sender_chain_id" json:"sender_chain_id"`
-	VaultAddress    string              `bson:"vault_address" json:"vault_address"`
-	Amount          string              `bson:"amount" json:"amount"`
-	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
-	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at"`
-	Status          string              `bson:"status" json:"status"`
-	ReturnTx        string              `bson:"return_tx" json:"return_tx"`
-	Signers         []string            `bson:"signers" json:"signers"`
-	ReturnTxHash    string              `bson:"return_tx_hash" json:"return_tx_hash"`
-	Memo            string              `bson:"memo" json:"memo"`
+	Id              *primitive.ObjectID  `bson:"_id,omitempty" json:"_id"`
+	TransactionHash string               `bson:"transaction_hash" json:"transaction_hash"`
+	Height          string               `bson:"height" json:"height"`
+	Confirmations   string               `bson:"confirmations" json:"confirmations"`
+	SenderAddress   string               `bson:"sender_address" json:"sender_address"`
+	SenderChainId   string               `bson:"sender_chain_id" json:"sender_chain_id"`
+	VaultAddress    string               `bson:"vault_address" json:"vault_address"`
+	Amount          string               `bson:"amount" json:"amount"`
+	CreatedAt       time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time            `bson:"updated_at" json:"updated_at"`
+	Status          string               `bson:"status" json:"status"`
+	ReturnTx        string               `bson:"return_tx" json:"return_tx"`
+	Signers         []string             `bson:"signers" json:"signers"`
+	TxFee           string               `bson:"tx_fee" json:"tx_fee"`
+	ReturnTxHash    string               `bson:"return_tx_hash" json:"return_tx_hash"`
+	Memo            string               `bson:"memo" json:"memo"`
+	LockToken       int64                `bson:"lock_token" json:"lock_token"`
+	Attempts        map[string]int64     `bson:"attempts,omitempty" json:"attempts,omitempty"`
+	LastError       string               `bson:"last_error" json:"last_error"`
+	NextAttemptAt   map[string]time.Time `bson:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty"`
+	PreviousStatus  string               `bson:"previous_status" json:"previous_status"`
 }
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	CollectionLeaders = "leaders"
+
+	LeaderScopeValidator = "validator"
+	LeaderScopeService   = "service"
+)
+
+type Leader struct {
+	Id          string    `bson:"_id" json:"_id"`
+	ValidatorId string    `bson:"validator_id" json:"validator_id"`
+	Holder      string    `bson:"holder" json:"holder"`
+	Hostname    string    `bson:"hostname" json:"hostname"`
+	ExpiresAt   time.Time `bson:"expires_at" json:"expires_at"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// Millis is a duration in milliseconds. It is read either as a number of milliseconds
+// or as a duration string such as "30s" or "2m".
+type Millis int64
+
+// ParseMillis parses a number of milliseconds or a duration string
+func ParseMillis(value string) (Millis, error) {
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return Millis(millis), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	return Millis(duration.Milliseconds()), nil
+}
+
+// Duration returns the value as a time.Duration
+func (m Millis) Duration() time.Duration {
+	return time.Duration(m) * time.Millisecond
+}
+
+func (m *Millis) UnmarshalText(text []byte) error {
+	millis, err := ParseMillis(string(text))
+	if err != nil {
+		return err
+	}
+	*m = millis
+	return nil
+}
+
+func (m *Millis) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	return m.UnmarshalText([]byte(value))
+}
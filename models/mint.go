@@ -11,26 +11,40 @@ const (
 )
 
 type Mint struct {
-	Id                  *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
-	TransactionHash     string              `bson:"transaction_hash" json:"transaction_hash"`
-	Height              string              `bson:"height" json:"height"`
-	Confirmations       string              `bson:"confirmations" json:"confirmations"`
-	SenderAddress       string              `bson:"sender_address" json:"sender_address"`
-	SenderChainId       string              `bson:"sender_chain_id" json:"sender_chain_id"`
-	RecipientAddress    string              `bson:"recipient_address" json:"recipient_address"`
-	RecipientChainId    string              `bson:"recipient_chain_id" json:"recipient_chain_id"`
-	WPOKTAddress        string              `bson:"wpokt_address" json:"wpokt_address"`
-	VaultAddress        string              `bson:"vault_address" json:"vault_address"`
-	Amount              string              `bson:"amount" json:"amount"`
-	Nonce               string              `bson:"nonce" json:"nonce"`
-	Memo                *MintMemo           `bson:"memo" json:"memo"`
-	CreatedAt           time.Time           `bson:"created_at" json:"created_at"`
-	UpdatedAt           time.Time           `bson:"updated_at" json:"updated_at"`
-	Status              string              `bson:"status" json:"status"`
-	Data                *MintData           `bson:"data" json:"data"`
-	Signers             []string            `bson:"signers" json:"signers"`
-	Signatures          []string            `bson:"signatures" json:"signatures"`
-	MintTransactionHash string              `bson:"mint_tx_hash" json:"mint_transaction_hash"`
+	Id                  *primitive.ObjectID  `bson:"_id,omitempty" json:"_id"`
+	TransactionHash     string               `bson:"transaction_hash" json:"transaction_hash"`
+	Height              string               `bson:"height" json:"height"`
+	Confirmations       string               `bson:"confirmations" json:"confirmations"`
+	SenderAddress       string               `bson:"sender_address" json:"sender_address"`
+	SenderChainId       string               `bson:"sender_chain_id" json:"sender_chain_id"`
+	RecipientAddress    string               `bson:"recipient_address" json:"recipient_address"`
+	RecipientChainId    string               `bson:"recipient_chain_id" json:"recipient_chain_id"`
+	WPOKTAddress        string               `bson:"wpokt_address" json:"wpokt_address"`
+	VaultAddress        string               `bson:"vault_address" json:"vault_address"`
+	Amount              string               `bson:"amount" json:"amount"`
+	Nonce               string               `bson:"nonce" json:"nonce"`
+	Memo                *MintMemo            `bson:"memo" json:"memo"`
+	CreatedAt           time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt           time.Time            `bson:"updated_at" json:"updated_at"`
+	Status              string               `bson:"status" json:"status"`
+	Data                *MintData            `bson:"data" json:"data"`
+	Signers             []string             `bson:"signers" json:"signers"`
+	Signatures          []string             `bson:"signatures" json:"signatures"`
+	DomainSeparator     string               `bson:"domain_separator" json:"domain_separator"`
+	MintTransactionHash string               `bson:"mint_tx_hash" json:"mint_transaction_hash"`
+	GrossAmount         string               `bson:"gross_amount" json:"gross_amount"`
+	NetAmount           string               `bson:"net_amount" json:"net_amount"`
+	Fee                 string               `bson:"fee" json:"fee"`
+	FeeCollector        string               `bson:"fee_collector" json:"fee_collector"`
+	LockToken           int64                `bson:"lock_token" json:"lock_token"`
+	Attempts            map[string]int64     `bson:"attempts,omitempty" json:"attempts,omitempty"`
+	LastError           string               `bson:"last_error" json:"last_error"`
+	NextAttemptAt       map[string]time.Time `bson:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty"`
+	PreviousStatus      string               `bson:"previous_status" json:"previous_status"`
+	CountedAt           time.Time            `bson:"counted_at" json:"counted_at"`
+	HeldReason          string               `bson:"held_reason" json:"held_reason"`
+	QuarantineReason    string               `bson:"quarantine_reason" json:"quarantine_reason"`
+	Approvals           []Approval           `bson:"approvals" json:"approvals"`
 }
 
 type MintMemo struct {
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// SolvencyReport compares the POKT held by the vault with the wPOKT it backs. Amounts are in
+// uPOKT, which wPOKT matches one to one.
+type SolvencyReport struct {
+	VaultBalance         string    `bson:"vault_balance" json:"vault_balance"`
+	TotalSupply          string    `bson:"total_supply" json:"total_supply"`
+	PendingMints         string    `bson:"pending_mints" json:"pending_mints"`                   // received but not minted yet
+	PendingInvalidMints  string    `bson:"pending_invalid_mints" json:"pending_invalid_mints"`   // received but not returned yet
+	PendingBurns         string    `bson:"pending_burns" json:"pending_burns"`                   // burned but not returned yet
+	ExpectedVaultBalance string    `bson:"expected_vault_balance" json:"expected_vault_balance"` // total supply plus pending transfers
+	Discrepancy          string    `bson:"discrepancy" json:"discrepancy"`                       // vault balance minus expected, negative when short
+	Tolerance            string    `bson:"tolerance" json:"tolerance"`
+	WithinTolerance      bool      `bson:"within_tolerance" json:"within_tolerance"`
+	CheckedAt            time.Time `bson:"checked_at" json:"checked_at"`
+}
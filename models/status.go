@@ -7,4 +7,10 @@ const (
 	StatusSubmitted = "submitted"
 	StatusSuccess   = "success"
 	StatusFailed    = "failed"
+
+	StatusNeedsAttention = "needs_attention"
+	StatusHeld           = "held"
+
+	StatusAwaitingApproval = "awaiting_approval"
+	StatusQuarantined      = "quarantined"
 )
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionStuckTransfers = "stuckTransfers"
+
+	StuckReasonAwaitingConfirmations = "waiting for confirmations"
+	StuckReasonConfirmedButPending   = "pending with enough confirmations"
+	StuckReasonMissingOurSignature   = "confirmed but missing our signature"
+	StuckReasonMissingSignatures     = "confirmed but missing signatures"
+	StuckReasonNotExecuted           = "signed but never executed"
+	StuckReasonNotSubmitted          = "signed but never submitted"
+	StuckReasonNotConfirmed          = "submitted but never confirmed"
+)
+
+// StuckTransfer is a mint, burn or invalid mint that stayed in a non-terminal status for
+// longer than the threshold of that status. It is resolved once the document moves on.
+type StuckTransfer struct {
+	Id                    *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	DocType               string              `bson:"doc_type" json:"doc_type"`
+	DocumentId            *primitive.ObjectID `bson:"document_id" json:"document_id"`
+	TransactionHash       string              `bson:"transaction_hash" json:"transaction_hash"`
+	Status                string              `bson:"status" json:"status"`
+	Reason                string              `bson:"reason" json:"reason"`
+	Since                 time.Time           `bson:"since" json:"since"`
+	Confirmations         string              `bson:"confirmations" json:"confirmations"`
+	RequiredConfirmations int64               `bson:"required_confirmations" json:"required_confirmations"`
+	Signers               []string            `bson:"signers" json:"signers"`
+	MissingSigners        []string            `bson:"missing_signers" json:"missing_signers"`
+	Resolved              bool                `bson:"resolved" json:"resolved"`
+	DetectedAt            time.Time           `bson:"detected_at" json:"detected_at"`
+	UpdatedAt             time.Time           `bson:"updated_at" json:"updated_at"`
+	ResolvedAt            time.Time           `bson:"resolved_at,omitempty" json:"resolved_at,omitempty"`
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// FeeMultipliersParamKey is the auth param that scales the base fee of every message type
+	FeeMultipliersParamKey = "auth/FeeMultipliers"
+)
+
+// paramInt reads an int64 param field, which the chain may encode with or without quotes
+type paramInt int64
+
+func (i *paramInt) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseInt(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = paramInt(value)
+	return nil
+}
+
+type feeMultipliers struct {
+	FeeMultis []struct {
+		Key        string   `json:"key"`
+		Multiplier paramInt `json:"multiplier"`
+	} `json:"fee_multiplier"`
+	Default paramInt `json:"default"`
+}
+
+// TxFeeFromFeeMultipliers computes the fee of a send transaction from the value of the
+// auth/FeeMultipliers param, the same way the chain does when checking the fee of a transaction
+func TxFeeFromFeeMultipliers(value string) (int64, error) {
+	var fm feeMultipliers
+	if err := json.Unmarshal([]byte(value), &fm); err != nil {
+		return 0, fmt.Errorf("invalid fee multipliers %q: %w", value, err)
+	}
+
+	multiplier := int64(fm.Default)
+	for _, entry := range fm.FeeMultis {
+		if entry.Key == nodeTypes.MsgSendName {
+			multiplier = int64(entry.Multiplier)
+			break
+		}
+	}
+	fee := nodeTypes.NodeFeeMap[nodeTypes.MsgSendName] * multiplier
+	if fee <= 0 {
+		return 0, fmt.Errorf("invalid fee multipliers %q: fee is %d", value, fee)
+	}
+	return fee, nil
+}
+
+// GetTxFee reads the fee of a send transaction from the chain params. The single param route is
+// tried first and the auth params of all params are used when it does not return the key.
+func GetTxFee(c PocketClient) (int64, error) {
+	param, err := c.GetParam(FeeMultipliersParamKey)
+	if err != nil {
+		return 0, err
+	}
+	if param.Key == FeeMultipliersParamKey && param.Value != "" {
+		return TxFeeFromFeeMultipliers(param.Value)
+	}
+
+	params, err := c.GetAllParams()
+	if err != nil {
+		return 0, err
+	}
+	for _, param := range params.AuthParams {
+		if param.Key == FeeMultipliersParamKey {
+			return TxFeeFromFeeMultipliers(param.Value)
+		}
+	}
+	return 0, fmt.Errorf("param %s not found", FeeMultipliersParamKey)
+}
+
+// UpdateTxFee reads the tx fee of a runner from the chain params. The last fee is kept when the
+// params cannot be read, so nil is only returned to a runner that never read one.
+func UpdateTxFee(c PocketClient, name string, last *big.Int) *big.Int {
+	fee, err := GetTxFee(c)
+	if err != nil {
+		log.Errorf("[%s] Error fetching pokt tx fee: %s", name, err.Error())
+		return last
+	}
+	if last == nil || last.Int64() != fee {
+		log.Infof("[%s] Pokt tx fee: %d", name, fee)
+	}
+	return big.NewInt(fee)
+}
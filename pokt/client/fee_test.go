@@ -0,0 +1,103 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxFeeFromFeeMultipliers(t *testing.T) {
+	t.Run("Default multiplier", func(t *testing.T) {
+		fee, err := TxFeeFromFeeMultipliers(`{"fee_multiplier":null,"default":"1"}`)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10000), fee)
+	})
+
+	t.Run("Send multiplier", func(t *testing.T) {
+		fee, err := TxFeeFromFeeMultipliers(`{"fee_multiplier":[{"key":"stake_validator","multiplier":5},{"key":"send","multiplier":3}],"default":1}`)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(30000), fee)
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		_, err := TxFeeFromFeeMultipliers(`{"default":"one"}`)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Zero fee", func(t *testing.T) {
+		_, err := TxFeeFromFeeMultipliers(`{"default":0}`)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetTxFee(t *testing.T) {
+	t.Run("Single param", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(&ParamResponse{Key: FeeMultipliersParamKey, Value: `{"default":"2"}`}, nil)
+
+		fee, err := GetTxFee(mockClient)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(20000), fee)
+	})
+
+	t.Run("All params", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(&ParamResponse{}, nil)
+		mockClient.EXPECT().GetAllParams().Return(&AllParamsResponse{
+			AuthParams: []ParamResponse{
+				{Key: "auth/MaxMemoCharacters", Value: "75"},
+				{Key: FeeMultipliersParamKey, Value: `{"default":"1"}`},
+			},
+		}, nil)
+
+		fee, err := GetTxFee(mockClient)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10000), fee)
+	})
+
+	t.Run("Param not found", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(&ParamResponse{}, nil)
+		mockClient.EXPECT().GetAllParams().Return(&AllParamsResponse{}, nil)
+
+		_, err := GetTxFee(mockClient)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Error fetching param", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(nil, errors.New("error"))
+
+		_, err := GetTxFee(mockClient)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestUpdateTxFee(t *testing.T) {
+	t.Run("Fee changed", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).
+			Return(&ParamResponse{Key: FeeMultipliersParamKey, Value: `{"fee_multiplier":[{"key":"send","multiplier":"2"}],"default":"1"}`}, nil)
+
+		fee := UpdateTxFee(mockClient, "BURN SIGNER", big.NewInt(10000))
+		assert.Equal(t, big.NewInt(20000), fee)
+	})
+
+	t.Run("Error keeps the last fee", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(nil, errors.New("error"))
+
+		fee := UpdateTxFee(mockClient, "BURN SIGNER", big.NewInt(10000))
+		assert.Equal(t, big.NewInt(10000), fee)
+	})
+
+	t.Run("Error without a known fee", func(t *testing.T) {
+		mockClient := NewMockPocketClient(t)
+		mockClient.EXPECT().GetParam(FeeMultipliersParamKey).Return(nil, errors.New("error"))
+
+		fee := UpdateTxFee(mockClient, "BURN SIGNER", nil)
+		assert.Nil(t, fee)
+	})
+}
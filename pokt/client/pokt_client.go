@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
@@ -22,6 +21,9 @@ type PocketClient interface {
 	SubmitRawTx(params rpc.SendRawTxParams) (*SubmitRawTxResponse, error)
 	GetTx(hash string) (*TxResponse, error)
 	GetAccountTxsByHeight(address string, height int64) ([]*TxResponse, error)
+	GetBalance(address string) (*BalanceResponse, error)
+	GetAllParams() (*AllParamsResponse, error)
+	GetParam(key string) (*ParamResponse, error)
 	ValidateNetwork()
 }
 
@@ -138,7 +140,7 @@ func queryRPC(path string, jsonArgs []byte) (string, error) {
 
 	req.Header.Set("Content-Type", "application/json")
 	client := &http.Client{
-		Timeout: time.Millisecond * time.Duration(app.Config.Pocket.RPCTimeoutMillis),
+		Timeout: app.Config.Pocket.RPCTimeoutMillis.Duration(),
 	}
 
 	resp, err := client.Do(req)
@@ -203,6 +205,51 @@ func (c *pocketClient) GetTx(hash string) (*TxResponse, error) {
 	return &obj, err
 }
 
+func (c *pocketClient) GetBalance(address string) (*BalanceResponse, error) {
+	params := rpc.HeightAndAddrParams{Height: 0, Address: address}
+	j, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryRPC(getBalancePath, j)
+	if err != nil {
+		return nil, err
+	}
+	var obj BalanceResponse
+	err = json.Unmarshal([]byte(res), &obj)
+	return &obj, err
+}
+
+func (c *pocketClient) GetAllParams() (*AllParamsResponse, error) {
+	params := rpc.HeightParams{Height: 0}
+	j, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryRPC(getAllParamsPath, j)
+	if err != nil {
+		return nil, err
+	}
+	var obj AllParamsResponse
+	err = json.Unmarshal([]byte(res), &obj)
+	return &obj, err
+}
+
+func (c *pocketClient) GetParam(key string) (*ParamResponse, error) {
+	params := rpc.HeightAndKeyParams{Height: 0, Key: key}
+	j, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryRPC(getParamPath, j)
+	if err != nil {
+		return nil, err
+	}
+	var obj ParamResponse
+	err = json.Unmarshal([]byte(res), &obj)
+	return &obj, err
+}
+
 func (c *pocketClient) SubmitRawTx(params rpc.SendRawTxParams) (*SubmitRawTxResponse, error) {
 	j, err := json.Marshal(params)
 	if err != nil {
@@ -290,6 +337,10 @@ func (c *pocketClient) ValidateNetwork() {
 	log.Infoln("[POKT] Validated network")
 }
 
-func NewClient() PocketClient {
-	return &pocketClient{}
+// NewClient returns a pocket rpc client. Its calls are traced under the current span of scope, if any.
+func NewClient(scope app.TraceScope) PocketClient {
+	if scope == nil {
+		return &pocketClient{}
+	}
+	return newTracedClient(&pocketClient{}, scope)
 }
@@ -3,9 +3,8 @@
 package client
 
 import (
-	mock "github.com/stretchr/testify/mock"
-
 	rpc "github.com/pokt-network/pocket-core/app/cmd/rpc"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MockPocketClient is an autogenerated mock type for the PocketClient type
@@ -76,6 +75,113 @@ func (_c *MockPocketClient_GetAccountTxsByHeight_Call) RunAndReturn(run func(str
 	return _c
 }
 
+// GetAllParams provides a mock function with given fields:
+func (_m *MockPocketClient) GetAllParams() (*AllParamsResponse, error) {
+	ret := _m.Called()
+
+	var r0 *AllParamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*AllParamsResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *AllParamsResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AllParamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPocketClient_GetAllParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllParams'
+type MockPocketClient_GetAllParams_Call struct {
+	*mock.Call
+}
+
+// GetAllParams is a helper method to define mock.On call
+func (_e *MockPocketClient_Expecter) GetAllParams() *MockPocketClient_GetAllParams_Call {
+	return &MockPocketClient_GetAllParams_Call{Call: _e.mock.On("GetAllParams")}
+}
+
+func (_c *MockPocketClient_GetAllParams_Call) Run(run func()) *MockPocketClient_GetAllParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPocketClient_GetAllParams_Call) Return(_a0 *AllParamsResponse, _a1 error) *MockPocketClient_GetAllParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPocketClient_GetAllParams_Call) RunAndReturn(run func() (*AllParamsResponse, error)) *MockPocketClient_GetAllParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalance provides a mock function with given fields: address
+func (_m *MockPocketClient) GetBalance(address string) (*BalanceResponse, error) {
+	ret := _m.Called(address)
+
+	var r0 *BalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*BalanceResponse, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) *BalanceResponse); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPocketClient_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type MockPocketClient_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - address string
+func (_e *MockPocketClient_Expecter) GetBalance(address interface{}) *MockPocketClient_GetBalance_Call {
+	return &MockPocketClient_GetBalance_Call{Call: _e.mock.On("GetBalance", address)}
+}
+
+func (_c *MockPocketClient_GetBalance_Call) Run(run func(address string)) *MockPocketClient_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPocketClient_GetBalance_Call) Return(_a0 *BalanceResponse, _a1 error) *MockPocketClient_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPocketClient_GetBalance_Call) RunAndReturn(run func(string) (*BalanceResponse, error)) *MockPocketClient_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBlock provides a mock function with given fields:
 func (_m *MockPocketClient) GetBlock() (*BlockResponse, error) {
 	ret := _m.Called()
@@ -182,6 +288,60 @@ func (_c *MockPocketClient_GetHeight_Call) RunAndReturn(run func() (*HeightRespo
 	return _c
 }
 
+// GetParam provides a mock function with given fields: key
+func (_m *MockPocketClient) GetParam(key string) (*ParamResponse, error) {
+	ret := _m.Called(key)
+
+	var r0 *ParamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*ParamResponse, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *ParamResponse); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ParamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPocketClient_GetParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParam'
+type MockPocketClient_GetParam_Call struct {
+	*mock.Call
+}
+
+// GetParam is a helper method to define mock.On call
+//   - key string
+func (_e *MockPocketClient_Expecter) GetParam(key interface{}) *MockPocketClient_GetParam_Call {
+	return &MockPocketClient_GetParam_Call{Call: _e.mock.On("GetParam", key)}
+}
+
+func (_c *MockPocketClient_GetParam_Call) Run(run func(key string)) *MockPocketClient_GetParam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPocketClient_GetParam_Call) Return(_a0 *ParamResponse, _a1 error) *MockPocketClient_GetParam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPocketClient_GetParam_Call) RunAndReturn(run func(string) (*ParamResponse, error)) *MockPocketClient_GetParam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTx provides a mock function with given fields: hash
 func (_m *MockPocketClient) GetTx(hash string) (*TxResponse, error) {
 	ret := _m.Called(hash)
@@ -318,7 +478,7 @@ func (_c *MockPocketClient_ValidateNetwork_Call) Return() *MockPocketClient_Vali
 }
 
 func (_c *MockPocketClient_ValidateNetwork_Call) RunAndReturn(run func()) *MockPocketClient_ValidateNetwork_Call {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
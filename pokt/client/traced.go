@@ -0,0 +1,85 @@
+package client
+
+import (
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracedClient traces every RPC call of a pocket client under the span of a runner
+type tracedClient struct {
+	client PocketClient
+	scope  app.TraceScope
+}
+
+// newTracedClient traces every RPC call of client under the current span of scope
+func newTracedClient(client PocketClient, scope app.TraceScope) PocketClient {
+	return &tracedClient{client: client, scope: scope}
+}
+
+func (c *tracedClient) span(method string, attrs ...attribute.KeyValue) func(error) {
+	_, span := app.StartSpan(c.scope.TraceContext(), "pokt."+method, append([]attribute.KeyValue{attribute.String("rpc.system", "pokt")}, attrs...)...)
+	return func(err error) {
+		app.EndSpan(span, err)
+	}
+}
+
+func (c *tracedClient) GetBlock() (*BlockResponse, error) {
+	end := c.span("GetBlock")
+	res, err := c.client.GetBlock()
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetHeight() (*HeightResponse, error) {
+	end := c.span("GetHeight")
+	res, err := c.client.GetHeight()
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) SubmitRawTx(params rpc.SendRawTxParams) (*SubmitRawTxResponse, error) {
+	end := c.span("SubmitRawTx")
+	res, err := c.client.SubmitRawTx(params)
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetTx(hash string) (*TxResponse, error) {
+	end := c.span("GetTx", attribute.String("tx_hash", hash))
+	res, err := c.client.GetTx(hash)
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetAccountTxsByHeight(address string, height int64) ([]*TxResponse, error) {
+	end := c.span("GetAccountTxsByHeight", attribute.Int64("height", height))
+	res, err := c.client.GetAccountTxsByHeight(address, height)
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetBalance(address string) (*BalanceResponse, error) {
+	end := c.span("GetBalance")
+	res, err := c.client.GetBalance(address)
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetAllParams() (*AllParamsResponse, error) {
+	end := c.span("GetAllParams")
+	res, err := c.client.GetAllParams()
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) GetParam(key string) (*ParamResponse, error) {
+	end := c.span("GetParam", attribute.String("param_key", key))
+	res, err := c.client.GetParam(key)
+	end(err)
+	return res, err
+}
+
+func (c *tracedClient) ValidateNetwork() {
+	c.client.ValidateNetwork()
+}
@@ -1,9 +1,15 @@
 package client
 
+import "math/big"
+
 type HeightResponse struct {
 	Height int64 `json:"height"`
 }
 
+type BalanceResponse struct {
+	Balance *big.Int `json:"balance"`
+}
+
 type SubmitRawTxResponse struct {
 	TransactionHash string `json:"txhash"`
 }
@@ -63,3 +69,16 @@ type TxResult struct {
 	Recipient   string `json:"recipient"`
 	Signer      string `json:"signer"`
 }
+
+type ParamResponse struct {
+	Key   string `json:"param_key"`
+	Value string `json:"param_value"`
+}
+
+type AllParamsResponse struct {
+	AppParams    []ParamResponse `json:"app_params"`
+	NodeParams   []ParamResponse `json:"node_params"`
+	PocketParams []ParamResponse `json:"pocket_params"`
+	GovParams    []ParamResponse `json:"gov_params"`
+	AuthParams   []ParamResponse `json:"auth_params"`
+}
@@ -1,6 +1,7 @@
 package pokt
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -20,33 +21,50 @@ const (
 )
 
 type BurnExecutorRunner struct {
+	app.Traced
+
+	validatorId  string
 	client       pokt.PocketClient
 	wpoktAddress string
 	vaultAddress string
+	failed       bool
 }
 
 func (x *BurnExecutorRunner) Run() {
-	x.SyncTxs()
+	x.failed = !x.SyncTxs()
 }
 
 func (x *BurnExecutorRunner) Status() models.RunnerStatus {
-	return models.RunnerStatus{}
+	return models.RunnerStatus{
+		Failed: x.failed,
+	}
 }
 
-func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
+func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint, lockId string, lockToken int64) bool {
 
 	if doc == nil || (doc.Status != models.StatusSigned && doc.Status != models.StatusSubmitted) {
 		log.Error("[BURN EXECUTOR] Invalid mint is nil or has invalid status")
 		return false
 	}
 
-	log.Debug("[BURN EXECUTOR] Handling invalid mint: ", doc.TransactionHash)
+	logger := app.TransferLogger(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
+	statusFrom := doc.Status
+	defer x.StartSpan("BURN EXECUTOR HandleInvalidMint", app.TransferAttributes(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)...)()
+
+	logger.Debug("[BURN EXECUTOR] Handling invalid mint: ", doc.TransactionHash)
+	app.AlertIfStuck(BurnExecutorName, models.CollectionInvalidMints, doc.Id, doc.TransactionHash, doc.Status, doc.UpdatedAt)
 
 	var filter bson.M
 	var update bson.M
 
 	if doc.Status == models.StatusSigned {
-		log.Debug("[BURN EXECUTOR] Submitting invalid mint")
+		logger.Debug("[BURN EXECUTOR] Submitting invalid mint")
+
+		// a broadcast cannot be undone, so the lease must still be held when the tx is sent
+		if err := x.DB().RenewLock(lockId); err != nil {
+			logger.Error("[BURN EXECUTOR] Lock on invalid mint is no longer held, not submitting: ", err)
+			return false
+		}
 
 		var err error
 
@@ -57,13 +75,15 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 
 		res, err := x.client.SubmitRawTx(p)
 		if err != nil {
-			log.Error("[BURN EXECUTOR] Error submitting transaction: ", err)
+			logger.Error("[BURN EXECUTOR] Error submitting transaction: ", err)
+			app.RecordFailure(x.validatorId, models.CollectionInvalidMints, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 			return false
 		}
 
 		filter = bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(lockToken),
 		}
 
 		update = bson.M{
@@ -74,20 +94,23 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 			},
 		}
 	} else if doc.Status == models.StatusSubmitted {
-		log.Debug("[BURN EXECUTOR] Checking invalid mint")
+		logger.Debug("[BURN EXECUTOR] Checking invalid mint")
 		tx, err := x.client.GetTx(doc.ReturnTxHash)
 		if err != nil {
-			log.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
+			logger.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
+			app.RecordFailure(x.validatorId, models.CollectionInvalidMints, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 			return false
 		}
 
 		filter = bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(lockToken),
 		}
 
 		if tx.TxResult.Code != 0 {
-			log.Error("[BURN EXECUTOR] Invalid mint return tx failed: ", tx.Hash)
+			logger.Error("[BURN EXECUTOR] Invalid mint return tx failed: ", tx.Hash)
+			app.AlertReturnTxFailed(BurnExecutorName, models.CollectionInvalidMints, doc.Id, doc.TransactionHash, doc.ReturnTxHash)
 			update = bson.M{
 				"$set": bson.M{
 					"status":         models.StatusConfirmed,
@@ -98,7 +121,7 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 				},
 			}
 		} else {
-			log.Debug("[BURN EXECUTOR] Invalid mint return tx succeeded: ", tx.Hash)
+			logger.Debug("[BURN EXECUTOR] Invalid mint return tx succeeded: ", tx.Hash)
 			update = bson.M{
 				"$set": bson.M{
 					"status":     models.StatusSuccess,
@@ -108,29 +131,48 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 		}
 	}
 
-	if err := app.DB.UpdateOne(models.CollectionInvalidMints, filter, update); err != nil {
-		log.Error("[BURN EXECUTOR] Error updating invalid mint: ", err)
+	update["$set"].(bson.M)["lock_token"] = lockToken
+
+	err := x.DB().UpdateOne(models.CollectionInvalidMints, filter, update)
+	if errors.Is(err, app.ErrNotMatched) {
+		// another validator moved it on first, or the lock was taken over
+		logger.Info("[BURN EXECUTOR] Invalid mint changed before it was updated")
+		return true
+	}
+	if err != nil {
+		logger.Error("[BURN EXECUTOR] Error updating invalid mint: ", err)
 		return false
 	}
 
-	log.Info("[BURN EXECUTOR] Handled invalid mint")
+	logger.WithFields(app.StatusFields(statusFrom, update)).Info("[BURN EXECUTOR] Handled invalid mint")
 	return true
 }
 
-func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
+func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn, lockId string, lockToken int64) bool {
 
 	if doc == nil || (doc.Status != models.StatusSigned && doc.Status != models.StatusSubmitted) {
 		log.Error("[BURN EXECUTOR] Burn is nil or has invalid status")
 		return false
 	}
 
-	log.Debug("[BURN EXECUTOR] Handling burn: ", doc.TransactionHash)
+	logger := app.TransferLogger(models.CollectionBurns, doc.Id, doc.TransactionHash)
+	statusFrom := doc.Status
+	defer x.StartSpan("BURN EXECUTOR HandleBurn", app.TransferAttributes(models.CollectionBurns, doc.Id, doc.TransactionHash)...)()
+
+	logger.Debug("[BURN EXECUTOR] Handling burn: ", doc.TransactionHash)
+	app.AlertIfStuck(BurnExecutorName, models.CollectionBurns, doc.Id, doc.TransactionHash, doc.Status, doc.UpdatedAt)
 
 	var filter bson.M
 	var update bson.M
 
 	if doc.Status == models.StatusSigned {
-		log.Debug("[BURN EXECUTOR] Submitting burn")
+		logger.Debug("[BURN EXECUTOR] Submitting burn")
+
+		// a broadcast cannot be undone, so the lease must still be held when the tx is sent
+		if err := x.DB().RenewLock(lockId); err != nil {
+			logger.Error("[BURN EXECUTOR] Lock on burn is no longer held, not submitting: ", err)
+			return false
+		}
 
 		p := rpc.SendRawTxParams{
 			Addr:        x.vaultAddress,
@@ -139,13 +181,15 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 
 		res, err := x.client.SubmitRawTx(p)
 		if err != nil {
-			log.Error("[BURN EXECUTOR] Error submitting transaction: ", err)
+			logger.Error("[BURN EXECUTOR] Error submitting transaction: ", err)
+			app.RecordFailure(x.validatorId, models.CollectionBurns, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 			return false
 		}
 
 		filter = bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(lockToken),
 		}
 
 		update = bson.M{
@@ -156,20 +200,23 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 			},
 		}
 	} else if doc.Status == models.StatusSubmitted {
-		log.Debug("[BURN EXECUTOR] Checking burn")
+		logger.Debug("[BURN EXECUTOR] Checking burn")
 		tx, err := x.client.GetTx(doc.ReturnTxHash)
 		if err != nil {
-			log.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
+			logger.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
+			app.RecordFailure(x.validatorId, models.CollectionBurns, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 			return false
 		}
 
 		filter = bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(lockToken),
 		}
 
 		if tx.TxResult.Code != 0 {
-			log.Error("[BURN EXECUTOR] Burn return tx failed: ", tx.Hash)
+			logger.Error("[BURN EXECUTOR] Burn return tx failed: ", tx.Hash)
+			app.AlertReturnTxFailed(BurnExecutorName, models.CollectionBurns, doc.Id, doc.TransactionHash, doc.ReturnTxHash)
 			update = bson.M{
 				"$set": bson.M{
 					"status":         models.StatusConfirmed,
@@ -180,7 +227,7 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 				},
 			}
 		} else {
-			log.Debug("[BURN EXECUTOR] Burn return tx succeeded: ", tx.Hash)
+			logger.Debug("[BURN EXECUTOR] Burn return tx succeeded: ", tx.Hash)
 			update = bson.M{
 				"$set": bson.M{
 					"status":     models.StatusSuccess,
@@ -190,12 +237,20 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 		}
 	}
 
-	if err := app.DB.UpdateOne(models.CollectionBurns, filter, update); err != nil {
-		log.Error("[BURN EXECUTOR] Error updating burn: ", err)
+	update["$set"].(bson.M)["lock_token"] = lockToken
+
+	err := x.DB().UpdateOne(models.CollectionBurns, filter, update)
+	if errors.Is(err, app.ErrNotMatched) {
+		// another validator moved it on first, or the lock was taken over
+		logger.Info("[BURN EXECUTOR] Burn changed before it was updated")
+		return true
+	}
+	if err != nil {
+		logger.Error("[BURN EXECUTOR] Error updating burn: ", err)
 		return false
 	}
 
-	log.Info("[BURN EXECUTOR] Handled burn")
+	logger.WithFields(app.StatusFields(statusFrom, update)).Info("[BURN EXECUTOR] Handled burn")
 	return true
 }
 
@@ -210,10 +265,11 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 			},
 		},
 		"vault_address": x.vaultAddress,
+		"$expr":         app.RetryDueFilter(x.validatorId),
 	}
 	invalidMints := []models.InvalidMint{}
 
-	err := app.DB.FindMany(models.CollectionInvalidMints, filter, &invalidMints)
+	err := x.DB().FindMany(models.CollectionInvalidMints, filter, &invalidMints)
 	if err != nil {
 		log.Error("[BURN EXECUTOR] Error fetching invalid mints: ", err)
 		return false
@@ -226,7 +282,7 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 		doc := invalidMints[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionInvalidMints, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[BURN EXECUTOR] Error locking invalid mint: ", err)
 			success = false
@@ -234,9 +290,9 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 		}
 		log.Debug("[BURN EXECUTOR] Locked invalid mint: ", doc.TransactionHash)
 
-		success = x.HandleInvalidMint(&doc) && success
+		success = x.HandleInvalidMint(&doc, lockId, lockToken) && success
 
-		if err := app.DB.Unlock(lockId); err != nil {
+		if err := x.DB().Unlock(lockId); err != nil {
 			log.Error("[BURN EXECUTOR] Error unlocking invalid mint: ", err)
 			success = false
 		} else {
@@ -260,10 +316,11 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 			},
 		},
 		"wpokt_address": x.wpoktAddress,
+		"$expr":         app.RetryDueFilter(x.validatorId),
 	}
 	burns := []models.Burn{}
 
-	err := app.DB.FindMany(models.CollectionBurns, filter, &burns)
+	err := x.DB().FindMany(models.CollectionBurns, filter, &burns)
 	if err != nil {
 		log.Error("[BURN EXECUTOR] Error fetching burns: ", err)
 		return false
@@ -277,7 +334,7 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 		doc := burns[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionBurns, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[BURN EXECUTOR] Error locking burn: ", err)
 			success = false
@@ -285,9 +342,9 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 		}
 		log.Debugln("[BURN EXECUTOR] Locked burn:", doc.TransactionHash, doc.LogIndex)
 
-		success = x.HandleBurn(&doc) && success
+		success = x.HandleBurn(&doc, lockId, lockToken) && success
 
-		if err := app.DB.Unlock(lockId); err != nil {
+		if err := x.DB().Unlock(lockId); err != nil {
 			log.Error("[BURN EXECUTOR] Error unlocking burn: ", err)
 			success = false
 		} else {
@@ -334,13 +391,19 @@ func NewBurnExecutor(wg *sync.WaitGroup, health models.ServiceHealth) app.Servic
 		log.Fatal("[BURN EXECUTOR] Multisig address does not match vault address")
 	}
 
+	validatorId, err := app.ValidatorId()
+	if err != nil {
+		log.Fatal("[BURN EXECUTOR] Error naming validator: ", err)
+	}
+
 	x := &BurnExecutorRunner{
+		validatorId:  validatorId,
 		vaultAddress: strings.ToLower(vaultAddress),
 		wpoktAddress: strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
-		client:       pokt.NewClient(),
 	}
+	x.client = pokt.NewClient(x)
 
 	log.Info("[BURN EXECUTOR] Initialized")
 
-	return app.NewRunnerService(BurnExecutorName, x, wg, time.Duration(app.Config.BurnExecutor.IntervalMillis)*time.Millisecond)
+	return app.NewRunnerService(BurnExecutorName, x, wg, app.Config.BurnExecutor.IntervalMillis.Duration())
 }
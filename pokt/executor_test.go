@@ -26,6 +26,7 @@ func init() {
 
 func NewTestBurnExecutor(t *testing.T, mockClient *pokt.MockPocketClient) *BurnExecutorRunner {
 	x := &BurnExecutorRunner{
+		validatorId:  "wpokt-validator-01",
 		vaultAddress: "vaultaddress",
 		wpoktAddress: "wpoktaddress",
 		client:       mockClient,
@@ -50,7 +51,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		success := x.HandleInvalidMint(nil)
+		success := x.HandleInvalidMint(nil, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -63,11 +64,29 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 
 		doc := &models.InvalidMint{}
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
 
+	t.Run("Lock lost before submitting signed transaction", func(t *testing.T) {
+		mockClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnExecutor(t, mockClient)
+
+		doc := &models.InvalidMint{
+			Status: models.StatusSigned,
+		}
+
+		mockDB.EXPECT().RenewLock("lockId").Return(app.ErrLeaseLost)
+
+		success := x.HandleInvalidMint(doc, "lockId", 0)
+
+		assert.False(t, success)
+		mockClient.AssertNotCalled(t, "SubmitRawTx", mock.Anything)
+	})
+
 	t.Run("Error submitting signed transaction", func(t *testing.T) {
 		mockClient := pokt.NewMockPocketClient(t)
 		mockDB := app.NewMockDatabase(t)
@@ -83,9 +102,12 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			RawHexBytes: doc.ReturnTx,
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(nil, errors.New("error"))
 
-		success := x.HandleInvalidMint(doc)
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -109,16 +131,18 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			TransactionHash: "hash",
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(res, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(errors.New("error"))
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -142,11 +166,13 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			TransactionHash: "hash",
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(res, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
@@ -154,6 +180,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 				"status":         models.StatusSubmitted,
 				"return_tx_hash": res.TransactionHash,
 				"updated_at":     time.Now(),
+				"lock_token":     int64(0),
 			},
 		}
 
@@ -163,7 +190,26 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Signed transaction submitted by another validator", func(t *testing.T) {
+		mockClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnExecutor(t, mockClient)
+
+		doc := &models.InvalidMint{
+			Status: models.StatusSigned,
+		}
+
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
+		mockClient.EXPECT().SubmitRawTx(mock.Anything).Return(&pokt.SubmitRawTxResponse{TransactionHash: "hash"}, nil)
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(app.ErrNotMatched).Once()
+
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -180,7 +226,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 
 		mockClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
 
-		success := x.HandleInvalidMint(doc)
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -204,14 +252,16 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":         models.StatusConfirmed,
 				"updated_at":     time.Now(),
+				"lock_token":     int64(0),
 				"return_tx_hash": "",
 				"return_tx":      "",
 				"signers":        []string{},
@@ -224,7 +274,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -248,14 +298,16 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -265,7 +317,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -289,14 +341,16 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -306,7 +360,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -321,7 +375,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		success := x.HandleBurn(nil)
+		success := x.HandleBurn(nil, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -334,11 +388,29 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 
 		doc := &models.Burn{}
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
 
+	t.Run("Lock lost before submitting signed transaction", func(t *testing.T) {
+		mockClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnExecutor(t, mockClient)
+
+		doc := &models.Burn{
+			Status: models.StatusSigned,
+		}
+
+		mockDB.EXPECT().RenewLock("lockId").Return(app.ErrLeaseLost)
+
+		success := x.HandleBurn(doc, "lockId", 0)
+
+		assert.False(t, success)
+		mockClient.AssertNotCalled(t, "SubmitRawTx", mock.Anything)
+	})
+
 	t.Run("Error submitting signed transaction", func(t *testing.T) {
 		mockClient := pokt.NewMockPocketClient(t)
 		mockDB := app.NewMockDatabase(t)
@@ -354,9 +426,12 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			RawHexBytes: doc.ReturnTx,
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(nil, errors.New("error"))
 
-		success := x.HandleBurn(doc)
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -380,16 +455,18 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			TransactionHash: "hash",
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(res, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(errors.New("error"))
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -413,11 +490,13 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			TransactionHash: "hash",
 		}
 
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
 		mockClient.EXPECT().SubmitRawTx(p).Return(res, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSigned,
+			"_id":        doc.Id,
+			"status":     models.StatusSigned,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
@@ -425,6 +504,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 				"status":         models.StatusSubmitted,
 				"return_tx_hash": res.TransactionHash,
 				"updated_at":     time.Now(),
+				"lock_token":     int64(0),
 			},
 		}
 
@@ -434,7 +514,26 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Signed transaction submitted by another validator", func(t *testing.T) {
+		mockClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnExecutor(t, mockClient)
+
+		doc := &models.Burn{
+			Status: models.StatusSigned,
+		}
+
+		mockDB.EXPECT().RenewLock("lockId").Return(nil)
+		mockClient.EXPECT().SubmitRawTx(mock.Anything).Return(&pokt.SubmitRawTxResponse{TransactionHash: "hash"}, nil)
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(app.ErrNotMatched).Once()
+
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -451,7 +550,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 
 		mockClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
 
-		success := x.HandleBurn(doc)
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -475,14 +576,16 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":         models.StatusConfirmed,
 				"updated_at":     time.Now(),
+				"lock_token":     int64(0),
 				"return_tx_hash": "",
 				"return_tx":      "",
 				"signers":        []string{},
@@ -495,7 +598,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -519,14 +622,16 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -536,7 +641,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.False(t, success)
 	})
@@ -560,14 +665,16 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filter := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -577,7 +684,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(doc, "lockId", 0)
 
 		assert.True(t, success)
 	})
@@ -614,6 +721,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				},
 			},
 			"vault_address": x.vaultAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
@@ -637,6 +745,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				},
 			},
 			"vault_address": x.vaultAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
@@ -649,7 +758,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 		success := x.SyncInvalidMints()
 
 		assert.False(t, success)
@@ -670,6 +779,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				},
 			},
 			"vault_address": x.vaultAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.InvalidMint{
@@ -685,7 +795,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -696,14 +806,16 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -734,6 +846,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				},
 			},
 			"vault_address": x.vaultAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.InvalidMint{
@@ -749,7 +862,7 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -760,14 +873,16 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -816,6 +931,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				},
 			},
 			"wpokt_address": x.wpoktAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionBurns, filter, mock.Anything).Return(nil)
@@ -839,6 +955,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				},
 			},
 			"wpokt_address": x.wpoktAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
@@ -851,7 +968,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 		success := x.SyncBurns()
 
 		assert.False(t, success)
@@ -872,6 +989,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				},
 			},
 			"wpokt_address": x.wpoktAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.Burn{
@@ -887,7 +1005,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -898,14 +1016,16 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -936,6 +1056,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				},
 			},
 			"wpokt_address": x.wpoktAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.Burn{
@@ -951,7 +1072,7 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -962,14 +1083,16 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil)
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1004,6 +1127,7 @@ func TestBurnExecutorRun(t *testing.T) {
 				},
 			},
 			"vault_address": x.vaultAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.InvalidMint{
@@ -1019,7 +1143,7 @@ func TestBurnExecutorRun(t *testing.T) {
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil).Once()
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -1030,14 +1154,16 @@ func TestBurnExecutorRun(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil).Once()
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1059,6 +1185,7 @@ func TestBurnExecutorRun(t *testing.T) {
 				},
 			},
 			"wpokt_address": x.wpoktAddress,
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		doc := &models.Burn{
@@ -1074,7 +1201,7 @@ func TestBurnExecutorRun(t *testing.T) {
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil).Once()
 
 		tx := &pokt.TxResponse{
 			TxResult: pokt.TxResult{
@@ -1085,14 +1212,16 @@ func TestBurnExecutorRun(t *testing.T) {
 		mockClient.EXPECT().GetTx("").Return(tx, nil).Once()
 
 		filterUpdate := bson.M{
-			"_id":    doc.Id,
-			"status": models.StatusSubmitted,
+			"_id":        doc.Id,
+			"status":     models.StatusSubmitted,
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusSuccess,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1159,9 +1288,29 @@ func TestNewBurnExecutor(t *testing.T) {
 		})
 	})
 
+	t.Run("Signer not in multisig", func(t *testing.T) {
+
+		app.Config.BurnExecutor.Enabled = true
+		app.Config.Pocket.PrivateKey = "f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055"
+		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
+		app.Config.Pocket.MultisigPublicKeys = []string{
+			"eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743",
+			"ec69e25c0f2d79e252c1fe0eb8ae07c3a3d8ff7bd616d736f2ded2e9167488b2",
+			"abc364918abe9e3966564f60baf74d7ea1c4f3efe92889de066e617989c54283",
+		}
+
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+		assert.Panics(t, func() {
+			NewBurnExecutor(&sync.WaitGroup{}, models.ServiceHealth{})
+		})
+	})
+
 	t.Run("Interval is 0", func(t *testing.T) {
 
 		app.Config.BurnExecutor.Enabled = true
+		app.Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
 		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
 		app.Config.Pocket.MultisigPublicKeys = []string{
 			"eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743",
@@ -1177,6 +1326,7 @@ func TestNewBurnExecutor(t *testing.T) {
 	t.Run("Valid", func(t *testing.T) {
 
 		app.Config.BurnExecutor.Enabled = true
+		app.Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
 		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
 		app.Config.BurnExecutor.IntervalMillis = 1
 
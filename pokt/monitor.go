@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/models"
@@ -21,22 +20,31 @@ const (
 )
 
 type MintMonitorRunner struct {
+	app.Traced
+
 	client        pokt.PocketClient
 	wpoktAddress  string
 	vaultAddress  string
 	startHeight   int64
 	currentHeight int64
-	minimumAmount *big.Int
+	txFee         *big.Int
+	failed        bool
 }
 
 func (x *MintMonitorRunner) Run() {
 	x.UpdateCurrentHeight()
-	x.SyncTxs()
+	x.txFee = pokt.UpdateTxFee(x.client, MintMonitorName, x.txFee)
+	if x.txFee == nil {
+		x.failed = true
+		return
+	}
+	x.failed = !x.SyncTxs()
 }
 
 func (x *MintMonitorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight: strconv.FormatInt(x.startHeight, 10),
+		Failed:     x.failed,
 	}
 }
 
@@ -58,18 +66,21 @@ func (x *MintMonitorRunner) HandleFailedMint(tx *pokt.TxResponse) bool {
 
 	doc := util.CreateFailedMint(tx, x.vaultAddress)
 
-	log.Debug("[MINT MONITOR] Storing failed mint tx")
-	err := app.DB.InsertOne(models.CollectionInvalidMints, doc)
+	logger := app.TransferLogger(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
+	defer x.StartSpan("MINT MONITOR HandleFailedMint", app.TransferAttributes(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)...)()
+
+	logger.Debug("[MINT MONITOR] Storing failed mint tx")
+	err := x.DB().InsertOne(models.CollectionInvalidMints, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			log.Info("[MINT MONITOR] Found duplicate failed mint tx")
+			logger.Info("[MINT MONITOR] Found duplicate failed mint tx")
 			return true
 		}
-		log.Error("[MINT MONITOR] Error storing failed mint tx: ", err)
+		logger.Error("[MINT MONITOR] Error storing failed mint tx: ", err)
 		return false
 	}
 
-	log.Info("[MINT MONITOR] Stored failed mint tx")
+	logger.WithField("status_to", doc.Status).Info("[MINT MONITOR] Stored failed mint tx")
 	return true
 }
 
@@ -81,18 +92,21 @@ func (x *MintMonitorRunner) HandleInvalidMint(tx *pokt.TxResponse) bool {
 
 	doc := util.CreateInvalidMint(tx, x.vaultAddress)
 
-	log.Debug("[MINT MONITOR] Storing invalid mint tx")
-	err := app.DB.InsertOne(models.CollectionInvalidMints, doc)
+	logger := app.TransferLogger(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
+	defer x.StartSpan("MINT MONITOR HandleInvalidMint", app.TransferAttributes(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)...)()
+
+	logger.Debug("[MINT MONITOR] Storing invalid mint tx")
+	err := x.DB().InsertOne(models.CollectionInvalidMints, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			log.Info("[MINT MONITOR] Found duplicate invalid mint tx")
+			logger.Info("[MINT MONITOR] Found duplicate invalid mint tx")
 			return true
 		}
-		log.Error("[MINT MONITOR] Error storing invalid mint tx: ", err)
+		logger.Error("[MINT MONITOR] Error storing invalid mint tx: ", err)
 		return false
 	}
 
-	log.Info("[MINT MONITOR] Stored invalid mint tx")
+	logger.WithField("status_to", doc.Status).Info("[MINT MONITOR] Stored invalid mint tx")
 	return true
 }
 
@@ -104,18 +118,21 @@ func (x *MintMonitorRunner) HandleValidMint(tx *pokt.TxResponse, memo models.Min
 
 	doc := util.CreateMint(tx, memo, x.wpoktAddress, x.vaultAddress)
 
-	log.Debug("[MINT MONITOR] Storing mint tx")
-	err := app.DB.InsertOne(models.CollectionMints, doc)
+	logger := app.TransferLogger(models.CollectionMints, doc.Id, doc.TransactionHash)
+	defer x.StartSpan("MINT MONITOR HandleValidMint", app.TransferAttributes(models.CollectionMints, doc.Id, doc.TransactionHash)...)()
+
+	logger.Debug("[MINT MONITOR] Storing mint tx")
+	err := x.DB().InsertOne(models.CollectionMints, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			log.Info("[MINT MONITOR] Found duplicate mint tx")
+			logger.Info("[MINT MONITOR] Found duplicate mint tx")
 			return true
 		}
-		log.Error("[MINT MONITOR] Error storing mint tx: ", err)
+		logger.Error("[MINT MONITOR] Error storing mint tx: ", err)
 		return false
 	}
 
-	log.Info("[MINT MONITOR] Stored mint tx")
+	logger.WithField("status_to", doc.Status).Info("[MINT MONITOR] Stored mint tx")
 	return true
 }
 
@@ -137,7 +154,7 @@ func (x *MintMonitorRunner) SyncTxs() bool {
 		tx := txs[i]
 
 		amount, ok := new(big.Int).SetString(tx.StdTx.Msg.Value.Amount, 10)
-		if tx.Tx == "" || tx.TxResult.Code != 0 || !strings.EqualFold(tx.TxResult.Recipient, x.vaultAddress) || tx.TxResult.MessageType != "send" || !ok || amount.Cmp(x.minimumAmount) != 1 {
+		if tx.Tx == "" || tx.TxResult.Code != 0 || !strings.EqualFold(tx.TxResult.Recipient, x.vaultAddress) || tx.TxResult.MessageType != "send" || !ok || amount.Cmp(x.txFee) != 1 {
 			log.Info("[MINT MONITOR] Found failed mint tx: ", tx.Hash, " with code: ", tx.TxResult.Code)
 			success = x.HandleFailedMint(tx) && success
 			continue
@@ -205,9 +222,8 @@ func NewMintMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 		wpoktAddress:  strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
 		startHeight:   0,
 		currentHeight: 0,
-		client:        pokt.NewClient(),
-		minimumAmount: big.NewInt(app.Config.Pocket.TxFee),
 	}
+	x.client = pokt.NewClient(x)
 
 	x.UpdateCurrentHeight()
 
@@ -215,5 +231,5 @@ func NewMintMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 
 	log.Info("[MINT MONITOR] Initialized")
 
-	return app.NewRunnerService(MintMonitorName, x, wg, time.Duration(app.Config.MintMonitor.IntervalMillis)*time.Millisecond)
+	return app.NewRunnerService(MintMonitorName, x, wg, app.Config.MintMonitor.IntervalMillis.Duration())
 }
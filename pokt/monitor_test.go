@@ -30,12 +30,17 @@ func NewTestMintMonitor(t *testing.T, mockClient *pokt.MockPocketClient) *MintMo
 		startHeight:   0,
 		currentHeight: 0,
 		client:        mockClient,
-		minimumAmount: big.NewInt(10000),
+		txFee:         big.NewInt(10000),
 	}
-	app.Config.Pocket.TxFee = 10000
 	return x
 }
 
+// expectTxFee makes the chain params charge the base fee of 10000 for a send
+func expectTxFee(mockPoktClient *pokt.MockPocketClient) {
+	mockPoktClient.EXPECT().GetParam(pokt.FeeMultipliersParamKey).
+		Return(&pokt.ParamResponse{Key: pokt.FeeMultipliersParamKey, Value: `{"fee_multiplier":null,"default":"1"}`}, nil)
+}
+
 func TestMintMonitorStatus(t *testing.T) {
 	mockClient := pokt.NewMockPocketClient(t)
 	x := NewTestMintMonitor(t, mockClient)
@@ -721,6 +726,7 @@ func TestMintMonitorRun(t *testing.T) {
 	}
 
 	mockClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil).Once()
+	expectTxFee(mockClient)
 	mockClient.EXPECT().GetAccountTxsByHeight(x.vaultAddress, x.startHeight).Return(txs, nil).Once()
 	mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(nil).
 		Run(func(_ string, doc interface{}) {
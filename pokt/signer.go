@@ -20,6 +20,7 @@ import (
 	"github.com/pokt-network/pocket-core/crypto"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 const (
@@ -27,7 +28,11 @@ const (
 )
 
 type BurnSignerRunner struct {
+	app.Traced
+
+	validatorId    string
 	privateKey     crypto.PrivateKey
+	keyGeneration  int64
 	multisigPubKey crypto.PublicKeyMultiSig
 	numSigners     int
 	ethClient      eth.EthereumClient
@@ -37,20 +42,59 @@ type BurnSignerRunner struct {
 	vaultAddress   string
 	wpoktAddress   string
 	wpoktContract  eth.WrappedPocketContract
-	minimumAmount  *big.Int
+	txFee          *big.Int
+	failed         bool
 }
 
 func (x *BurnSignerRunner) Run() {
+	x.UpdatePrivateKey()
 	x.UpdateBlocks()
-	x.SyncTxs()
+	x.txFee = pokt.UpdateTxFee(x.poktClient, BurnSignerName, x.txFee)
+	if x.txFee == nil {
+		x.failed = true
+		return
+	}
+	if app.SigningHalted(x.DB(), BurnSignerName) {
+		x.failed = false
+		return
+	}
+	x.failed = !x.SyncTxs()
 }
 func (x *BurnSignerRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight:     strconv.FormatInt(x.poktHeight, 10),
 		EthBlockNumber: strconv.FormatInt(x.ethBlockNumber, 10),
+		Failed:         x.failed,
 	}
 }
 
+// LogPinnedTxFee warns when a document was first signed with a different tx fee than the chain
+// charges now. A lower pinned fee is signed again from scratch, a higher one is kept.
+func (x *BurnSignerRunner) LogPinnedTxFee(logger *log.Entry, pinnedTxFee string) {
+	fee := x.txFee.String()
+	if pinnedTxFee == "" || pinnedTxFee == fee {
+		return
+	}
+	logger.WithFields(log.Fields{"pinned_tx_fee": pinnedTxFee, "tx_fee": fee}).Warn("[BURN SIGNER] Pinned tx fee differs from the chain params")
+}
+
+// AuditRepinnedTxFee records that the signatures of a document were discarded to sign it again
+// with the tx fee the chain charges now. A kept return tx always has more than one signer after
+// signing, so a single signer left from earlier signers means it was built from scratch.
+func (x *BurnSignerRunner) AuditRepinnedTxFee(logger *log.Entry, collection string, id *primitive.ObjectID, pinnedTxFee string, signersBefore int, signersAfter int) {
+	if signersBefore == 0 || signersAfter != 1 {
+		return
+	}
+	logger.WithFields(log.Fields{"pinned_tx_fee": pinnedTxFee, "tx_fee": x.txFee.String()}).Warn("[BURN SIGNER] Discarded signatures of a pinned tx fee below the chain params")
+	app.RecordAudit(models.AuditLog{
+		Action:     models.AuditActionRepinTxFee,
+		Operator:   app.ValidatorOperator(),
+		Collection: collection,
+		DocumentId: id.Hex(),
+		Reason:     fmt.Sprintf("discarded %d signatures of pinned tx fee %s to sign with tx fee %s", signersBefore, pinnedTxFee, x.txFee.String()),
+	})
+}
+
 func (x *BurnSignerRunner) UpdateBlocks() {
 	log.Debug("[BURN SIGNER] Updating blocks")
 
@@ -72,7 +116,9 @@ func (x *BurnSignerRunner) UpdateBlocks() {
 }
 
 func (x *BurnSignerRunner) ValidateInvalidMint(doc *models.InvalidMint) (bool, error) {
-	log.Debug("[BURN SIGNER] Validating invalid mint: ", doc.TransactionHash)
+	logger := app.TransferLogger(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
+
+	logger.Debug("[BURN SIGNER] Validating invalid mint: ", doc.TransactionHash)
 
 	tx, err := x.poktClient.GetTx(doc.TransactionHash)
 	if err != nil {
@@ -80,67 +126,72 @@ func (x *BurnSignerRunner) ValidateInvalidMint(doc *models.InvalidMint) (bool, e
 	}
 
 	if tx.Tx == "" || tx.TxResult.Code != 0 {
-		log.Debug("[BURN SIGNER] Transaction not found or failed")
+		logger.Debug("[BURN SIGNER] Transaction not found or failed")
 		return false, nil
 	}
 
 	if tx.TxResult.MessageType != "send" || tx.StdTx.Msg.Type != "pos/Send" {
-		log.Debug("[BURN SIGNER] Transaction message type is not send")
+		logger.Debug("[BURN SIGNER] Transaction message type is not send")
 		return false, nil
 	}
 
 	if strings.EqualFold(tx.StdTx.Msg.Value.ToAddress, "0000000000000000000000000000000000000000") {
-		log.Debug("[BURN SIGNER] Transaction recipient is zero address")
+		logger.Debug("[BURN SIGNER] Transaction recipient is zero address")
 		return false, nil
 	}
 
 	if !strings.EqualFold(tx.StdTx.Msg.Value.ToAddress, x.vaultAddress) {
-		log.Debug("[BURN SIGNER] Transaction recipient is not vault address")
+		logger.Debug("[BURN SIGNER] Transaction recipient is not vault address")
 		return false, nil
 	}
 
 	if !strings.EqualFold(tx.StdTx.Msg.Value.FromAddress, doc.SenderAddress) {
-		log.Debug("[BURN SIGNER] Transaction signer is not sender address")
+		logger.Debug("[BURN SIGNER] Transaction signer is not sender address")
 		return false, nil
 	}
 
 	amount, ok := new(big.Int).SetString(tx.StdTx.Msg.Value.Amount, 10)
 
-	if !ok || amount.Cmp(x.minimumAmount) != 1 {
-		log.Debug("[BURN SIGNER] Transaction amount too low")
+	if !ok || amount.Cmp(x.txFee) != 1 {
+		logger.Debug("[BURN SIGNER] Transaction amount too low")
 		return false, nil
 	}
 
 	if tx.StdTx.Msg.Value.Amount != doc.Amount {
-		log.Debug("[BURN SIGNER] Transaction amount does not match invalid mint amount")
+		logger.Debug("[BURN SIGNER] Transaction amount does not match invalid mint amount")
 		return false, nil
 	}
 
 	if tx.StdTx.Memo != doc.Memo {
-		log.Debug("[BURN SIGNER] Memo mismatch")
+		logger.Debug("[BURN SIGNER] Memo mismatch")
 		return false, nil
 	}
 
 	_, valid := util.ValidateMemo(doc.Memo)
 	if valid {
-		log.Error("[BURN SIGNER] Memo is valid, should be invalid")
+		logger.Error("[BURN SIGNER] Memo is valid, should be invalid")
 		return false, nil
 	}
 
-	log.Debug("[BURN SIGNER] Validated invalid mint")
+	logger.Debug("[BURN SIGNER] Validated invalid mint")
 	return true, nil
 }
 
-func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
+func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint, lockToken int64) bool {
 	if doc == nil {
 		log.Error("[BURN SIGNER] Invalid mint is nil")
 		return false
 	}
-	log.Debug("[BURN SIGNER] Handling invalid mint: ", doc.TransactionHash)
+
+	logger := app.TransferLogger(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
+	statusFrom := doc.Status
+	pinnedTxFee, signersBefore := "", 0
+	defer x.StartSpan("BURN SIGNER HandleInvalidMint", app.TransferAttributes(models.CollectionInvalidMints, doc.Id, doc.TransactionHash)...)()
+	logger.Debug("[BURN SIGNER] Handling invalid mint: ", doc.TransactionHash)
 
 	doc, err := util.UpdateStatusAndConfirmationsForInvalidMint(doc, x.poktHeight)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error getting invalid mint status: ", err)
+		logger.Error("[BURN SIGNER] Error getting invalid mint status: ", err)
 		return false
 	}
 
@@ -148,12 +199,14 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 
 	valid, err := x.ValidateInvalidMint(doc)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error validating invalid mint: ", err)
+		logger.Error("[BURN SIGNER] Error validating invalid mint: ", err)
+		app.RecordFailure(x.validatorId, models.CollectionInvalidMints, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 		return false
 	}
 
 	if !valid {
-		log.Error("[BURN SIGNER] Invalid mint failed validation")
+		logger.Error("[BURN SIGNER] Invalid mint failed validation")
+		app.AlertValidationFailed(BurnSignerName, models.CollectionInvalidMints, doc.Id, doc.TransactionHash)
 		update = bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
@@ -163,11 +216,13 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 	} else {
 
 		if doc.Status == models.StatusConfirmed {
-			log.Debug("[BURN SIGNER] Signing invalid mint")
+			logger.Debug("[BURN SIGNER] Signing invalid mint")
+			x.LogPinnedTxFee(logger, doc.TxFee)
+			pinnedTxFee, signersBefore = doc.TxFee, len(doc.Signers)
 
-			doc, err = util.SignInvalidMint(doc, x.privateKey, x.multisigPubKey, x.numSigners)
+			doc, err = util.SignInvalidMint(doc, x.privateKey, x.multisigPubKey, x.numSigners, x.txFee.Int64())
 			if err != nil {
-				log.Error("[BURN SIGNER] Error signing invalid mint: ", err)
+				logger.Error("[BURN SIGNER] Error signing invalid mint: ", err)
 				return false
 			}
 
@@ -175,13 +230,14 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 				"$set": bson.M{
 					"return_tx":     doc.ReturnTx,
 					"signers":       doc.Signers,
+					"tx_fee":        doc.TxFee,
 					"status":        doc.Status,
 					"confirmations": doc.Confirmations,
 					"updated_at":    time.Now(),
 				},
 			}
 		} else {
-			log.Debug("[BURN SIGNER] Not signing invalid mint")
+			logger.Debug("[BURN SIGNER] Not signing invalid mint")
 			update = bson.M{
 				"$set": bson.M{
 					"status":        doc.Status,
@@ -192,21 +248,27 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 		}
 	}
 
+	update["$set"].(bson.M)["lock_token"] = lockToken
+
 	filter := bson.M{
-		"_id":    doc.Id,
-		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"_id":        doc.Id,
+		"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"lock_token": app.LockTokenFilter(lockToken),
 	}
-	err = app.DB.UpdateOne(models.CollectionInvalidMints, filter, update)
+	err = x.DB().UpdateOne(models.CollectionInvalidMints, filter, update)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error updating invalid mint: ", err)
+		logger.Error("[BURN SIGNER] Error updating invalid mint: ", err)
 		return false
 	}
-	log.Info("[BURN SIGNER] Handled invalid mint: ", doc.TransactionHash)
+	x.AuditRepinnedTxFee(logger, models.CollectionInvalidMints, doc.Id, pinnedTxFee, signersBefore, len(doc.Signers))
+	logger.WithFields(app.StatusFields(statusFrom, update)).Info("[BURN SIGNER] Handled invalid mint: ", doc.TransactionHash)
 	return true
 }
 
 func (x *BurnSignerRunner) ValidateBurn(doc *models.Burn) (bool, error) {
-	log.Debug("[BURN SIGNER] Validating burn: ", doc.TransactionHash)
+	logger := app.TransferLogger(models.CollectionBurns, doc.Id, doc.TransactionHash)
+
+	logger.Debug("[BURN SIGNER] Validating burn: ", doc.TransactionHash)
 
 	txReceipt, err := x.ethClient.GetTransactionReceipt(doc.TransactionHash)
 
@@ -216,7 +278,7 @@ func (x *BurnSignerRunner) ValidateBurn(doc *models.Burn) (bool, error) {
 
 	logIndex, err := strconv.Atoi(doc.LogIndex)
 	if err != nil {
-		log.Debug("[BURN SIGNER] Error converting log index to int: ", err)
+		logger.Debug("[BURN SIGNER] Error converting log index to int: ", err)
 		return false, nil
 	}
 
@@ -230,62 +292,91 @@ func (x *BurnSignerRunner) ValidateBurn(doc *models.Burn) (bool, error) {
 	}
 
 	if burnLog == nil {
-		log.Debug("[BURN SIGNER] Burn log not found")
+		logger.Debug("[BURN SIGNER] Burn log not found")
 		return false, nil
 	}
 
 	burnEvent, err := x.wpoktContract.ParseBurnAndBridge(*burnLog)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error parsing burn event: ", err)
+		logger.Error("[BURN SIGNER] Error parsing burn event: ", err)
 		return false, nil
 	}
 
 	amount, ok := new(big.Int).SetString(doc.Amount, 10)
-	if !ok || amount.Cmp(x.minimumAmount) != 1 {
-		log.Debug("[BURN SIGNER] Burn amount too low")
+	if !ok || amount.Cmp(x.txFee) != 1 {
+		logger.Debug("[BURN SIGNER] Burn amount too low")
 		return false, nil
 	}
 
 	if burnEvent.Amount.Cmp(amount) != 0 {
-		log.Error("[BURN SIGNER] Invalid burn amount")
+		logger.Error("[BURN SIGNER] Invalid burn amount")
 		return false, nil
 	}
 	if !strings.EqualFold(burnEvent.From.Hex(), doc.SenderAddress) {
-		log.Error("[BURN SIGNER] Invalid burn sender")
+		logger.Error("[BURN SIGNER] Invalid burn sender")
 		return false, nil
 	}
 	receiver := common.HexToAddress(fmt.Sprintf("0x%s", doc.RecipientAddress))
 	if !strings.EqualFold(burnEvent.PoktAddress.Hex(), receiver.Hex()) {
-		log.Error("[BURN SIGNER] Invalid burn recipient")
+		logger.Error("[BURN SIGNER] Invalid burn recipient")
 		return false, nil
 	}
 
-	log.Debug("[BURN SIGNER] Validated burn")
+	if err := app.ScreenAddresses(
+		app.ScreenedAddress{Role: "sender", Address: doc.SenderAddress},
+		app.ScreenedAddress{Role: "recipient", Address: doc.RecipientAddress},
+	); err != nil {
+		return false, err
+	}
+
+	logger.Debug("[BURN SIGNER] Validated burn")
 	return true, nil
 }
 
-func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
+func (x *BurnSignerRunner) HandleBurn(doc *models.Burn, lockToken int64) bool {
 	if doc == nil {
 		log.Error("[BURN SIGNER] Burn is nil")
 		return false
 	}
-	log.Debug("[BURN SIGNER] Handling burn: ", doc.TransactionHash)
+
+	logger := app.TransferLogger(models.CollectionBurns, doc.Id, doc.TransactionHash)
+	statusFrom := doc.Status
+	pinnedTxFee, signersBefore := "", 0
+	defer x.StartSpan("BURN SIGNER HandleBurn", app.TransferAttributes(models.CollectionBurns, doc.Id, doc.TransactionHash)...)()
+	logger.Debug("[BURN SIGNER] Handling burn: ", doc.TransactionHash)
+
+	if doc.Status == models.StatusHeld || doc.Status == models.StatusAwaitingApproval {
+		// held burns and burns awaiting approval were confirmed, check them again
+		doc.Status = models.StatusConfirmed
+	}
 
 	doc, err := util.UpdateStatusAndConfirmationsForBurn(doc, x.ethBlockNumber)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error getting burn status: ", err)
+		logger.Error("[BURN SIGNER] Error getting burn status: ", err)
 		return false
 	}
 
 	var update bson.M
 
 	valid, err := x.ValidateBurn(doc)
-	if err != nil {
-		log.Error("[BURN SIGNER] Error validating burn: ", err)
+	var quarantine *app.QuarantineError
+	if errors.As(err, &quarantine) {
+		logger.Warn("[BURN SIGNER] Quarantining burn: ", quarantine.Reason)
+		app.AlertQuarantined(BurnSignerName, models.CollectionBurns, doc.Id, doc.TransactionHash, quarantine.Reason)
+		update = bson.M{
+			"$set": bson.M{
+				"status":            models.StatusQuarantined,
+				"quarantine_reason": quarantine.Reason,
+				"updated_at":        time.Now(),
+			},
+		}
+	} else if err != nil {
+		logger.Error("[BURN SIGNER] Error validating burn: ", err)
+		app.RecordFailure(x.validatorId, models.CollectionBurns, doc.Id, doc.Status, doc.Attempts, lockToken, err)
 		return false
-	}
-	if !valid {
-		log.Error("[BURN SIGNER] Burn failed validation")
+	} else if !valid {
+		logger.Error("[BURN SIGNER] Burn failed validation")
+		app.AlertValidationFailed(BurnSignerName, models.CollectionBurns, doc.Id, doc.TransactionHash)
 		update = bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
@@ -294,11 +385,55 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 		}
 	} else {
 
-		if doc.Status == models.StatusConfirmed {
-			log.Debug("[BURN SIGNER] Signing burn")
-			doc, err = util.SignBurn(doc, x.privateKey, x.multisigPubKey, x.numSigners)
+		amount, _ := new(big.Int).SetString(doc.Amount, 10)
+		awaitingApproval := false
+		if doc.Status == models.StatusConfirmed && app.RequiresApproval(models.CollectionBurns, amount) {
+			message := app.ApprovalMessage(models.CollectionBurns, doc.Id.Hex(), doc.TransactionHash, doc.RecipientAddress, doc.Amount)
+			awaitingApproval = !app.Approved(message, doc.Approvals)
+		}
+
+		var holdReason string
+		if doc.Status == models.StatusConfirmed && !awaitingApproval {
+			holdReason, err = app.HoldReason(x.DB(), models.CollectionBurns,
+				bson.M{"wpokt_address": x.wpoktAddress},
+				app.VolumeTransfer{Id: doc.Id, Sender: doc.SenderAddress, Recipient: doc.RecipientAddress, Amount: amount},
+				app.LiveConfig().VolumeLimits.Burns,
+			)
 			if err != nil {
-				log.Error("[BURN SIGNER] Error signing burn: ", err)
+				logger.Error("[BURN SIGNER] Error checking volume limits: ", err)
+				return false
+			}
+		}
+
+		if awaitingApproval {
+			logger.Info("[BURN SIGNER] Burn awaiting approval")
+			if statusFrom != models.StatusAwaitingApproval {
+				app.AlertAwaitingApproval(BurnSignerName, models.CollectionBurns, doc.Id, doc.TransactionHash, doc.Amount)
+			}
+			update = bson.M{
+				"$set": bson.M{
+					"status":        models.StatusAwaitingApproval,
+					"confirmations": doc.Confirmations,
+					"updated_at":    time.Now(),
+				},
+			}
+		} else if holdReason != "" {
+			logger.Warn("[BURN SIGNER] Holding burn: ", holdReason)
+			update = bson.M{
+				"$set": bson.M{
+					"status":        models.StatusHeld,
+					"held_reason":   holdReason,
+					"confirmations": doc.Confirmations,
+					"updated_at":    time.Now(),
+				},
+			}
+		} else if doc.Status == models.StatusConfirmed {
+			logger.Debug("[BURN SIGNER] Signing burn")
+			x.LogPinnedTxFee(logger, doc.TxFee)
+			pinnedTxFee, signersBefore = doc.TxFee, len(doc.Signers)
+			doc, err = util.SignBurn(doc, x.privateKey, x.multisigPubKey, x.numSigners, x.txFee.Int64())
+			if err != nil {
+				logger.Error("[BURN SIGNER] Error signing burn: ", err)
 				return false
 			}
 
@@ -306,13 +441,21 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 				"$set": bson.M{
 					"return_tx":     doc.ReturnTx,
 					"signers":       doc.Signers,
+					"tx_fee":        doc.TxFee,
 					"status":        doc.Status,
 					"confirmations": doc.Confirmations,
 					"updated_at":    time.Now(),
 				},
 			}
+			if doc.CountedAt.IsZero() {
+				update["$set"].(bson.M)["counted_at"] = time.Now()
+			}
+			if statusFrom == models.StatusHeld {
+				logger.Info("[BURN SIGNER] Releasing held burn")
+				update["$set"].(bson.M)["held_reason"] = ""
+			}
 		} else {
-			log.Debug("[BURN SIGNER] Not signing burn")
+			logger.Debug("[BURN SIGNER] Not signing burn")
 			update = bson.M{
 				"$set": bson.M{
 					"status":        doc.Status,
@@ -323,16 +466,20 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 		}
 	}
 
+	update["$set"].(bson.M)["lock_token"] = lockToken
+
 	filter := bson.M{
-		"_id":    doc.Id,
-		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		"_id":        doc.Id,
+		"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+		"lock_token": app.LockTokenFilter(lockToken),
 	}
-	err = app.DB.UpdateOne(models.CollectionBurns, filter, update)
+	err = x.DB().UpdateOne(models.CollectionBurns, filter, update)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error updating burn: ", err)
+		logger.Error("[BURN SIGNER] Error updating burn: ", err)
 		return false
 	}
-	log.Info("[BURN SIGNER] Handled burn: ", doc.TransactionHash)
+	x.AuditRepinnedTxFee(logger, models.CollectionBurns, doc.Id, pinnedTxFee, signersBefore, len(doc.Signers))
+	logger.WithFields(app.StatusFields(statusFrom, update)).Info("[BURN SIGNER] Handled burn: ", doc.TransactionHash)
 
 	return true
 }
@@ -346,10 +493,11 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 		"vault_address": x.vaultAddress,
 		"status":        statusFilter,
 		"signers":       signersFilter,
+		"$expr":         app.RetryDueFilter(x.validatorId),
 	}
 
 	invalidMints := []models.InvalidMint{}
-	err := app.DB.FindMany(models.CollectionInvalidMints, filter, &invalidMints)
+	err := x.DB().FindMany(models.CollectionInvalidMints, filter, &invalidMints)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching invalid mints: ", err)
 		return false
@@ -361,7 +509,7 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 		doc := invalidMints[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionInvalidMints, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[BURN SIGNER] Error locking invalid mint: ", err)
 			success = false
@@ -369,9 +517,9 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 		}
 		log.Debug("[BURN SIGNER] Locked invalid mint: ", doc.TransactionHash)
 
-		success = x.HandleInvalidMint(&doc) && success
+		success = x.HandleInvalidMint(&doc, lockToken) && success
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = x.DB().Unlock(lockId); err != nil {
 			log.Error("[BURN SIGNER] Error unlocking invalid mint: ", err)
 			success = false
 		} else {
@@ -388,15 +536,16 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 	log.Debug("[BURN SIGNER] Syncing burns")
 
 	signersFilter := bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}}
-	statusFilter := bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}}
+	statusFilter := bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}}
 	filter := bson.M{
 		"wpokt_address": x.wpoktAddress,
 		"status":        statusFilter,
 		"signers":       signersFilter,
+		"$expr":         app.RetryDueFilter(x.validatorId),
 	}
 
 	burns := []models.Burn{}
-	err := app.DB.FindMany(models.CollectionBurns, filter, &burns)
+	err := x.DB().FindMany(models.CollectionBurns, filter, &burns)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching burns: ", err)
 		return false
@@ -409,7 +558,7 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 		doc := burns[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionBurns, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lockId, lockToken, err := x.DB().XLock(resourceId)
 		if err != nil {
 			log.Error("[BURN SIGNER] Error locking burn: ", err)
 			success = false
@@ -417,9 +566,9 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 		}
 		log.Debug("[BURN SIGNER] Locked burn: ", doc.TransactionHash)
 
-		success = x.HandleBurn(&doc) && success
+		success = x.HandleBurn(&doc, lockToken) && success
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = x.DB().Unlock(lockId); err != nil {
 			log.Error("[BURN SIGNER] Error unlocking burn: ", err)
 			success = false
 		} else {
@@ -442,6 +591,23 @@ func (x *BurnSignerRunner) SyncTxs() bool {
 	return success
 }
 
+// UpdatePrivateKey loads the private key again after it was rotated
+func (x *BurnSignerRunner) UpdatePrivateKey() {
+	generation := app.SecretsGeneration()
+	if generation == x.keyGeneration {
+		return
+	}
+
+	pk, err := crypto.NewPrivateKey(app.LiveConfig().Pocket.PrivateKey)
+	if err != nil {
+		log.Error("[BURN SIGNER] Error loading rotated private key: ", err)
+		return
+	}
+	x.privateKey = pk
+	x.keyGeneration = generation
+	log.Info("[BURN SIGNER] Loaded rotated private key for public key: ", pk.PublicKey().RawString())
+}
+
 func NewBurnSigner(wg *sync.WaitGroup, health models.ServiceHealth) app.Service {
 	if !app.Config.BurnSigner.Enabled {
 		log.Debug("[BURN SIGNER] Disabled")
@@ -473,34 +639,39 @@ func NewBurnSigner(wg *sync.WaitGroup, health models.ServiceHealth) app.Service
 		log.Fatal("[BURN SIGNER] Multisig address does not match vault address")
 	}
 
-	poktClient := pokt.NewClient()
-	ethClient, err := eth.NewClient()
-	if err != nil {
-		log.Fatal("[BURN SIGNER] Error initializing ethereum client: ", err)
-	}
-
-	log.Debug("[BURN SIGNER] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
-	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
+	validatorId, err := app.ValidatorId()
 	if err != nil {
-		log.Fatal("[BURN SIGNER] Error initializing Wrapped Pocket contract", err)
+		log.Fatal("[BURN SIGNER] Error naming validator: ", err)
 	}
-	log.Debug("[BURN SIGNER] Connected to wpokt contract")
 
 	x := &BurnSignerRunner{
+		validatorId:    validatorId,
 		privateKey:     pk,
+		keyGeneration:  app.SecretsGeneration(),
 		multisigPubKey: multisigPk,
 		numSigners:     len(pks),
-		ethClient:      ethClient,
-		poktClient:     poktClient,
 		vaultAddress:   strings.ToLower(vaultAddress),
 		wpoktAddress:   strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
-		wpoktContract:  eth.NewWrappedPocketContract(contract),
-		minimumAmount:  big.NewInt(app.Config.Pocket.TxFee),
 	}
+	x.poktClient = pokt.NewClient(x)
+
+	ethClient, err := eth.NewClient(x)
+	if err != nil {
+		log.Fatal("[BURN SIGNER] Error initializing ethereum client: ", err)
+	}
+	x.ethClient = ethClient
+
+	log.Debug("[BURN SIGNER] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
+	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
+	if err != nil {
+		log.Fatal("[BURN SIGNER] Error initializing Wrapped Pocket contract", err)
+	}
+	x.wpoktContract = eth.NewWrappedPocketContract(contract, x)
+	log.Debug("[BURN SIGNER] Connected to wpokt contract")
 
 	x.UpdateBlocks()
 
 	log.Info("[BURN SIGNER] Initialized")
 
-	return app.NewRunnerService(BurnSignerName, x, wg, time.Duration(app.Config.BurnSigner.IntervalMillis)*time.Millisecond)
+	return app.NewRunnerService(BurnSignerName, x, wg, app.Config.BurnSigner.IntervalMillis.Duration())
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -22,6 +24,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -42,9 +45,8 @@ func NewTestBurnSigner(t *testing.T, mockContract *eth.MockWrappedPocketContract
 	}
 	multisigPk := crypto.PublicKeyMultiSignature{PublicKeys: pks}
 
-	app.Config.Pocket.TxFee = 10000
-
 	x := &BurnSignerRunner{
+		validatorId:    "wpokt-validator-01",
 		vaultAddress:   strings.ToLower(multisigPk.Address().String()),
 		wpoktAddress:   "wpoktaddress",
 		privateKey:     privateKey1,
@@ -55,7 +57,7 @@ func NewTestBurnSigner(t *testing.T, mockContract *eth.MockWrappedPocketContract
 		poktHeight:     0,
 		ethBlockNumber: 0,
 		wpoktContract:  mockContract,
-		minimumAmount:  big.NewInt(10000),
+		txFee:          big.NewInt(10000),
 	}
 	return x
 }
@@ -71,6 +73,79 @@ func TestBurnSignerStatus(t *testing.T) {
 	assert.Equal(t, status.PoktHeight, "0")
 }
 
+func TestBurnSignerUpdatePrivateKey(t *testing.T) {
+	t.Run("Key not rotated", func(t *testing.T) {
+		x := NewTestBurnSigner(t, nil, nil, nil)
+		privateKey := x.privateKey
+
+		x.UpdatePrivateKey()
+
+		assert.Equal(t, privateKey, x.privateKey)
+	})
+
+	t.Run("Key rotated", func(t *testing.T) {
+		app.Config.Pocket.PrivateKey = "f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055"
+		defer func() { app.Config.Pocket.PrivateKey = "" }()
+		x := NewTestBurnSigner(t, nil, nil, nil)
+		x.keyGeneration = -1
+
+		x.UpdatePrivateKey()
+
+		assert.Equal(t, "ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055", x.privateKey.PublicKey().RawString())
+		assert.Equal(t, app.SecretsGeneration(), x.keyGeneration)
+	})
+}
+
+func TestBurnSignerRunWithoutTxFee(t *testing.T) {
+
+	t.Run("Error without a known fee skips the run", func(t *testing.T) {
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		x := NewTestBurnSigner(t, eth.NewMockWrappedPocketContract(t), mockEthClient, mockPoktClient)
+		x.txFee = nil
+
+		mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil)
+		mockEthClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+		mockPoktClient.EXPECT().GetParam(pokt.FeeMultipliersParamKey).Return(nil, errors.New("error"))
+
+		x.Run()
+
+		assert.True(t, x.Status().Failed)
+	})
+}
+
+func TestBurnSignerAuditRepinnedTxFee(t *testing.T) {
+	id := primitive.NewObjectID()
+	logger := app.TransferLogger(models.CollectionBurns, &id, "")
+
+	t.Run("Kept signatures are not audited", func(t *testing.T) {
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnSigner(t, nil, nil, nil)
+
+		x.AuditRepinnedTxFee(logger, models.CollectionBurns, &id, "10000", 1, 2)
+		x.AuditRepinnedTxFee(logger, models.CollectionBurns, &id, "", 0, 1)
+
+		mockDB.AssertNotCalled(t, "InsertOne", models.CollectionAuditLogs, mock.Anything)
+	})
+
+	t.Run("Discarded signatures are audited", func(t *testing.T) {
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnSigner(t, nil, nil, nil)
+		x.txFee = big.NewInt(20000)
+
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.MatchedBy(func(audit models.AuditLog) bool {
+			return audit.Action == models.AuditActionRepinTxFee &&
+				audit.Collection == models.CollectionBurns &&
+				audit.DocumentId == id.Hex() &&
+				audit.Reason == "discarded 2 signatures of pinned tx fee 10000 to sign with tx fee 20000"
+		})).Return(nil).Once()
+
+		x.AuditRepinnedTxFee(logger, models.CollectionBurns, &id, "10000", 2, 1)
+	})
+}
+
 func TestBurnSignerUpdateBlocks(t *testing.T) {
 
 	t.Run("No Error", func(t *testing.T) {
@@ -725,7 +800,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnSigner(t, mockContract, mockEthClient, mockPoktClient)
 
-		success := x.HandleInvalidMint(nil)
+		success := x.HandleInvalidMint(nil, 0)
 
 		assert.False(t, success)
 	})
@@ -745,7 +820,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			Height:        "invalid",
 		}
 
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.False(t, success)
 	})
@@ -768,7 +843,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 
 		mockPoktClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
 
-		success := x.HandleInvalidMint(invalidMint)
+		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.False(t, success)
 	})
@@ -815,14 +892,16 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 
 		filter := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -833,7 +912,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.True(t, success)
 	})
@@ -880,14 +959,16 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 
 		filter := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -898,7 +979,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.False(t, success)
 	})
@@ -944,7 +1025,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 
 		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.False(t, success)
 	})
@@ -961,7 +1042,6 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		app.Config.Pocket.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		invalidMint := &models.InvalidMint{
 			SenderAddress: x.privateKey.PublicKey().Address().String(),
@@ -992,15 +1072,18 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 
 		filter := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1016,7 +1099,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.True(t, success)
 	})
@@ -1062,8 +1145,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 
 		filter := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
@@ -1071,6 +1155,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 				"status":        models.StatusPending,
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 			},
 		}
 
@@ -1081,7 +1166,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		success := x.HandleInvalidMint(invalidMint, 0)
 
 		assert.True(t, success)
 	})
@@ -1098,7 +1183,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnSigner(t, mockContract, mockEthClient, mockPoktClient)
 
-		success := x.HandleBurn(nil)
+		success := x.HandleBurn(nil, 0)
 
 		assert.False(t, success)
 	})
@@ -1118,7 +1203,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			BlockNumber:   "invalid",
 		}
 
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
 
 		assert.False(t, success)
 	})
@@ -1141,7 +1226,9 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 
 		mockEthClient.EXPECT().GetTransactionReceipt("").Return(nil, errors.New("error"))
 
-		success := x.HandleBurn(burn)
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).Once()
+
+		success := x.HandleBurn(burn, 0)
 
 		assert.False(t, success)
 	})
@@ -1172,14 +1259,16 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
 
 		filter := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1189,7 +1278,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
 
 		assert.True(t, success)
 	})
@@ -1220,14 +1309,16 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
 
 		filter := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"status":     models.StatusFailed,
 				"updated_at": time.Now(),
+				"lock_token": int64(0),
 			},
 		}
 
@@ -1237,7 +1328,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
 
 		assert.False(t, success)
 	})
@@ -1276,7 +1367,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 
 		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
 
 		assert.False(t, success)
 	})
@@ -1293,7 +1384,6 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		app.Config.Ethereum.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		burn := &models.Burn{
 			Confirmations:    "1",
@@ -1319,15 +1409,19 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		filter := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
+				"counted_at":    time.Now(),
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1339,10 +1433,11 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 				assert.NotEmpty(t, returnTx)
 				gotUpdate.(bson.M)["$set"].(bson.M)["return_tx"] = ""
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
 
 		assert.True(t, success)
 	})
@@ -1383,8 +1478,9 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		filter := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
@@ -1392,6 +1488,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 				"status":        models.StatusPending,
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 			},
 		}
 
@@ -1401,7 +1498,117 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		success := x.HandleBurn(burn, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Held over global volume limit trips breaker", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnSigner(t, mockContract, mockEthClient, mockPoktClient)
+		app.Config.VolumeLimits.Burns.GlobalDaily = 50000
+		app.Config.CircuitBreaker.VolumeLimit = true
+		t.Cleanup(func() {
+			app.Config.VolumeLimits = models.VolumeLimitsConfig{}
+			app.Config.CircuitBreaker = models.CircuitBreakerConfig{}
+		})
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+
+		burn := &models.Burn{
+			Id:               &primitive.NilObjectID,
+			Confirmations:    "1",
+			BlockNumber:      "99",
+			Status:           models.StatusConfirmed,
+			LogIndex:         "0",
+			Amount:           "20000",
+			SenderAddress:    common.HexToAddress("0x1234").Hex(),
+			RecipientAddress: strings.ToLower(strings.Split(common.HexToAddress("0x1c").Hex(), "0x")[1]),
+		}
+
+		mockEthClient.EXPECT().GetTransactionReceipt("").Return(&types.Receipt{Logs: []*types.Log{{}}}, nil)
+		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(&autogen.WrappedPocketBurnAndBridge{
+			Amount:      big.NewInt(20000),
+			From:        common.HexToAddress("0x1234"),
+			PoktAddress: common.HexToAddress("0x1c"),
+		}, nil)
+
+		mockDB.EXPECT().FindMany(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, filter interface{}, result interface{}) {
+				assert.Equal(t, x.wpoktAddress, filter.(bson.M)["wpokt_address"])
+				*result.(*[]app.VolumeEntry) = []app.VolumeEntry{
+					{RecipientAddress: "other", Amount: "40000", CountedAt: time.Now().Add(-12 * time.Hour)},
+				}
+			}).Once()
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.BreakerTriggerVolumeLimit, update.(bson.M)["$set"].(bson.M)["trigger"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusHeld, set["status"])
+				assert.Equal(t, "global daily volume of 60000 would exceed the limit of 50000", set["held_reason"])
+				assert.Nil(t, set["return_tx"])
+			}).Once()
+
+		success := x.HandleBurn(burn, 0)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Quarantined recipient", func(t *testing.T) {
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockEthClient := eth.NewMockEthereumClient(t)
+		mockPoktClient := pokt.NewMockPocketClient(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnSigner(t, mockContract, mockEthClient, mockPoktClient)
+
+		recipient := strings.ToLower(strings.Split(common.HexToAddress("0x1c").Hex(), "0x")[1])
+		denyFile := filepath.Join(t.TempDir(), "deny.txt")
+		assert.Nil(t, os.WriteFile(denyFile, []byte("0x"+recipient+"\n"), 0600))
+		app.Config.Screening = models.ScreeningConfig{Enabled: true, DenyListFile: denyFile}
+		t.Cleanup(func() { app.Config.Screening = models.ScreeningConfig{} })
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+
+		burn := &models.Burn{
+			Id:               &primitive.NilObjectID,
+			Confirmations:    "1",
+			BlockNumber:      "99",
+			Status:           models.StatusConfirmed,
+			LogIndex:         "0",
+			Amount:           "20000",
+			SenderAddress:    common.HexToAddress("0x1234").Hex(),
+			RecipientAddress: recipient,
+		}
+
+		mockEthClient.EXPECT().GetTransactionReceipt("").Return(&types.Receipt{Logs: []*types.Log{{}}}, nil)
+		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(&autogen.WrappedPocketBurnAndBridge{
+			Amount:      big.NewInt(20000),
+			From:        common.HexToAddress("0x1234"),
+			PoktAddress: common.HexToAddress("0x1c"),
+		}, nil)
+
+		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+				set := gotUpdate.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusQuarantined, set["status"])
+				assert.Equal(t, "recipient "+recipient+" is on the deny list", set["quarantine_reason"])
+				assert.Nil(t, set["return_tx"])
+			}).Once()
+
+		success := x.HandleBurn(burn, 0)
 
 		assert.True(t, success)
 	})
@@ -1438,6 +1645,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
@@ -1459,6 +1667,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
@@ -1471,7 +1680,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 		success := x.SyncInvalidMints()
 
 		assert.False(t, success)
@@ -1490,6 +1699,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -1498,7 +1708,6 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		app.Config.Pocket.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		invalidMint := &models.InvalidMint{
 			Id:            &primitive.NilObjectID,
@@ -1530,15 +1739,18 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		}
 
 		filterUpdate := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1554,7 +1766,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -1584,6 +1796,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -1592,7 +1805,6 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		app.Config.Pocket.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		invalidMint := &models.InvalidMint{
 			Id:            &primitive.NilObjectID,
@@ -1624,15 +1836,18 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		}
 
 		filterUpdate := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1648,7 +1863,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -1695,8 +1910,9 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 
 		filter := bson.M{
 			"wpokt_address": x.wpoktAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionBurns, filter, mock.Anything).Return(nil)
@@ -1716,8 +1932,9 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
@@ -1730,7 +1947,7 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), errors.New("error"))
 		success := x.SyncBurns()
 
 		assert.False(t, success)
@@ -1747,8 +1964,9 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -1757,7 +1975,6 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		app.Config.Ethereum.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		burn := &models.Burn{
 			Id:               &primitive.NilObjectID,
@@ -1784,15 +2001,19 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		filterUpdate := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
+				"counted_at":    time.Now(),
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1806,7 +2027,7 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -1814,6 +2035,7 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				assert.NotEmpty(t, returnTx)
 				gotUpdate.(bson.M)["$set"].(bson.M)["return_tx"] = ""
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
@@ -1834,8 +2056,9 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -1844,7 +2067,6 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		app.Config.Ethereum.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		burn := &models.Burn{
 			Id:               &primitive.NilObjectID,
@@ -1871,15 +2093,19 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		filterUpdate := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
+				"counted_at":    time.Now(),
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1893,7 +2119,7 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil)
 
 		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -1901,6 +2127,7 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				assert.NotEmpty(t, returnTx)
 				gotUpdate.(bson.M)["$set"].(bson.M)["return_tx"] = ""
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
@@ -1924,12 +2151,15 @@ func TestBurnSignerRun(t *testing.T) {
 
 	mockPoktClient.EXPECT().GetHeight().Return(&pokt.HeightResponse{Height: 200}, nil)
 	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+	expectTxFee(mockPoktClient)
+	mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments)
 
 	{
 		filterFind := bson.M{
 			"vault_address": x.vaultAddress,
 			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -1938,7 +2168,6 @@ func TestBurnSignerRun(t *testing.T) {
 		app.Config.Pocket.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		invalidMint := &models.InvalidMint{
 			Id:            &primitive.NilObjectID,
@@ -1970,15 +2199,18 @@ func TestBurnSignerRun(t *testing.T) {
 		}
 
 		filterUpdate := bson.M{
-			"_id":    invalidMint.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        invalidMint.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -1994,7 +2226,7 @@ func TestBurnSignerRun(t *testing.T) {
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil).Once()
 
 		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -2011,8 +2243,9 @@ func TestBurnSignerRun(t *testing.T) {
 	{
 		filterFind := bson.M{
 			"wpokt_address": x.wpoktAddress,
-			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"status":        bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
 			"signers":       bson.M{"$nin": []string{strings.ToLower(x.privateKey.PublicKey().RawString())}},
+			"$expr":         app.RetryDueFilter(x.validatorId),
 		}
 
 		app.Config.Pocket.Confirmations = 0
@@ -2021,7 +2254,6 @@ func TestBurnSignerRun(t *testing.T) {
 		app.Config.Ethereum.Confirmations = 0
 		app.Config.Ethereum.ChainId = "31337"
 		app.Config.Pocket.ChainId = "testnet"
-		app.Config.Pocket.TxFee = 10000
 
 		burn := &models.Burn{
 			Id:               &primitive.NilObjectID,
@@ -2048,15 +2280,19 @@ func TestBurnSignerRun(t *testing.T) {
 		mockContract.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil).Once()
 
 		filterUpdate := bson.M{
-			"_id":    burn.Id,
-			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+			"_id":        burn.Id,
+			"status":     bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusHeld, models.StatusAwaitingApproval}},
+			"lock_token": app.LockTokenFilter(0),
 		}
 
 		update := bson.M{
 			"$set": bson.M{
 				"confirmations": "1",
 				"updated_at":    time.Now(),
+				"lock_token":    int64(0),
 				"return_tx":     "",
+				"tx_fee":        "10000",
+				"counted_at":    time.Now(),
 				"signers":       []string{x.privateKey.PublicKey().RawString()},
 				"status":        models.StatusConfirmed,
 			},
@@ -2070,7 +2306,7 @@ func TestBurnSignerRun(t *testing.T) {
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", int64(0), nil).Once()
 
 		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(nil).
 			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
@@ -2078,6 +2314,7 @@ func TestBurnSignerRun(t *testing.T) {
 				assert.NotEmpty(t, returnTx)
 				gotUpdate.(bson.M)["$set"].(bson.M)["return_tx"] = ""
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
+				gotUpdate.(bson.M)["$set"].(bson.M)["counted_at"] = update["$set"].(bson.M)["counted_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
@@ -2160,7 +2397,7 @@ func TestNewBurnSigner(t *testing.T) {
 	t.Run("Invalid ETH RPC", func(t *testing.T) {
 
 		app.Config.BurnSigner.Enabled = true
-		app.Config.Pocket.PrivateKey = "8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82"
+		app.Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
 		app.Config.Ethereum.RPCURL = ""
 		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
 
@@ -2181,7 +2418,7 @@ func TestNewBurnSigner(t *testing.T) {
 	t.Run("Interval is 0", func(t *testing.T) {
 
 		app.Config.BurnSigner.Enabled = true
-		app.Config.Pocket.PrivateKey = "8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82"
+		app.Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
 		app.Config.Ethereum.RPCURL = "https://eth.llamarpc.com"
 		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
 
@@ -2199,7 +2436,7 @@ func TestNewBurnSigner(t *testing.T) {
 	t.Run("Valid", func(t *testing.T) {
 
 		app.Config.BurnSigner.Enabled = true
-		app.Config.Pocket.PrivateKey = "8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82"
+		app.Config.Pocket.PrivateKey = "5efedbbc3d3d6f82d78eaf21258c81f462f3a25268be0018d4d75e1a4787bd14eb0cf2a891382677f03c1b080ec270c693dda7a4c3ee4bcac259ad47c5fe0743"
 		app.Config.BurnSigner.IntervalMillis = 1
 		app.Config.Ethereum.RPCURL = "https://eth.llamarpc.com"
 		app.Config.Pocket.VaultAddress = "E3BB46007E9BF127FD69B02DD5538848A80CADCE"
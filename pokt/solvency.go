@@ -0,0 +1,180 @@
+package pokt
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	SolvencyCheckerName = "SOLVENCY CHECKER"
+)
+
+// SolvencyCheckerRunner checks that the vault holds the POKT backing every wPOKT, plus the
+// POKT of transfers that are still in flight
+type SolvencyCheckerRunner struct {
+	app.Traced
+
+	client        pokt.PocketClient
+	wpoktContract eth.WrappedPocketContract
+	vaultAddress  string
+	wpoktAddress  string
+	report        *models.SolvencyReport
+	failed        bool
+}
+
+func (x *SolvencyCheckerRunner) Run() {
+	x.failed = !x.Check()
+}
+
+func (x *SolvencyCheckerRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{
+		Failed:   x.failed,
+		Solvency: x.report,
+	}
+}
+
+// inFlight is every status a transfer is in before it completes or fails
+var inFlight = bson.M{"$nin": []string{models.StatusSuccess, models.StatusFailed}}
+
+// PendingAmount sums the amounts of the transfers in a collection that are still in flight
+func (x *SolvencyCheckerRunner) PendingAmount(collection string, filter bson.M) (*big.Int, error) {
+	var docs []struct {
+		Amount string `bson:"amount"`
+	}
+	filter["status"] = inFlight
+	if err := x.DB().FindMany(collection, filter, &docs); err != nil {
+		return nil, err
+	}
+
+	total := big.NewInt(0)
+	for _, doc := range docs {
+		amount, ok := new(big.Int).SetString(doc.Amount, 10)
+		if !ok {
+			return nil, errors.New("invalid amount " + doc.Amount + " in " + collection)
+		}
+		total.Add(total, amount)
+	}
+	return total, nil
+}
+
+// NewSolvencyReport compares the vault balance with the total supply and the pending transfers
+func NewSolvencyReport(vaultBalance, totalSupply, pendingMints, pendingInvalidMints, pendingBurns *big.Int, tolerance int64) models.SolvencyReport {
+	expected := new(big.Int).Add(totalSupply, pendingMints)
+	expected.Add(expected, pendingInvalidMints)
+	expected.Add(expected, pendingBurns)
+
+	discrepancy := new(big.Int).Sub(vaultBalance, expected)
+	limit := big.NewInt(tolerance)
+
+	return models.SolvencyReport{
+		VaultBalance:         vaultBalance.String(),
+		TotalSupply:          totalSupply.String(),
+		PendingMints:         pendingMints.String(),
+		PendingInvalidMints:  pendingInvalidMints.String(),
+		PendingBurns:         pendingBurns.String(),
+		ExpectedVaultBalance: expected.String(),
+		Discrepancy:          discrepancy.String(),
+		Tolerance:            limit.String(),
+		WithinTolerance:      new(big.Int).Abs(discrepancy).Cmp(limit) <= 0,
+		CheckedAt:            time.Now(),
+	}
+}
+
+func (x *SolvencyCheckerRunner) Check() bool {
+	log.Debug("[SOLVENCY CHECKER] Checking solvency")
+
+	balance, err := x.client.GetBalance(x.vaultAddress)
+	if err != nil || balance.Balance == nil {
+		log.Error("[SOLVENCY CHECKER] Error fetching vault balance: ", err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Config.Ethereum.RPCTimeoutMillis.Duration())
+	defer cancel()
+	totalSupply, err := x.wpoktContract.TotalSupply(&bind.CallOpts{Context: ctx, Pending: false})
+	if err != nil {
+		log.Error("[SOLVENCY CHECKER] Error fetching total supply: ", err)
+		return false
+	}
+
+	pendingMints, err := x.PendingAmount(models.CollectionMints, bson.M{"wpokt_address": x.wpoktAddress, "vault_address": x.vaultAddress})
+	if err != nil {
+		log.Error("[SOLVENCY CHECKER] Error fetching pending mints: ", err)
+		return false
+	}
+	pendingInvalidMints, err := x.PendingAmount(models.CollectionInvalidMints, bson.M{"vault_address": x.vaultAddress})
+	if err != nil {
+		log.Error("[SOLVENCY CHECKER] Error fetching pending invalid mints: ", err)
+		return false
+	}
+	pendingBurns, err := x.PendingAmount(models.CollectionBurns, bson.M{"wpokt_address": x.wpoktAddress})
+	if err != nil {
+		log.Error("[SOLVENCY CHECKER] Error fetching pending burns: ", err)
+		return false
+	}
+
+	report := NewSolvencyReport(balance.Balance, totalSupply, pendingMints, pendingInvalidMints, pendingBurns, app.LiveConfig().Solvency.Tolerance)
+	x.report = &report
+
+	logger := log.WithFields(log.Fields{
+		"vault_balance":          report.VaultBalance,
+		"expected_vault_balance": report.ExpectedVaultBalance,
+		"discrepancy":            report.Discrepancy,
+	})
+	if !report.WithinTolerance {
+		logger.Error("[SOLVENCY CHECKER] Vault balance is off by ", report.Discrepancy, ", more than the tolerance of ", report.Tolerance)
+		app.AlertSolvency(SolvencyCheckerName, report)
+		reason := "vault balance " + report.VaultBalance + " is off by " + report.Discrepancy + " from the expected " + report.ExpectedVaultBalance
+		app.TripBreaker(models.BreakerTriggerSolvency, reason, app.ValidatorOperator())
+		return true
+	}
+
+	logger.Info("[SOLVENCY CHECKER] Vault balance matches the total supply and pending transfers")
+	return true
+}
+
+func NewSolvencyChecker(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Service {
+	if !app.Config.SolvencyChecker.Enabled {
+		log.Debug("[SOLVENCY CHECKER] Disabled")
+		return app.NewEmptyService(wg)
+	}
+
+	log.Debug("[SOLVENCY CHECKER] Initializing")
+
+	x := &SolvencyCheckerRunner{
+		vaultAddress: strings.ToLower(app.Config.Pocket.VaultAddress),
+		wpoktAddress: strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
+	}
+	x.client = pokt.NewClient(x)
+
+	ethClient, err := eth.NewClient(nil)
+	if err != nil {
+		log.Fatal("[SOLVENCY CHECKER] Error initializing ethereum client: ", err)
+	}
+
+	log.Debug("[SOLVENCY CHECKER] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
+	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
+	if err != nil {
+		log.Fatal("[SOLVENCY CHECKER] Error initializing Wrapped Pocket contract", err)
+	}
+	x.wpoktContract = eth.NewWrappedPocketContract(contract, x)
+	log.Debug("[SOLVENCY CHECKER] Connected to wpokt contract")
+
+	log.Info("[SOLVENCY CHECKER] Initialized")
+
+	return app.NewRunnerService(SolvencyCheckerName, x, wg, app.Config.SolvencyChecker.IntervalMillis.Duration())
+}
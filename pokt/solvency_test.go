@@ -0,0 +1,148 @@
+package pokt
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/models"
+	pokt "github.com/dan13ram/wpokt-validator/pokt/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func NewTestSolvencyChecker(t *testing.T, mockClient *pokt.MockPocketClient, mockContract *eth.MockWrappedPocketContract) *SolvencyCheckerRunner {
+	x := &SolvencyCheckerRunner{
+		vaultAddress:  "vaultaddress",
+		wpoktAddress:  "wpoktaddress",
+		client:        mockClient,
+		wpoktContract: mockContract,
+	}
+	return x
+}
+
+func expectPendingAmounts(mockDB *app.MockDatabase, collection string, filter bson.M, amounts ...string) {
+	filter["status"] = bson.M{"$nin": []string{models.StatusSuccess, models.StatusFailed}}
+	mockDB.EXPECT().FindMany(collection, filter, mock.Anything).Return(nil).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			docs := result.(*[]struct {
+				Amount string `bson:"amount"`
+			})
+			for _, amount := range amounts {
+				*docs = append(*docs, struct {
+					Amount string `bson:"amount"`
+				}{Amount: amount})
+			}
+		}).Once()
+}
+
+func TestNewSolvencyReport(t *testing.T) {
+	report := NewSolvencyReport(big.NewInt(1000), big.NewInt(700), big.NewInt(100), big.NewInt(50), big.NewInt(100), 50)
+
+	assert.Equal(t, "950", report.ExpectedVaultBalance)
+	assert.Equal(t, "50", report.Discrepancy)
+	assert.True(t, report.WithinTolerance)
+
+	report = NewSolvencyReport(big.NewInt(900), big.NewInt(700), big.NewInt(100), big.NewInt(50), big.NewInt(100), 10)
+
+	assert.Equal(t, "-50", report.Discrepancy)
+	assert.False(t, report.WithinTolerance)
+}
+
+func TestSolvencyCheckerCheck(t *testing.T) {
+	setup := func(t *testing.T) (*app.MockDatabase, *pokt.MockPocketClient, *eth.MockWrappedPocketContract, *SolvencyCheckerRunner) {
+		mockClient := pokt.NewMockPocketClient(t)
+		mockContract := eth.NewMockWrappedPocketContract(t)
+		mockDB := app.NewMockDatabase(t)
+		app.DB = mockDB
+		t.Cleanup(func() {
+			app.DB = nil
+			app.Config.Solvency = models.SolvencyConfig{}
+		})
+		return mockDB, mockClient, mockContract, NewTestSolvencyChecker(t, mockClient, mockContract)
+	}
+
+	t.Run("Solvent", func(t *testing.T) {
+		mockDB, mockClient, mockContract, x := setup(t)
+
+		mockClient.EXPECT().GetBalance("vaultaddress").Return(&pokt.BalanceResponse{Balance: big.NewInt(1000)}, nil).Once()
+		mockContract.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(600), nil).Once()
+		expectPendingAmounts(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpoktaddress", "vault_address": "vaultaddress"}, "100", "200")
+		expectPendingAmounts(mockDB, models.CollectionInvalidMints, bson.M{"vault_address": "vaultaddress"}, "50")
+		expectPendingAmounts(mockDB, models.CollectionBurns, bson.M{"wpokt_address": "wpoktaddress"}, "50")
+
+		x.Run()
+
+		status := x.Status()
+		assert.False(t, status.Failed)
+		assert.Equal(t, "0", status.Solvency.Discrepancy)
+		assert.Equal(t, "300", status.Solvency.PendingMints)
+		assert.True(t, status.Solvency.WithinTolerance)
+	})
+
+	t.Run("Discrepancy beyond tolerance", func(t *testing.T) {
+		mockDB, mockClient, mockContract, x := setup(t)
+		app.Config.Solvency.Tolerance = 10
+
+		mockClient.EXPECT().GetBalance("vaultaddress").Return(&pokt.BalanceResponse{Balance: big.NewInt(900)}, nil).Once()
+		mockContract.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(1000), nil).Once()
+		expectPendingAmounts(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpoktaddress", "vault_address": "vaultaddress"})
+		expectPendingAmounts(mockDB, models.CollectionInvalidMints, bson.M{"vault_address": "vaultaddress"})
+		expectPendingAmounts(mockDB, models.CollectionBurns, bson.M{"wpokt_address": "wpoktaddress"})
+
+		x.Run()
+
+		status := x.Status()
+		assert.False(t, status.Failed)
+		assert.Equal(t, "-100", status.Solvency.Discrepancy)
+		assert.False(t, status.Solvency.WithinTolerance)
+	})
+
+	t.Run("Discrepancy trips signing breaker", func(t *testing.T) {
+		mockDB, mockClient, mockContract, x := setup(t)
+		app.Config.Solvency.Tolerance = 10
+		app.Config.CircuitBreaker.SolvencyMismatch = true
+		t.Cleanup(func() { app.Config.CircuitBreaker = models.CircuitBreakerConfig{} })
+
+		mockClient.EXPECT().GetBalance("vaultaddress").Return(&pokt.BalanceResponse{Balance: big.NewInt(900)}, nil).Once()
+		mockContract.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(1000), nil).Once()
+		expectPendingAmounts(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpoktaddress", "vault_address": "vaultaddress"})
+		expectPendingAmounts(mockDB, models.CollectionInvalidMints, bson.M{"vault_address": "vaultaddress"})
+		expectPendingAmounts(mockDB, models.CollectionBurns, bson.M{"wpokt_address": "wpoktaddress"})
+		mockDB.EXPECT().FindOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().UpsertOne(models.CollectionCircuitBreakers, bson.M{"_id": models.SigningBreakerId}, mock.Anything).Return(nil).
+			Run(func(_ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.BreakerTriggerSolvency, update.(bson.M)["$set"].(bson.M)["trigger"])
+			}).Once()
+		mockDB.EXPECT().InsertOne(models.CollectionAuditLogs, mock.Anything).Return(nil).Once()
+
+		x.Run()
+
+		assert.False(t, x.Status().Failed)
+	})
+
+	t.Run("Error fetching balance", func(t *testing.T) {
+		_, mockClient, _, x := setup(t)
+
+		mockClient.EXPECT().GetBalance("vaultaddress").Return(nil, errors.New("error")).Once()
+
+		x.Run()
+
+		assert.True(t, x.Status().Failed)
+		assert.Nil(t, x.Status().Solvency)
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		mockDB, mockClient, mockContract, x := setup(t)
+
+		mockClient.EXPECT().GetBalance("vaultaddress").Return(&pokt.BalanceResponse{Balance: big.NewInt(900)}, nil).Once()
+		mockContract.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(1000), nil).Once()
+		expectPendingAmounts(mockDB, models.CollectionMints, bson.M{"wpokt_address": "wpoktaddress", "vault_address": "vaultaddress"}, "abc")
+
+		assert.False(t, x.Check())
+	})
+}
@@ -0,0 +1,140 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+const (
+	SlotSigned  = "signed"
+	SlotMissing = "missing"
+	SlotInvalid = "invalid"
+)
+
+// SignatureSlot is the partial signature held by one key of the multisig
+type SignatureSlot struct {
+	Index     int
+	PublicKey string
+	Status    string
+}
+
+// DecodedTx is a multisig return transaction in readable form
+type DecodedTx struct {
+	FromAddress   string
+	ToAddress     string
+	Amount        string
+	Fee           string
+	Memo          string
+	Entropy       int64
+	MultisigMatch bool
+	Slots         []SignatureSlot
+}
+
+// Complete reports whether every multisig slot holds a valid signature
+func (d DecodedTx) Complete() bool {
+	if len(d.Slots) == 0 {
+		return false
+	}
+	for _, slot := range d.Slots {
+		if slot.Status != SlotSigned {
+			return false
+		}
+	}
+	return true
+}
+
+// Valid reports whether the transaction is from the configured multisig and holds no bad signatures
+func (d DecodedTx) Valid() bool {
+	if !d.MultisigMatch {
+		return false
+	}
+	for _, slot := range d.Slots {
+		if slot.Status == SlotInvalid {
+			return false
+		}
+	}
+	return true
+}
+
+// DecodeReturnTx decodes a hex encoded return transaction and verifies each partial signature
+// against the multisig public keys, in order
+func DecodeReturnTx(txHex string, chainID string, multisigKeys []crypto.PublicKey) (DecodedTx, error) {
+	tx, bytesToSign, err := decodeTx(txHex, chainID)
+	if err != nil {
+		return DecodedTx{}, err
+	}
+
+	var msg nodeTypes.MsgSend
+	switch m := tx.GetMsg().(type) {
+	case nodeTypes.MsgSend:
+		msg = m
+	case *nodeTypes.MsgSend:
+		msg = *m
+	default:
+		return DecodedTx{}, errors.New("transaction is not a MsgSend")
+	}
+
+	decoded := DecodedTx{
+		FromAddress: strings.ToLower(msg.FromAddress.String()),
+		ToAddress:   strings.ToLower(msg.ToAddress.String()),
+		Amount:      msg.Amount.String(),
+		Fee:         tx.GetFee().String(),
+		Memo:        tx.GetMemo(),
+		Entropy:     tx.GetEntropy(),
+	}
+
+	multisigPk := crypto.PublicKeyMultiSignature{PublicKeys: multisigKeys}
+	if tx.Signature.PublicKey != nil {
+		decoded.MultisigMatch = multisigPk.Equals(tx.Signature.PublicKey)
+	}
+
+	sigs, err := unmarshalSignatures(tx.GetSignature().GetSignature())
+	if err != nil {
+		return DecodedTx{}, err
+	}
+
+	for i, key := range multisigKeys {
+		slot := SignatureSlot{
+			Index:     i,
+			PublicKey: strings.ToLower(key.RawString()),
+			Status:    SlotMissing,
+		}
+		if i < len(sigs) && len(sigs[i]) > 1 {
+			slot.Status = verifySlot(bytesToSign, sigs[i], i, multisigKeys)
+		}
+		decoded.Slots = append(decoded.Slots, slot)
+	}
+
+	return decoded, nil
+}
+
+// verifySlot checks a partial signature against the key of its slot. The first signer fills
+// every slot with its own signature, so a slot signed by another key is still missing.
+func verifySlot(bytesToSign []byte, sig []byte, index int, keys []crypto.PublicKey) string {
+	if keys[index].VerifyBytes(bytesToSign, sig) {
+		return SlotSigned
+	}
+	for i, key := range keys {
+		if i != index && key.VerifyBytes(bytesToSign, sig) {
+			return SlotMissing
+		}
+	}
+	return SlotInvalid
+}
+
+func unmarshalSignatures(bz []byte) (sigs [][]byte, err error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid multisignature: %v", r)
+		}
+	}()
+	var ms crypto.MultiSignature
+	return ms.Unmarshal(bz).Signatures(), nil
+}
@@ -0,0 +1,86 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReturnTx(t *testing.T) {
+	privateKey1, _ := crypto.NewPrivateKey("8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82")
+	privateKey2, _ := crypto.NewPrivateKey("f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055")
+	privateKey3, _ := crypto.NewPrivateKey("05339b10520335644fe486e4d39ce33db4d079b5c1d3bceb725e75e4354f5ca7351799d14073dca9e5b7d50355b6b3a85d28a6a4b7f67ecb2ac8217732c4070b")
+
+	pubKeys := []crypto.PublicKey{privateKey1.PublicKey(), privateKey2.PublicKey(), privateKey3.PublicKey()}
+	multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pubKeys}
+
+	app.Config.Pocket.ChainId = "testnet"
+
+	recipient := privateKey3.PublicKey().Address().String()
+	doc := &models.Burn{
+		Status:           models.StatusConfirmed,
+		Signers:          []string{},
+		RecipientAddress: recipient,
+		Amount:           "100000",
+		TransactionHash:  "transaction_hash",
+	}
+
+	t.Run("Partially signed", func(t *testing.T) {
+		signed, err := SignBurn(doc, privateKey1, multisigPubKey, 3, 10000)
+		assert.Nil(t, err)
+
+		decoded, err := DecodeReturnTx(signed.ReturnTx, "testnet", pubKeys)
+
+		assert.Nil(t, err)
+		assert.Equal(t, strings.ToLower(multisigPubKey.Address().String()), decoded.FromAddress)
+		assert.Equal(t, strings.ToLower(recipient), decoded.ToAddress)
+		assert.Equal(t, "90000", decoded.Amount)
+		assert.Equal(t, "10000upokt", decoded.Fee)
+		assert.Equal(t, "transaction_hash", decoded.Memo)
+		assert.True(t, decoded.MultisigMatch)
+		assert.Len(t, decoded.Slots, 3)
+		assert.Equal(t, SlotSigned, decoded.Slots[0].Status)
+		assert.Equal(t, SlotMissing, decoded.Slots[1].Status)
+		assert.Equal(t, SlotMissing, decoded.Slots[2].Status)
+		assert.False(t, decoded.Complete())
+		assert.True(t, decoded.Valid())
+	})
+
+	t.Run("Fully signed", func(t *testing.T) {
+		signed, _ := SignBurn(doc, privateKey2, multisigPubKey, 3, 10000)
+		signed, _ = SignBurn(signed, privateKey3, multisigPubKey, 3, 10000)
+
+		decoded, err := DecodeReturnTx(signed.ReturnTx, "testnet", pubKeys)
+
+		assert.Nil(t, err)
+		assert.True(t, decoded.Complete())
+		assert.True(t, decoded.Valid())
+	})
+
+	t.Run("Wrong chain id", func(t *testing.T) {
+		decoded, err := DecodeReturnTx(doc.ReturnTx, "mainnet", pubKeys)
+
+		assert.Nil(t, err)
+		assert.Equal(t, SlotInvalid, decoded.Slots[0].Status)
+		assert.False(t, decoded.Complete())
+		assert.False(t, decoded.Valid())
+	})
+
+	t.Run("Different multisig keys", func(t *testing.T) {
+		decoded, err := DecodeReturnTx(doc.ReturnTx, "testnet", []crypto.PublicKey{privateKey3.PublicKey(), privateKey1.PublicKey()})
+
+		assert.Nil(t, err)
+		assert.False(t, decoded.MultisigMatch)
+		assert.False(t, decoded.Valid())
+	})
+
+	t.Run("Invalid hex", func(t *testing.T) {
+		_, err := DecodeReturnTx("invalid", "testnet", pubKeys)
+
+		assert.NotNil(t, err)
+	})
+}
@@ -2,6 +2,8 @@ package util
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -19,24 +21,23 @@ import (
 var txEncoder sdk.TxEncoder = auth.DefaultTxEncoder(pokt.Codec())
 var txDecoder sdk.TxDecoder = auth.DefaultTxDecoder(pokt.Codec())
 
-func buildMultiSigTxAndSign(
+func newReturnTx(
 	toAddr string,
 	memo string,
 	chainID string,
 	amount int64,
 	fees int64,
-	signerKey crypto.PrivateKey,
 	multisigKey crypto.PublicKeyMultiSig,
-) ([]byte, error) {
+) (authTypes.StdTx, []byte, error) {
 
 	fa, err := sdk.AddressFromHex(multisigKey.Address().String())
 	if err != nil {
-		return nil, err
+		return authTypes.StdTx{}, nil, err
 	}
 
 	ta, err := sdk.AddressFromHex(toAddr)
 	if err != nil {
-		return nil, err
+		return authTypes.StdTx{}, nil, err
 	}
 
 	m := &nodeTypes.MsgSend{
@@ -50,14 +51,18 @@ func buildMultiSigTxAndSign(
 
 	signBz, err := authTypes.StdSignBytes(chainID, entropy, fee, m, memo)
 	if err != nil {
-		return nil, err
+		return authTypes.StdTx{}, nil, err
 	}
 
-	sigBytes, err := signerKey.Sign(signBz)
-	if err != nil {
-		return nil, err
+	sig := authTypes.StdSignature{
+		PublicKey: multisigKey,
 	}
 
+	return authTypes.NewTx(m, fee, sig, memo, entropy).(authTypes.StdTx), signBz, nil
+}
+
+// withFirstSignature fills every multisig slot with the signature of the first signer
+func withFirstSignature(tx authTypes.StdTx, sigBytes []byte, multisigKey crypto.PublicKeyMultiSig) (authTypes.StdTx, error) {
 	// sign using multisignature structure
 	var ms = crypto.MultiSig(crypto.MultiSignature{})
 	ms = ms.NewMultiSignature()
@@ -73,8 +78,34 @@ func buildMultiSigTxAndSign(
 		Signature: ms.Marshal(),
 	}
 
+	return tx.WithSignature(sig)
+}
+
+func buildMultiSigTxAndSign(
+	toAddr string,
+	memo string,
+	chainID string,
+	amount int64,
+	fees int64,
+	signerKey crypto.PrivateKey,
+	multisigKey crypto.PublicKeyMultiSig,
+) ([]byte, error) {
+
+	tx, signBz, err := newReturnTx(toAddr, memo, chainID, amount, fees, multisigKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := signerKey.Sign(signBz)
+	if err != nil {
+		return nil, err
+	}
+
 	// create a new standard transaction object
-	tx := authTypes.NewTx(m, fee, sig, memo, entropy)
+	tx, err = withFirstSignature(tx, sigBytes, multisigKey)
+	if err != nil {
+		return nil, err
+	}
 
 	// encode it using the default encoder
 	return txEncoder(tx, -1)
@@ -151,6 +182,154 @@ func signMultisigTx(
 	return txEncoder(tx, -1)
 }
 
+// BuildReturnTx creates an unsigned return transaction from the vault, deducting the tx fee from the amount
+func BuildReturnTx(toAddr string, amountWithTxFee string, memo string, txFee int64, multisigKey crypto.PublicKeyMultiSig) (string, error) {
+	amount, err := returnAmount(amountWithTxFee, txFee)
+	if err != nil {
+		return "", err
+	}
+
+	tx, _, err := newReturnTx(toAddr, memo, app.Config.Pocket.ChainId, amount, txFee, multisigKey)
+	if err != nil {
+		return "", err
+	}
+
+	txBytes, err := txEncoder(tx, -1)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(txBytes), nil
+}
+
+// returnAmount is the amount sent back once the tx fee is deducted
+func returnAmount(amountWithTxFee string, txFee int64) (int64, error) {
+	amount, err := strconv.ParseInt(amountWithTxFee, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if txFee <= 0 {
+		return 0, fmt.Errorf("invalid tx fee %d", txFee)
+	}
+	if amount <= txFee {
+		return 0, fmt.Errorf("amount %d does not cover the tx fee %d", amount, txFee)
+	}
+	return amount - txFee, nil
+}
+
+// ReturnTxFee reads the fee of a return transaction
+func ReturnTxFee(txHex string, chainID string) (int64, error) {
+	tx, _, err := decodeTx(txHex, chainID)
+	if err != nil {
+		return 0, err
+	}
+	return tx.GetFee().AmountOf(sdk.DefaultStakeDenom).Int64(), nil
+}
+
+// CheckReturnTx checks that a return transaction signed by another validator spends the amount
+// of the document with the fee pinned by the first signature, and returns that fee
+func CheckReturnTx(txHex string, chainID string, amountWithTxFee string, pinnedTxFee string) (int64, error) {
+	tx, _, err := decodeTx(txHex, chainID)
+	if err != nil {
+		return 0, err
+	}
+	fee := tx.GetFee().AmountOf(sdk.DefaultStakeDenom).Int64()
+	if pinnedTxFee != "" && strconv.FormatInt(fee, 10) != pinnedTxFee {
+		return 0, fmt.Errorf("return tx fee %d does not match the pinned tx fee %s", fee, pinnedTxFee)
+	}
+
+	var msgAmount sdk.BigInt
+	switch msg := tx.GetMsg().(type) {
+	case nodeTypes.MsgSend:
+		msgAmount = msg.Amount
+	case *nodeTypes.MsgSend:
+		msgAmount = msg.Amount
+	default:
+		return 0, errors.New("return tx message is not a send")
+	}
+	if msgAmount.AddRaw(fee).String() != amountWithTxFee {
+		return 0, fmt.Errorf("return tx amount %s and fee %d do not add up to %s", msgAmount, fee, amountWithTxFee)
+	}
+	return fee, nil
+}
+
+// pinTxFee decides the return transaction to sign. The first signer builds it with the tx fee
+// of the chain and the fee is pinned to the document, so that every co-signer signs the same
+// bytes even if their view of the fee differs. The signatures are only discarded when the chain
+// now charges more than the pinned fee, since the transaction would be rejected.
+func pinTxFee(returnTx string, signers []string, amountWithTxFee string, pinnedTxFee string, txFee int64) (string, []string, int64, error) {
+	if returnTx == "" || len(signers) == 0 {
+		return "", []string{}, txFee, nil
+	}
+	fee, err := CheckReturnTx(returnTx, app.Config.Pocket.ChainId, amountWithTxFee, pinnedTxFee)
+	if err != nil {
+		return returnTx, signers, 0, err
+	}
+	if fee < txFee {
+		return "", []string{}, txFee, nil
+	}
+	return returnTx, signers, fee, nil
+}
+
+// ReturnTxSignBytes returns the bytes every signer of a return transaction signs
+func ReturnTxSignBytes(txHex string, chainID string) ([]byte, error) {
+	_, bytesToSign, err := decodeTx(txHex, chainID)
+	return bytesToSign, err
+}
+
+// MultisigKeyIndex returns the index of a key in the multisig, or -1 when it is not a member
+func MultisigKeyIndex(key crypto.PublicKey, multisigKey crypto.PublicKeyMultiSig) int {
+	for i, member := range multisigKey.Keys() {
+		if member.Equals(key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddReturnTxSignature verifies a signature produced elsewhere and adds it to a return transaction
+func AddReturnTxSignature(
+	txHex string,
+	chainID string,
+	sigBytes []byte,
+	signerKey crypto.PublicKey,
+	multisigKey crypto.PublicKeyMultiSig,
+) (string, error) {
+	// the first signature fills every slot of the multisig, so a non member must never get that far
+	index := MultisigKeyIndex(signerKey, multisigKey)
+	if index < 0 {
+		return "", errors.New("signer is not a member of the multisig")
+	}
+
+	tx, bytesToSign, err := decodeTx(txHex, chainID)
+	if err != nil {
+		return "", err
+	}
+
+	if !signerKey.VerifyBytes(bytesToSign, sigBytes) {
+		return "", errors.New("signature does not match transaction")
+	}
+
+	if tx.GetSignature().GetSignature() == nil || len(tx.GetSignature().GetSignature()) == 0 {
+		tx, err = withFirstSignature(tx, sigBytes, multisigKey)
+	} else {
+		ms := crypto.MultiSig(crypto.MultiSignature{}).Unmarshal(tx.GetSignature().GetSignature())
+		ms = ms.AddSignatureByIndex(sigBytes, index)
+		tx, err = tx.WithSignature(authTypes.StdSignature{
+			PublicKey: tx.Signature.PublicKey,
+			Signature: ms.Marshal(),
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	txBytes, err := txEncoder(tx, -1)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(txBytes), nil
+}
+
 func UpdateStatusAndConfirmationsForInvalidMint(doc *models.InvalidMint, currentHeight int64) (*models.InvalidMint, error) {
 	status := doc.Status
 	confirmations, err := strconv.ParseInt(doc.Confirmations, 10, 64)
@@ -160,7 +339,7 @@ func UpdateStatusAndConfirmationsForInvalidMint(doc *models.InvalidMint, current
 
 	if status == models.StatusPending || confirmations == 0 {
 		status = models.StatusPending
-		if app.Config.Pocket.Confirmations == 0 {
+		if app.LiveConfig().Pocket.Confirmations == 0 {
 			status = models.StatusConfirmed
 		} else {
 			mintHeight, err := strconv.ParseInt(doc.Height, 10, 64)
@@ -168,7 +347,7 @@ func UpdateStatusAndConfirmationsForInvalidMint(doc *models.InvalidMint, current
 				return doc, err
 			}
 			confirmations = currentHeight - mintHeight
-			if confirmations >= app.Config.Pocket.Confirmations {
+			if confirmations >= app.LiveConfig().Pocket.Confirmations {
 				status = models.StatusConfirmed
 			}
 		}
@@ -185,21 +364,18 @@ func SignInvalidMint(
 	privateKey crypto.PrivateKey,
 	multisigPubKey crypto.PublicKeyMultiSig,
 	numSigners int,
+	txFee int64,
 ) (*models.InvalidMint, error) {
-	returnTx := doc.ReturnTx
-	signers := doc.Signers
-
-	if signers == nil || returnTx == "" || len(signers) == 0 {
-		signers = []string{}
-		returnTx = ""
+	returnTx, signers, txFee, err := pinTxFee(doc.ReturnTx, doc.Signers, doc.Amount, doc.TxFee, txFee)
+	if err != nil {
+		return doc, err
 	}
 
 	if returnTx == "" {
-		amountWithTxFee, err := strconv.ParseInt(doc.Amount, 10, 64)
+		amount, err := returnAmount(doc.Amount, txFee)
 		if err != nil {
 			return doc, err
 		}
-		amount := amountWithTxFee - app.Config.Pocket.TxFee
 		memo := doc.TransactionHash
 
 		returnTxBytes, err := buildMultiSigTxAndSign(
@@ -207,7 +383,7 @@ func SignInvalidMint(
 			memo,
 			app.Config.Pocket.ChainId,
 			amount,
-			app.Config.Pocket.TxFee,
+			txFee,
 			privateKey,
 			multisigPubKey,
 		)
@@ -236,6 +412,7 @@ func SignInvalidMint(
 
 	doc.ReturnTx = returnTx
 	doc.Signers = signers
+	doc.TxFee = strconv.FormatInt(txFee, 10)
 
 	return doc, nil
 }
@@ -249,7 +426,7 @@ func UpdateStatusAndConfirmationsForBurn(doc *models.Burn, blockNumber int64) (*
 
 	if status == models.StatusPending || confirmations == 0 {
 		status = models.StatusPending
-		if app.Config.Ethereum.Confirmations == 0 {
+		if app.LiveConfig().Ethereum.Confirmations == 0 {
 			status = models.StatusConfirmed
 		} else {
 			burnBlockNumber, err := strconv.ParseInt(doc.BlockNumber, 10, 64)
@@ -258,7 +435,7 @@ func UpdateStatusAndConfirmationsForBurn(doc *models.Burn, blockNumber int64) (*
 			}
 
 			confirmations = blockNumber - burnBlockNumber
-			if confirmations >= app.Config.Ethereum.Confirmations {
+			if confirmations >= app.LiveConfig().Ethereum.Confirmations {
 				status = models.StatusConfirmed
 			}
 		}
@@ -274,22 +451,19 @@ func SignBurn(
 	privateKey crypto.PrivateKey,
 	multisigPubKey crypto.PublicKeyMultiSig,
 	numSigners int,
+	txFee int64,
 ) (*models.Burn, error) {
 
-	signers := doc.Signers
-	returnTx := doc.ReturnTx
-
-	if signers == nil || returnTx == "" || len(signers) == 0 {
-		signers = []string{}
-		returnTx = ""
+	returnTx, signers, txFee, err := pinTxFee(doc.ReturnTx, doc.Signers, doc.Amount, doc.TxFee, txFee)
+	if err != nil {
+		return doc, err
 	}
 
 	if returnTx == "" {
-		amountWithTxFee, err := strconv.ParseInt(doc.Amount, 10, 64)
+		amount, err := returnAmount(doc.Amount, txFee)
 		if err != nil {
 			return doc, err
 		}
-		amount := amountWithTxFee - app.Config.Pocket.TxFee
 		memo := doc.TransactionHash
 
 		returnTxBytes, err := buildMultiSigTxAndSign(
@@ -297,7 +471,7 @@ func SignBurn(
 			memo,
 			app.Config.Pocket.ChainId,
 			amount,
-			app.Config.Pocket.TxFee,
+			txFee,
 			privateKey,
 			multisigPubKey,
 		)
@@ -327,6 +501,7 @@ func SignBurn(
 
 	doc.Signers = signers
 	doc.ReturnTx = returnTx
+	doc.TxFee = strconv.FormatInt(txFee, 10)
 
 	return doc, nil
 }
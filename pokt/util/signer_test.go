@@ -420,7 +420,6 @@ func TestSignBurn(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			app.Config.Pocket.ChainId = "testnet"
-			app.Config.Pocket.TxFee = 10000
 			pubKeys := []crypto.PublicKey{}
 			for i := 0; i < tc.numSigners; i++ {
 				pubKeys = append(pubKeys, privateKeys[i].PublicKey())
@@ -428,7 +427,7 @@ func TestSignBurn(t *testing.T) {
 			multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pubKeys}
 
 			inputDoc := tc.doc
-			result, err := SignBurn(&inputDoc, tc.privateKey, multisigPubKey, tc.numSigners)
+			result, err := SignBurn(&inputDoc, tc.privateKey, multisigPubKey, tc.numSigners, 10000)
 
 			if tc.expectedErr {
 				assert.Error(t, err)
@@ -441,7 +440,7 @@ func TestSignBurn(t *testing.T) {
 				assert.NotNil(t, tx)
 				assert.NotNil(t, bytesToSign)
 
-				fee := types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(app.Config.Pocket.TxFee)))
+				fee := types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(10000)))
 				assert.Equal(t, fee, tx.GetFee())
 
 				assert.Equal(t, tc.doc.TransactionHash, tx.GetMemo())
@@ -452,7 +451,7 @@ func TestSignBurn(t *testing.T) {
 				assert.Equal(t, fa, tx.GetMsg().(*nodeTypes.MsgSend).FromAddress)
 
 				amountInt, _ := strconv.ParseInt(tc.doc.Amount, 10, 64)
-				finalAmount := amountInt - app.Config.Pocket.TxFee
+				finalAmount := amountInt - 10000
 				amount := strconv.FormatInt(finalAmount, 10)
 				assert.Equal(t, amount, tx.GetMsg().(*nodeTypes.MsgSend).Amount.String())
 
@@ -464,6 +463,7 @@ func TestSignBurn(t *testing.T) {
 				signers := tc.doc.Signers
 				signers = append(signers, tc.privateKey.PublicKey().RawString())
 				tc.expectedDoc.Signers = signers
+				tc.expectedDoc.TxFee = "10000"
 
 				sigs := [][]byte{}
 				for i := 0; i < tc.numSigners; i++ {
@@ -655,7 +655,6 @@ func TestSignInvalidMint(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			app.Config.Pocket.ChainId = "testnet"
-			app.Config.Pocket.TxFee = 10000
 			pubKeys := []crypto.PublicKey{}
 			for i := 0; i < tc.numSigners; i++ {
 				pubKeys = append(pubKeys, privateKeys[i].PublicKey())
@@ -663,7 +662,7 @@ func TestSignInvalidMint(t *testing.T) {
 			multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pubKeys}
 
 			inputDoc := tc.doc
-			result, err := SignInvalidMint(&inputDoc, tc.privateKey, multisigPubKey, tc.numSigners)
+			result, err := SignInvalidMint(&inputDoc, tc.privateKey, multisigPubKey, tc.numSigners, 10000)
 
 			if tc.expectedErr {
 				assert.Error(t, err)
@@ -676,7 +675,7 @@ func TestSignInvalidMint(t *testing.T) {
 				assert.NotNil(t, tx)
 				assert.NotNil(t, bytesToSign)
 
-				fee := types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(app.Config.Pocket.TxFee)))
+				fee := types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(10000)))
 				assert.Equal(t, fee, tx.GetFee())
 
 				assert.Equal(t, tc.doc.TransactionHash, tx.GetMemo())
@@ -687,7 +686,7 @@ func TestSignInvalidMint(t *testing.T) {
 				assert.Equal(t, fa, tx.GetMsg().(*nodeTypes.MsgSend).FromAddress)
 
 				amountInt, _ := strconv.ParseInt(tc.doc.Amount, 10, 64)
-				finalAmount := amountInt - app.Config.Pocket.TxFee
+				finalAmount := amountInt - 10000
 				amount := strconv.FormatInt(finalAmount, 10)
 				assert.Equal(t, amount, tx.GetMsg().(*nodeTypes.MsgSend).Amount.String())
 
@@ -699,6 +698,7 @@ func TestSignInvalidMint(t *testing.T) {
 				signers := tc.doc.Signers
 				signers = append(signers, tc.privateKey.PublicKey().RawString())
 				tc.expectedDoc.Signers = signers
+				tc.expectedDoc.TxFee = "10000"
 
 				sigs := [][]byte{}
 				for i := 0; i < tc.numSigners; i++ {
@@ -731,3 +731,127 @@ func TestSignInvalidMint(t *testing.T) {
 		})
 	}
 }
+
+func TestSignBurnPinnedTxFee(t *testing.T) {
+	privateKey1, _ := crypto.NewPrivateKey("8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82")
+	privateKey2, _ := crypto.NewPrivateKey("f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055")
+
+	multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{privateKey1.PublicKey(), privateKey2.PublicKey()}}
+	app.Config.Pocket.ChainId = "testnet"
+
+	signFirst := func(txFee int64) models.Burn {
+		doc, err := SignBurn(&models.Burn{
+			Status:           models.StatusConfirmed,
+			RecipientAddress: privateKey2.PublicKey().Address().String(),
+			Amount:           "100000",
+			TransactionHash:  "transaction_hash",
+		}, privateKey1, multisigPubKey, 2, txFee)
+		assert.Nil(t, err)
+		return *doc
+	}
+
+	t.Run("First signature pins the fee", func(t *testing.T) {
+		doc := signFirst(20000)
+
+		assert.Equal(t, "20000", doc.TxFee)
+		fee, err := ReturnTxFee(doc.ReturnTx, app.Config.Pocket.ChainId)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(20000), fee)
+	})
+
+	t.Run("Co-signer keeps the pinned fee", func(t *testing.T) {
+		doc := signFirst(20000)
+
+		result, err := SignBurn(&doc, privateKey2, multisigPubKey, 2, 10000)
+
+		assert.Nil(t, err)
+		assert.Equal(t, models.StatusSigned, result.Status)
+		assert.Equal(t, "20000", result.TxFee)
+		assert.Len(t, result.Signers, 2)
+	})
+
+	t.Run("Co-signer signs again when the chain charges more", func(t *testing.T) {
+		doc := signFirst(10000)
+
+		result, err := SignBurn(&doc, privateKey2, multisigPubKey, 2, 20000)
+
+		assert.Nil(t, err)
+		assert.Equal(t, models.StatusConfirmed, result.Status)
+		assert.Equal(t, "20000", result.TxFee)
+		assert.Equal(t, []string{privateKey2.PublicKey().RawString()}, result.Signers)
+		fee, _ := ReturnTxFee(result.ReturnTx, app.Config.Pocket.ChainId)
+		assert.Equal(t, int64(20000), fee)
+	})
+
+	t.Run("Pinned fee does not match the tx", func(t *testing.T) {
+		doc := signFirst(10000)
+		doc.TxFee = "20000"
+
+		_, err := SignBurn(&doc, privateKey2, multisigPubKey, 2, 10000)
+
+		assert.EqualError(t, err, "return tx fee 10000 does not match the pinned tx fee 20000")
+	})
+
+	t.Run("Tx does not spend the amount", func(t *testing.T) {
+		doc := signFirst(10000)
+		doc.Amount = "200000"
+
+		_, err := SignBurn(&doc, privateKey2, multisigPubKey, 2, 10000)
+
+		assert.EqualError(t, err, "return tx amount 90000 and fee 10000 do not add up to 200000")
+	})
+
+	t.Run("Amount does not cover the fee", func(t *testing.T) {
+		_, err := SignBurn(&models.Burn{
+			RecipientAddress: privateKey2.PublicKey().Address().String(),
+			Amount:           "10000",
+		}, privateKey1, multisigPubKey, 2, 10000)
+
+		assert.EqualError(t, err, "amount 10000 does not cover the tx fee 10000")
+	})
+}
+
+func TestAddReturnTxSignature(t *testing.T) {
+	privateKey1, _ := crypto.NewPrivateKey("8d8da5d374c559b2f80c99c0f4cfb4405b6095487989bb8a5d5a7e579a4e76646a456564a026788cd201a1a324a26d090e8df3dd0f3a233796552bdcaa95ad82")
+	privateKey2, _ := crypto.NewPrivateKey("f2c227cd1299f62750e48d3e44c2d29cb3add4c8e9a171ae260b8fdeff49c761ee604c6068452fa886c196afd7dd3a284ce9082d23baae2bfa6fe9cc1cd9d055")
+	outsider := crypto.GenerateEd25519PrivKey()
+
+	multisigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{privateKey1.PublicKey(), privateKey2.PublicKey()}}
+	app.Config.Pocket.ChainId = "testnet"
+
+	returnTx, err := BuildReturnTx(privateKey2.PublicKey().Address().String(), "100000", "transaction_hash", 10000, multisigPubKey)
+	assert.Nil(t, err)
+	signBytes, err := ReturnTxSignBytes(returnTx, app.Config.Pocket.ChainId)
+	assert.Nil(t, err)
+
+	t.Run("Member signs first", func(t *testing.T) {
+		sig, _ := privateKey2.Sign(signBytes)
+
+		signed, err := AddReturnTxSignature(returnTx, app.Config.Pocket.ChainId, sig, privateKey2.PublicKey(), multisigPubKey)
+
+		assert.Nil(t, err)
+		assert.NotEqual(t, returnTx, signed)
+	})
+
+	t.Run("Non member signs first", func(t *testing.T) {
+		sig, _ := outsider.Sign(signBytes)
+
+		_, err := AddReturnTxSignature(returnTx, app.Config.Pocket.ChainId, sig, outsider.PublicKey(), multisigPubKey)
+
+		assert.EqualError(t, err, "signer is not a member of the multisig")
+	})
+
+	t.Run("Signature of another key", func(t *testing.T) {
+		sig, _ := privateKey1.Sign(signBytes)
+
+		_, err := AddReturnTxSignature(returnTx, app.Config.Pocket.ChainId, sig, privateKey2.PublicKey(), multisigPubKey)
+
+		assert.EqualError(t, err, "signature does not match transaction")
+	})
+
+	t.Run("Member index", func(t *testing.T) {
+		assert.Equal(t, 0, MultisigKeyIndex(privateKey1.PublicKey(), multisigPubKey))
+		assert.Equal(t, 1, MultisigKeyIndex(privateKey2.PublicKey(), multisigPubKey))
+		assert.Equal(t, -1, MultisigKeyIndex(outsider.PublicKey(), multisigPubKey))
+	})
+}